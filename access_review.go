@@ -0,0 +1,486 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"sync"
+)
+
+// AccessReviewResourceTypeValue represents the type of a resource in an
+// access review.
+type AccessReviewResourceTypeValue string
+
+// These constants represent all valid access review resource types.
+const (
+	AccessReviewGroup   AccessReviewResourceTypeValue = "group"
+	AccessReviewProject AccessReviewResourceTypeValue = "project"
+)
+
+// AccessReviewMembershipValue represents how a user got access to a resource.
+type AccessReviewMembershipValue string
+
+// These constants represent all valid access review memberships.
+const (
+	// AccessReviewDirect is a direct membership of the resource.
+	AccessReviewDirect AccessReviewMembershipValue = "direct"
+
+	// AccessReviewInherited is a membership inherited from an ancestor
+	// group.
+	AccessReviewInherited AccessReviewMembershipValue = "inherited"
+
+	// AccessReviewShared is a membership of a group the resource is shared
+	// with.
+	AccessReviewShared AccessReviewMembershipValue = "shared"
+)
+
+// AccessReviewEntry represents the effective access of a user to a group or
+// project.
+type AccessReviewEntry struct {
+	UserID       int                           `json:"user_id"`
+	Username     string                        `json:"username"`
+	Name         string                        `json:"name"`
+	State        string                        `json:"state"`
+	ResourceType AccessReviewResourceTypeValue `json:"resource_type"`
+	ResourceID   int                           `json:"resource_id"`
+	ResourcePath string                        `json:"resource_path"`
+	AccessLevel  AccessLevelValue              `json:"access_level"`
+	Membership   AccessReviewMembershipValue   `json:"membership"`
+	ExpiresAt    *ISOTime                      `json:"expires_at"`
+}
+
+func (e AccessReviewEntry) String() string {
+	return Stringify(e)
+}
+
+// AccessReviewFunc is called by ExportAccessReview for every entry of the
+// access review. It is never called concurrently.
+type AccessReviewFunc func(e *AccessReviewEntry) error
+
+// AccessReviewOptions represents the available ExportAccessReview() options.
+type AccessReviewOptions struct {
+	// Groups limits the review to the given groups, their descendant groups
+	// and their projects. When empty, all groups and projects visible to the
+	// authenticated user are reviewed, which covers the whole instance when
+	// the user is an administrator.
+	Groups []interface{}
+
+	// Concurrency is the number of groups and projects reviewed concurrently.
+	// Defaults to 4.
+	Concurrency int
+}
+
+// accessReviewResource is a group or project to review.
+type accessReviewResource struct {
+	typ    AccessReviewResourceTypeValue
+	id     int
+	path   string
+	shares []int
+}
+
+// ExportAccessReview walks groups and projects and calls fn with the
+// effective access level of every user to every group and project, including
+// access inherited from ancestor groups and access through groups the group or
+// project is shared with. Resources are reviewed concurrently, so the order of
+// the entries is not deterministic. The first error stops the review.
+//
+// Use an AccessReviewCSVWriter or an AccessReviewJSONWriter to write the
+// entries to a stream.
+func (c *Client) ExportAccessReview(ctx context.Context, opt *AccessReviewOptions, fn AccessReviewFunc) error {
+	o := AccessReviewOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	if o.Concurrency <= 0 {
+		o.Concurrency = 4
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	resources, err := c.accessReviewResources(ctx, o.Groups)
+	if err != nil {
+		return err
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	fail := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+	}
+
+	shared := &sharedGroupMembers{client: c, groups: make(map[int]*sharedGroupMembersEntry)}
+
+	queue := make(chan *accessReviewResource)
+	for i := 0; i < o.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for r := range queue {
+				entries, err := c.reviewResource(ctx, r, shared)
+				if err != nil {
+					fail(err)
+					continue
+				}
+
+				mu.Lock()
+				for _, e := range entries {
+					if firstErr != nil {
+						break
+					}
+					if err := fn(e); err != nil {
+						firstErr = err
+						cancel()
+					}
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, r := range resources {
+		select {
+		case queue <- r:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	close(queue)
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
+
+// accessReviewResources returns the groups and projects to review.
+func (c *Client) accessReviewResources(ctx context.Context, groups []interface{}) ([]*accessReviewResource, error) {
+	var resources []*accessReviewResource
+	type resourceKey struct {
+		typ AccessReviewResourceTypeValue
+		id  int
+	}
+	seen := make(map[resourceKey]bool)
+	addGroup := func(g *Group) {
+		key := resourceKey{AccessReviewGroup, g.ID}
+		if !seen[key] {
+			seen[key] = true
+			resources = append(resources, &accessReviewResource{typ: AccessReviewGroup, id: g.ID, path: g.FullPath})
+		}
+	}
+	addProjects := func(ps []*Project) {
+		for _, p := range ps {
+			key := resourceKey{AccessReviewProject, p.ID}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			r := &accessReviewResource{typ: AccessReviewProject, id: p.ID, path: p.PathWithNamespace}
+			for _, s := range p.SharedWithGroups {
+				r.shares = append(r.shares, s.GroupID)
+			}
+			resources = append(resources, r)
+		}
+	}
+
+	if len(groups) == 0 {
+		gopt := &ListGroupsOptions{ListOptions: ListOptions{PerPage: 100}, AllAvailable: Bool(true)}
+		err := ForEachPage(func(options ...RequestOptionFunc) (*Response, error) {
+			gs, resp, err := c.Groups.ListGroups(gopt, options...)
+			for _, g := range gs {
+				addGroup(g)
+			}
+			return resp, err
+		}, WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		popt := &ListProjectsOptions{ListOptions: ListOptions{PerPage: 100}}
+		err = ForEachPage(func(options ...RequestOptionFunc) (*Response, error) {
+			ps, resp, err := c.Projects.ListProjects(popt, options...)
+			addProjects(ps)
+			return resp, err
+		}, WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		return resources, nil
+	}
+
+	for _, gid := range groups {
+		g, _, err := c.Groups.GetGroup(gid, WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		addGroup(g)
+
+		dopt := &ListDescendantGroupsOptions{ListOptions: ListOptions{PerPage: 100}, AllAvailable: Bool(true)}
+		err = ForEachPage(func(options ...RequestOptionFunc) (*Response, error) {
+			gs, resp, err := c.Groups.ListDescendantGroups(g.ID, dopt, options...)
+			for _, g := range gs {
+				addGroup(g)
+			}
+			return resp, err
+		}, WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		popt := &ListGroupProjectsOptions{ListOptions: ListOptions{PerPage: 100}, IncludeSubgroups: Bool(true)}
+		err = ForEachPage(func(options ...RequestOptionFunc) (*Response, error) {
+			ps, resp, err := c.Groups.ListGroupProjects(g.ID, popt, options...)
+			addProjects(ps)
+			return resp, err
+		}, WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return resources, nil
+}
+
+// reviewResource returns the access review entries of a single resource.
+func (c *Client) reviewResource(ctx context.Context, r *accessReviewResource, shared *sharedGroupMembers) ([]*AccessReviewEntry, error) {
+	direct := make(map[int]bool)
+	var all []*AccessReviewEntry
+
+	switch r.typ {
+	case AccessReviewGroup:
+		// Shares are only included in the details of a group.
+		g, _, err := c.Groups.GetGroup(r.id, WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range g.SharedWithGroups {
+			r.shares = append(r.shares, s.GroupID)
+		}
+
+		opt := &ListGroupMembersOptions{ListOptions: ListOptions{PerPage: 100}}
+		err = ForEachPage(func(options ...RequestOptionFunc) (*Response, error) {
+			ms, resp, err := c.Groups.ListGroupMembers(r.id, opt, options...)
+			for _, m := range ms {
+				direct[m.ID] = true
+			}
+			return resp, err
+		}, WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		err = ForEachPage(func(options ...RequestOptionFunc) (*Response, error) {
+			ms, resp, err := c.Groups.ListAllGroupMembers(r.id, opt, options...)
+			for _, m := range ms {
+				all = append(all, &AccessReviewEntry{
+					UserID:      m.ID,
+					Username:    m.Username,
+					Name:        m.Name,
+					State:       m.State,
+					AccessLevel: m.AccessLevel,
+					ExpiresAt:   m.ExpiresAt,
+				})
+			}
+			return resp, err
+		}, WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+	case AccessReviewProject:
+		opt := &ListProjectMembersOptions{ListOptions: ListOptions{PerPage: 100}}
+		err := ForEachPage(func(options ...RequestOptionFunc) (*Response, error) {
+			ms, resp, err := c.ProjectMembers.ListProjectMembers(r.id, opt, options...)
+			for _, m := range ms {
+				direct[m.ID] = true
+			}
+			return resp, err
+		}, WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		err = ForEachPage(func(options ...RequestOptionFunc) (*Response, error) {
+			ms, resp, err := c.ProjectMembers.ListAllProjectMembers(r.id, opt, options...)
+			for _, m := range ms {
+				all = append(all, &AccessReviewEntry{
+					UserID:      m.ID,
+					Username:    m.Username,
+					Name:        m.Name,
+					State:       m.State,
+					AccessLevel: m.AccessLevel,
+					ExpiresAt:   m.ExpiresAt,
+				})
+			}
+			return resp, err
+		}, WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, e := range all {
+		e.ResourceType = r.typ
+		e.ResourceID = r.id
+		e.ResourcePath = r.path
+
+		switch {
+		case direct[e.UserID]:
+			e.Membership = AccessReviewDirect
+		default:
+			e.Membership = AccessReviewInherited
+			for _, gid := range r.shares {
+				ok, err := shared.isMember(ctx, gid, e.UserID)
+				if err != nil {
+					return nil, err
+				}
+				if ok {
+					e.Membership = AccessReviewShared
+					break
+				}
+			}
+		}
+	}
+
+	return all, nil
+}
+
+// sharedGroupMembers caches the members of the groups resources are shared
+// with.
+type sharedGroupMembers struct {
+	client *Client
+
+	mu     sync.Mutex
+	groups map[int]*sharedGroupMembersEntry
+}
+
+type sharedGroupMembersEntry struct {
+	once  sync.Once
+	users map[int]bool
+	err   error
+}
+
+// isMember reports whether the user is a member of the group, including
+// inherited members.
+func (s *sharedGroupMembers) isMember(ctx context.Context, gid, uid int) (bool, error) {
+	s.mu.Lock()
+	entry, ok := s.groups[gid]
+	if !ok {
+		entry = &sharedGroupMembersEntry{}
+		s.groups[gid] = entry
+	}
+	s.mu.Unlock()
+
+	entry.once.Do(func() {
+		users := make(map[int]bool)
+		opt := &ListGroupMembersOptions{ListOptions: ListOptions{PerPage: 100}}
+		entry.err = ForEachPage(func(options ...RequestOptionFunc) (*Response, error) {
+			ms, resp, err := s.client.Groups.ListAllGroupMembers(gid, opt, options...)
+			for _, m := range ms {
+				users[m.ID] = true
+			}
+			return resp, err
+		}, WithContext(ctx))
+		entry.users = users
+	})
+
+	return entry.users[uid], entry.err
+}
+
+// AccessReviewCSVWriter writes access review entries as CSV, starting with a
+// header row.
+type AccessReviewCSVWriter struct {
+	w           *csv.Writer
+	wroteHeader bool
+}
+
+// NewAccessReviewCSVWriter returns an AccessReviewCSVWriter writing to w.
+func NewAccessReviewCSVWriter(w io.Writer) *AccessReviewCSVWriter {
+	return &AccessReviewCSVWriter{w: csv.NewWriter(w)}
+}
+
+// Write writes a single entry. It can be passed to ExportAccessReview
+// directly. Call Flush when done.
+func (w *AccessReviewCSVWriter) Write(e *AccessReviewEntry) error {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		err := w.w.Write([]string{
+			"user_id", "username", "name", "state", "resource_type", "resource_id",
+			"resource_path", "access_level", "membership", "expires_at",
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	expiresAt := ""
+	if e.ExpiresAt != nil {
+		expiresAt = e.ExpiresAt.String()
+	}
+
+	return w.w.Write([]string{
+		strconv.Itoa(e.UserID),
+		e.Username,
+		e.Name,
+		e.State,
+		string(e.ResourceType),
+		strconv.Itoa(e.ResourceID),
+		e.ResourcePath,
+		strconv.Itoa(int(e.AccessLevel)),
+		string(e.Membership),
+		expiresAt,
+	})
+}
+
+// Flush writes any buffered data to the underlying writer.
+func (w *AccessReviewCSVWriter) Flush() error {
+	w.w.Flush()
+	return w.w.Error()
+}
+
+// AccessReviewJSONWriter writes access review entries as newline delimited
+// JSON.
+type AccessReviewJSONWriter struct {
+	enc *json.Encoder
+}
+
+// NewAccessReviewJSONWriter returns an AccessReviewJSONWriter writing to w.
+func NewAccessReviewJSONWriter(w io.Writer) *AccessReviewJSONWriter {
+	return &AccessReviewJSONWriter{enc: json.NewEncoder(w)}
+}
+
+// Write writes a single entry. It can be passed to ExportAccessReview
+// directly.
+func (w *AccessReviewJSONWriter) Write(e *AccessReviewEntry) error {
+	return w.enc.Encode(e)
+}
@@ -0,0 +1,124 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupAccessReview(t *testing.T) (*http.ServeMux, *Client, func()) {
+	mux, server, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":1,"full_path":"acme"}`)
+	})
+	mux.HandleFunc("/api/v4/groups/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":2,"full_path":"acme/dev"}`)
+	})
+	mux.HandleFunc("/api/v4/groups/1/descendant_groups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"id":2,"full_path":"acme/dev"}]`)
+	})
+	mux.HandleFunc("/api/v4/groups/1/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/groups/1/projects?include_subgroups=true&per_page=100")
+		fmt.Fprint(w, `[{"id":10,"path_with_namespace":"acme/dev/app","shared_with_groups":[{"group_id":3}]}]`)
+	})
+
+	members := map[string]string{
+		"/api/v4/groups/1/members":        `[{"id":1,"username":"owner","access_level":50}]`,
+		"/api/v4/groups/1/members/all":    `[{"id":1,"username":"owner","access_level":50}]`,
+		"/api/v4/groups/2/members":        `[{"id":2,"username":"dev","access_level":30}]`,
+		"/api/v4/groups/2/members/all":    `[{"id":1,"username":"owner","access_level":50},{"id":2,"username":"dev","access_level":30}]`,
+		"/api/v4/groups/3/members/all":    `[{"id":3,"username":"partner","access_level":20}]`,
+		"/api/v4/projects/10/members":     `[{"id":4,"username":"contractor","access_level":30,"expires_at":"2030-01-01"}]`,
+		"/api/v4/projects/10/members/all": `[{"id":1,"username":"owner","access_level":50},{"id":2,"username":"dev","access_level":30},{"id":3,"username":"partner","access_level":20},{"id":4,"username":"contractor","access_level":30,"expires_at":"2030-01-01"}]`,
+	}
+	for path, body := range members {
+		body := body
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			fmt.Fprint(w, body)
+		})
+	}
+
+	return mux, client, func() { teardown(server) }
+}
+
+func TestExportAccessReview(t *testing.T) {
+	_, client, done := setupAccessReview(t)
+	defer done()
+
+	var entries []string
+	err := client.ExportAccessReview(context.Background(), &AccessReviewOptions{Groups: []interface{}{1}}, func(e *AccessReviewEntry) error {
+		entries = append(entries, fmt.Sprintf("%s:%s:%s:%d:%s", e.ResourceType, e.ResourcePath, e.Username, e.AccessLevel, e.Membership))
+		return nil
+	})
+	require.NoError(t, err)
+
+	sort.Strings(entries)
+	want := []string{
+		"group:acme/dev:dev:30:direct",
+		"group:acme/dev:owner:50:inherited",
+		"group:acme:owner:50:direct",
+		"project:acme/dev/app:contractor:30:direct",
+		"project:acme/dev/app:dev:30:inherited",
+		"project:acme/dev/app:owner:50:inherited",
+		"project:acme/dev/app:partner:20:shared",
+	}
+	assert.Equal(t, want, entries)
+}
+
+func TestExportAccessReviewWriters(t *testing.T) {
+	_, client, done := setupAccessReview(t)
+	defer done()
+
+	var csvBuf, jsonBuf bytes.Buffer
+	csvw := NewAccessReviewCSVWriter(&csvBuf)
+	jsonw := NewAccessReviewJSONWriter(&jsonBuf)
+
+	err := client.ExportAccessReview(context.Background(), &AccessReviewOptions{Groups: []interface{}{1}, Concurrency: 1}, func(e *AccessReviewEntry) error {
+		if e.ResourceType != AccessReviewProject || e.Username != "contractor" {
+			return nil
+		}
+		if err := csvw.Write(e); err != nil {
+			return err
+		}
+		return jsonw.Write(e)
+	})
+	require.NoError(t, err)
+	require.NoError(t, csvw.Flush())
+
+	wantCSV := "user_id,username,name,state,resource_type,resource_id,resource_path,access_level,membership,expires_at\n" +
+		"4,contractor,,,project,10,acme/dev/app,30,direct,2030-01-01\n"
+	assert.Equal(t, wantCSV, csvBuf.String())
+
+	wantJSON := `{"user_id":4,"username":"contractor","name":"","state":"","resource_type":"project","resource_id":10,` +
+		`"resource_path":"acme/dev/app","access_level":30,"membership":"direct","expires_at":"2030-01-01"}`
+	assert.Equal(t, wantJSON, strings.TrimSpace(jsonBuf.String()))
+}
@@ -0,0 +1,307 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"time"
+)
+
+// AchievementsService handles communication with the achievements related
+// methods of the GitLab API. Achievements are only exposed by the GraphQL
+// API, so all methods of this service use the GraphQL endpoint.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/user/achievements/
+type AchievementsService struct {
+	client *Client
+}
+
+// Achievement represents a GitLab namespace achievement.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/user/achievements/
+type Achievement struct {
+	ID          int
+	Name        string
+	Description string
+	CreatedAt   *time.Time
+}
+
+func (a Achievement) String() string {
+	return Stringify(a)
+}
+
+// UserAchievement represents an achievement awarded to a user.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/user/achievements/
+type UserAchievement struct {
+	ID          int
+	Achievement *Achievement
+	CreatedAt   *time.Time
+	RevokedAt   *time.Time
+}
+
+func (a UserAchievement) String() string {
+	return Stringify(a)
+}
+
+// graphQLAchievement is the GraphQL representation of an Achievement.
+type graphQLAchievement struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	CreatedAt   *time.Time `json:"createdAt"`
+}
+
+func (a *graphQLAchievement) achievement() (*Achievement, error) {
+	if a == nil {
+		return nil, nil
+	}
+	id, err := ParseGlobalID(a.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &Achievement{
+		ID:          id,
+		Name:        a.Name,
+		Description: a.Description,
+		CreatedAt:   a.CreatedAt,
+	}, nil
+}
+
+// graphQLUserAchievement is the GraphQL representation of a UserAchievement.
+type graphQLUserAchievement struct {
+	ID          string              `json:"id"`
+	Achievement *graphQLAchievement `json:"achievement"`
+	CreatedAt   *time.Time          `json:"createdAt"`
+	RevokedAt   *time.Time          `json:"revokedAt"`
+}
+
+func (a *graphQLUserAchievement) userAchievement() (*UserAchievement, error) {
+	if a == nil {
+		return nil, nil
+	}
+	id, err := ParseGlobalID(a.ID)
+	if err != nil {
+		return nil, err
+	}
+	achievement, err := a.Achievement.achievement()
+	if err != nil {
+		return nil, err
+	}
+	return &UserAchievement{
+		ID:          id,
+		Achievement: achievement,
+		CreatedAt:   a.CreatedAt,
+		RevokedAt:   a.RevokedAt,
+	}, nil
+}
+
+const achievementFields = `
+      id
+      name
+      description
+      createdAt`
+
+const userAchievementFields = `
+      id
+      createdAt
+      revokedAt
+      achievement {` + achievementFields + `
+      }`
+
+// CreateAchievementOptions represents the available CreateAchievement()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationachievementscreate
+type CreateAchievementOptions struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// CreateAchievement creates a new achievement in the given top-level group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationachievementscreate
+func (s *AchievementsService) CreateAchievement(gid int, opt *CreateAchievementOptions, options ...RequestOptionFunc) (*Achievement, *Response, error) {
+	query := `
+mutation($input: AchievementsCreateInput!) {
+  achievementsCreate(input: $input) {
+    errors
+    achievement {` + achievementFields + `
+    }
+  }
+}`
+
+	input := map[string]interface{}{
+		"namespaceId": GlobalID("Group", gid),
+	}
+	if opt != nil {
+		if opt.Name != nil {
+			input["name"] = *opt.Name
+		}
+		if opt.Description != nil {
+			input["description"] = *opt.Description
+		}
+	}
+
+	var data struct {
+		AchievementsCreate struct {
+			Errors      []string            `json:"errors"`
+			Achievement *graphQLAchievement `json:"achievement"`
+		} `json:"achievementsCreate"`
+	}
+	resp, err := s.client.doGraphQL(query, map[string]interface{}{"input": input}, &data, options)
+	if err != nil {
+		return nil, resp, err
+	}
+	if err := mutationError("achievementsCreate", data.AchievementsCreate.Errors); err != nil {
+		return nil, resp, err
+	}
+
+	a, err := data.AchievementsCreate.Achievement.achievement()
+	return a, resp, err
+}
+
+// AwardAchievement awards an achievement to a user.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationachievementsaward
+func (s *AchievementsService) AwardAchievement(achievement, uid int, options ...RequestOptionFunc) (*UserAchievement, *Response, error) {
+	query := `
+mutation($input: AchievementsAwardInput!) {
+  achievementsAward(input: $input) {
+    errors
+    userAchievement {` + userAchievementFields + `
+    }
+  }
+}`
+
+	input := map[string]interface{}{
+		"achievementId": GlobalID("Achievements::Achievement", achievement),
+		"userId":        GlobalID("User", uid),
+	}
+
+	var data struct {
+		AchievementsAward struct {
+			Errors          []string                `json:"errors"`
+			UserAchievement *graphQLUserAchievement `json:"userAchievement"`
+		} `json:"achievementsAward"`
+	}
+	resp, err := s.client.doGraphQL(query, map[string]interface{}{"input": input}, &data, options)
+	if err != nil {
+		return nil, resp, err
+	}
+	if err := mutationError("achievementsAward", data.AchievementsAward.Errors); err != nil {
+		return nil, resp, err
+	}
+
+	a, err := data.AchievementsAward.UserAchievement.userAchievement()
+	return a, resp, err
+}
+
+// RevokeAchievement revokes an achievement awarded to a user.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationachievementsrevoke
+func (s *AchievementsService) RevokeAchievement(userAchievement int, options ...RequestOptionFunc) (*UserAchievement, *Response, error) {
+	query := `
+mutation($input: AchievementsRevokeInput!) {
+  achievementsRevoke(input: $input) {
+    errors
+    userAchievement {` + userAchievementFields + `
+    }
+  }
+}`
+
+	input := map[string]interface{}{
+		"userAchievementId": GlobalID("Achievements::UserAchievement", userAchievement),
+	}
+
+	var data struct {
+		AchievementsRevoke struct {
+			Errors          []string                `json:"errors"`
+			UserAchievement *graphQLUserAchievement `json:"userAchievement"`
+		} `json:"achievementsRevoke"`
+	}
+	resp, err := s.client.doGraphQL(query, map[string]interface{}{"input": input}, &data, options)
+	if err != nil {
+		return nil, resp, err
+	}
+	if err := mutationError("achievementsRevoke", data.AchievementsRevoke.Errors); err != nil {
+		return nil, resp, err
+	}
+
+	a, err := data.AchievementsRevoke.UserAchievement.userAchievement()
+	return a, resp, err
+}
+
+// ListUserAchievements lists the achievements awarded to a user. All pages
+// of the userAchievements connection are fetched.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#useruserachievements
+func (s *AchievementsService) ListUserAchievements(uid int, options ...RequestOptionFunc) ([]*UserAchievement, error) {
+	query := `
+query($id: UserID!, $after: String) {
+  user(id: $id) {
+    userAchievements(after: $after) {
+      nodes {` + userAchievementFields + `
+      }
+      pageInfo {
+        hasNextPage
+        endCursor
+      }
+    }
+  }
+}`
+
+	variables := map[string]interface{}{"id": GlobalID("User", uid)}
+
+	var as []*UserAchievement
+	for {
+		var data struct {
+			User *struct {
+				UserAchievements struct {
+					Nodes    []*graphQLUserAchievement `json:"nodes"`
+					PageInfo graphQLPageInfo           `json:"pageInfo"`
+				} `json:"userAchievements"`
+			} `json:"user"`
+		}
+		if _, err := s.client.doGraphQL(query, variables, &data, options); err != nil {
+			return nil, err
+		}
+		if data.User == nil {
+			return nil, fmt.Errorf("user %d not found", uid)
+		}
+
+		for _, node := range data.User.UserAchievements.Nodes {
+			a, err := node.userAchievement()
+			if err != nil {
+				return nil, err
+			}
+			as = append(as, a)
+		}
+
+		if !data.User.UserAchievements.PageInfo.HasNextPage {
+			break
+		}
+		variables["after"] = data.User.UserAchievements.PageInfo.EndCursor
+	}
+
+	return as, nil
+}
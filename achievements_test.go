@@ -0,0 +1,138 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAchievement(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var req graphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, map[string]interface{}{
+			"namespaceId": "gid://gitlab/Group/1",
+			"name":        "First MR",
+			"description": "Merged your first merge request",
+		}, req.Variables["input"])
+
+		fmt.Fprint(w, `{"data": {"achievementsCreate": {"errors": [], "achievement": {
+			"id": "gid://gitlab/Achievements::Achievement/5",
+			"name": "First MR",
+			"description": "Merged your first merge request",
+			"createdAt": "2024-03-01T10:00:00Z"
+		}}}}`)
+	})
+
+	achievement, _, err := client.Achievements.CreateAchievement(1, &CreateAchievementOptions{
+		Name:        String("First MR"),
+		Description: String("Merged your first merge request"),
+	})
+	require.NoError(t, err)
+
+	createdAt := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	want := &Achievement{
+		ID:          5,
+		Name:        "First MR",
+		Description: "Merged your first merge request",
+		CreatedAt:   &createdAt,
+	}
+	assert.Equal(t, want, achievement)
+}
+
+func TestAwardAchievementMutationError(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": {"achievementsAward": {"errors": ["User has already been awarded"], "userAchievement": null}}}`)
+	})
+
+	_, _, err := client.Achievements.AwardAchievement(5, 2)
+	assert.EqualError(t, err, "achievementsAward: [User has already been awarded]")
+}
+
+func TestRevokeAchievement(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		var req graphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, map[string]interface{}{
+			"userAchievementId": "gid://gitlab/Achievements::UserAchievement/9",
+		}, req.Variables["input"])
+
+		fmt.Fprint(w, `{"data": {"achievementsRevoke": {"errors": [], "userAchievement": {
+			"id": "gid://gitlab/Achievements::UserAchievement/9",
+			"revokedAt": "2024-03-02T10:00:00Z",
+			"achievement": {"id": "gid://gitlab/Achievements::Achievement/5", "name": "First MR"}
+		}}}}`)
+	})
+
+	ua, _, err := client.Achievements.RevokeAchievement(9)
+	require.NoError(t, err)
+
+	assert.Equal(t, 9, ua.ID)
+	assert.Equal(t, 5, ua.Achievement.ID)
+	assert.NotNil(t, ua.RevokedAt)
+}
+
+func TestListUserAchievements(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		var req graphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "gid://gitlab/User/2", req.Variables["id"])
+
+		switch req.Variables["after"] {
+		case nil:
+			fmt.Fprint(w, `{"data": {"user": {"userAchievements": {"nodes": [
+				{"id": "gid://gitlab/Achievements::UserAchievement/9", "achievement": {"id": "gid://gitlab/Achievements::Achievement/5", "name": "First MR"}}
+			], "pageInfo": {"hasNextPage": true, "endCursor": "c1"}}}}}`)
+		case "c1":
+			fmt.Fprint(w, `{"data": {"user": {"userAchievements": {"nodes": [
+				{"id": "gid://gitlab/Achievements::UserAchievement/10", "achievement": {"id": "gid://gitlab/Achievements::Achievement/6", "name": "Reviewer"}}
+			], "pageInfo": {"hasNextPage": false}}}}}`)
+		default:
+			t.Errorf("unexpected cursor %v", req.Variables["after"])
+		}
+	})
+
+	uas, err := client.Achievements.ListUserAchievements(2)
+	require.NoError(t, err)
+
+	want := []*UserAchievement{
+		{ID: 9, Achievement: &Achievement{ID: 5, Name: "First MR"}},
+		{ID: 10, Achievement: &Achievement{ID: 6, Name: "Reviewer"}},
+	}
+	assert.Equal(t, want, uas)
+}
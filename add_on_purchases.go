@@ -0,0 +1,286 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// AddOnPurchasesService handles communication with the subscription add-on
+// (like GitLab Duo) related methods of the GitLab API.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/subscriptions/subscription-add-ons.html
+type AddOnPurchasesService struct {
+	client *Client
+}
+
+// AddOnPurchase represents a subscription add-on purchase of a namespace.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/subscriptions/subscription-add-ons.html
+type AddOnPurchase struct {
+	NamespaceID   int      `json:"namespace_id"`
+	NamespaceName string   `json:"namespace_name"`
+	AddOn         string   `json:"add_on"`
+	Quantity      int      `json:"quantity"`
+	StartedOn     *ISOTime `json:"started_on"`
+	ExpiresOn     *ISOTime `json:"expires_on"`
+	PurchaseXID   string   `json:"purchase_xid"`
+	Trial         bool     `json:"trial"`
+}
+
+func (a AddOnPurchase) String() string {
+	return Stringify(a)
+}
+
+// GetAddOnPurchase gets the purchase of the given add-on (for example
+// code_suggestions) for a namespace. Available only for admins.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/subscriptions/subscription-add-ons.html
+func (s *AddOnPurchasesService) GetAddOnPurchase(nid interface{}, addOn string, options ...RequestOptionFunc) (*AddOnPurchase, *Response, error) {
+	namespace, err := parseID(nid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("namespaces/%s/subscription_add_on_purchase/%s", pathEscape(namespace), pathEscape(addOn))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(AddOnPurchase)
+	resp, err := s.client.Do(req, a)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a, resp, err
+}
+
+// AddOnPurchaseOptions represents the available CreateAddOnPurchase() and
+// UpdateAddOnPurchase() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/subscriptions/subscription-add-ons.html
+type AddOnPurchaseOptions struct {
+	Quantity    *int     `url:"quantity,omitempty" json:"quantity,omitempty"`
+	StartedOn   *ISOTime `url:"started_on,omitempty" json:"started_on,omitempty"`
+	ExpiresOn   *ISOTime `url:"expires_on,omitempty" json:"expires_on,omitempty"`
+	PurchaseXID *string  `url:"purchase_xid,omitempty" json:"purchase_xid,omitempty"`
+	Trial       *bool    `url:"trial,omitempty" json:"trial,omitempty"`
+}
+
+// CreateAddOnPurchase creates a purchase of the given add-on for a namespace.
+// Available only for admins.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/subscriptions/subscription-add-ons.html
+func (s *AddOnPurchasesService) CreateAddOnPurchase(nid interface{}, addOn string, opt *AddOnPurchaseOptions, options ...RequestOptionFunc) (*AddOnPurchase, *Response, error) {
+	namespace, err := parseID(nid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("namespaces/%s/subscription_add_on_purchase/%s", pathEscape(namespace), pathEscape(addOn))
+
+	req, err := s.client.NewRequest(http.MethodPost, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(AddOnPurchase)
+	resp, err := s.client.Do(req, a)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a, resp, err
+}
+
+// UpdateAddOnPurchase updates the purchase of the given add-on for a
+// namespace. Available only for admins.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/subscriptions/subscription-add-ons.html
+func (s *AddOnPurchasesService) UpdateAddOnPurchase(nid interface{}, addOn string, opt *AddOnPurchaseOptions, options ...RequestOptionFunc) (*AddOnPurchase, *Response, error) {
+	namespace, err := parseID(nid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("namespaces/%s/subscription_add_on_purchase/%s", pathEscape(namespace), pathEscape(addOn))
+
+	req, err := s.client.NewRequest(http.MethodPut, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(AddOnPurchase)
+	resp, err := s.client.Do(req, a)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a, resp, err
+}
+
+// GroupAddOnPurchase represents an add-on purchase of a group, including the
+// number of assigned seats.
+type GroupAddOnPurchase struct {
+	ID                string `json:"id"`
+	Name              string `json:"name"`
+	PurchasedQuantity int    `json:"purchasedQuantity"`
+	AssignedQuantity  int    `json:"assignedQuantity"`
+}
+
+func (a GroupAddOnPurchase) String() string {
+	return Stringify(a)
+}
+
+const listGroupAddOnPurchasesQuery = `
+query($namespaceId: NamespaceID!) {
+  addOnPurchases(namespaceId: $namespaceId) {
+    id
+    name
+    purchasedQuantity
+    assignedQuantity
+  }
+}`
+
+// ListGroupAddOnPurchases lists the add-on purchases of a top-level group,
+// including the number of purchased and assigned seats. This uses the
+// GraphQL API, as seat usage is not exposed by the REST API.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#queryaddonpurchases
+func (s *AddOnPurchasesService) ListGroupAddOnPurchases(gid int, options ...RequestOptionFunc) ([]*GroupAddOnPurchase, *Response, error) {
+	variables := map[string]interface{}{
+		"namespaceId": GlobalID("Group", gid),
+	}
+
+	var data struct {
+		AddOnPurchases []*GroupAddOnPurchase `json:"addOnPurchases"`
+	}
+	resp, err := s.client.doGraphQL(listGroupAddOnPurchasesQuery, variables, &data, options)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return data.AddOnPurchases, resp, err
+}
+
+// AddOnSeatAssignment represents a user with an assigned add-on seat.
+type AddOnSeatAssignment struct {
+	UserID   int
+	Username string
+	Name     string
+}
+
+func (a AddOnSeatAssignment) String() string {
+	return Stringify(a)
+}
+
+const listGroupAddOnSeatAssignmentsQuery = `
+query($fullPath: ID!, $addOnType: GitlabSubscriptionsAddOnType!, $addOnPurchaseIds: [GitlabSubscriptionsAddOnPurchaseID!]!, $after: String) {
+  namespace(fullPath: $fullPath) {
+    addOnEligibleUsers(addOnType: $addOnType, addOnPurchaseIds: $addOnPurchaseIds, after: $after) {
+      nodes {
+        id
+        username
+        name
+        addOnAssignments(addOnPurchaseIds: $addOnPurchaseIds) {
+          nodes {
+            addOnPurchase {
+              id
+            }
+          }
+        }
+      }
+      pageInfo {
+        hasNextPage
+        endCursor
+      }
+    }
+  }
+}`
+
+// ListGroupAddOnSeatAssignments lists the users of a top-level group that are
+// assigned a seat of the given add-on purchase. The addOnType is the GraphQL
+// add-on type of the purchase, for example CODE_SUGGESTIONS for GitLab Duo
+// Pro, and addOnPurchaseID is the global ID returned by
+// ListGroupAddOnPurchases. This uses the GraphQL API and fetches all pages, so
+// the returned response is the response of the last page.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#namespaceaddoneligibleusers
+func (s *AddOnPurchasesService) ListGroupAddOnSeatAssignments(fullPath, addOnType, addOnPurchaseID string, options ...RequestOptionFunc) ([]*AddOnSeatAssignment, *Response, error) {
+	variables := map[string]interface{}{
+		"fullPath":         fullPath,
+		"addOnType":        addOnType,
+		"addOnPurchaseIds": []string{addOnPurchaseID},
+	}
+
+	var assignments []*AddOnSeatAssignment
+	for {
+		var data struct {
+			Namespace *struct {
+				AddOnEligibleUsers struct {
+					Nodes []struct {
+						ID               string `json:"id"`
+						Username         string `json:"username"`
+						Name             string `json:"name"`
+						AddOnAssignments struct {
+							Nodes []json.RawMessage `json:"nodes"`
+						} `json:"addOnAssignments"`
+					} `json:"nodes"`
+					PageInfo graphQLPageInfo `json:"pageInfo"`
+				} `json:"addOnEligibleUsers"`
+			} `json:"namespace"`
+		}
+		resp, err := s.client.doGraphQL(listGroupAddOnSeatAssignmentsQuery, variables, &data, options)
+		if err != nil {
+			return nil, resp, err
+		}
+		if data.Namespace == nil {
+			return nil, resp, fmt.Errorf("namespace %q not found", fullPath)
+		}
+
+		users := data.Namespace.AddOnEligibleUsers
+		for _, u := range users.Nodes {
+			if len(u.AddOnAssignments.Nodes) == 0 {
+				continue
+			}
+			id, err := ParseGlobalID(u.ID)
+			if err != nil {
+				return nil, resp, err
+			}
+			assignments = append(assignments, &AddOnSeatAssignment{
+				UserID:   id,
+				Username: u.Username,
+				Name:     u.Name,
+			})
+		}
+
+		if !users.PageInfo.HasNextPage {
+			return assignments, resp, nil
+		}
+		variables["after"] = users.PageInfo.EndCursor
+	}
+}
@@ -0,0 +1,165 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAddOnPurchase(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/namespaces/1/subscription_add_on_purchase/code_suggestions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+			"namespace_id": 1,
+			"namespace_name": "group",
+			"add_on": "Code Suggestions",
+			"quantity": 10,
+			"started_on": "2024-01-01",
+			"expires_on": "2025-01-01",
+			"purchase_xid": "A-S000001",
+			"trial": false
+		}`)
+	})
+
+	purchase, _, err := client.AddOnPurchases.GetAddOnPurchase(1, "code_suggestions")
+	require.NoError(t, err)
+
+	startedOn := ISOTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	expiresOn := ISOTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	want := &AddOnPurchase{
+		NamespaceID:   1,
+		NamespaceName: "group",
+		AddOn:         "Code Suggestions",
+		Quantity:      10,
+		StartedOn:     &startedOn,
+		ExpiresOn:     &expiresOn,
+		PurchaseXID:   "A-S000001",
+	}
+	assert.Equal(t, want, purchase)
+}
+
+func TestUpdateAddOnPurchase(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/namespaces/1/subscription_add_on_purchase/code_suggestions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"quantity":25}`)
+		fmt.Fprint(w, `{"namespace_id": 1, "add_on": "Code Suggestions", "quantity": 25}`)
+	})
+
+	purchase, _, err := client.AddOnPurchases.UpdateAddOnPurchase(1, "code_suggestions", &AddOnPurchaseOptions{Quantity: Int(25)})
+	require.NoError(t, err)
+	assert.Equal(t, 25, purchase.Quantity)
+}
+
+func TestListGroupAddOnPurchases(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var req graphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "gid://gitlab/Group/1", req.Variables["namespaceId"])
+
+		fmt.Fprint(w, `{"data": {"addOnPurchases": [{
+			"id": "gid://gitlab/GitlabSubscriptions::AddOnPurchase/3",
+			"name": "CODE_SUGGESTIONS",
+			"purchasedQuantity": 10,
+			"assignedQuantity": 4
+		}]}}`)
+	})
+
+	purchases, _, err := client.AddOnPurchases.ListGroupAddOnPurchases(1)
+	require.NoError(t, err)
+
+	want := []*GroupAddOnPurchase{{
+		ID:                "gid://gitlab/GitlabSubscriptions::AddOnPurchase/3",
+		Name:              "CODE_SUGGESTIONS",
+		PurchasedQuantity: 10,
+		AssignedQuantity:  4,
+	}}
+	assert.Equal(t, want, purchases)
+}
+
+func TestListGroupAddOnSeatAssignments(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var req graphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "group", req.Variables["fullPath"])
+		assert.Equal(t, "CODE_SUGGESTIONS", req.Variables["addOnType"])
+
+		switch req.Variables["after"] {
+		case nil:
+			fmt.Fprint(w, `{"data": {"namespace": {"addOnEligibleUsers": {
+				"nodes": [
+					{"id": "gid://gitlab/User/1", "username": "alice", "name": "Alice", "addOnAssignments": {"nodes": [{"addOnPurchase": {"id": "gid://gitlab/GitlabSubscriptions::AddOnPurchase/3"}}]}},
+					{"id": "gid://gitlab/User/2", "username": "bob", "name": "Bob", "addOnAssignments": {"nodes": []}}
+				],
+				"pageInfo": {"hasNextPage": true, "endCursor": "abc"}
+			}}}}`)
+		case "abc":
+			fmt.Fprint(w, `{"data": {"namespace": {"addOnEligibleUsers": {
+				"nodes": [
+					{"id": "gid://gitlab/User/3", "username": "carol", "name": "Carol", "addOnAssignments": {"nodes": [{"addOnPurchase": {"id": "gid://gitlab/GitlabSubscriptions::AddOnPurchase/3"}}]}}
+				],
+				"pageInfo": {"hasNextPage": false, "endCursor": "def"}
+			}}}}`)
+		default:
+			t.Errorf("unexpected cursor %v", req.Variables["after"])
+		}
+	})
+
+	assignments, resp, err := client.AddOnPurchases.ListGroupAddOnSeatAssignments("group", "CODE_SUGGESTIONS", "gid://gitlab/GitlabSubscriptions::AddOnPurchase/3")
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+
+	want := []*AddOnSeatAssignment{
+		{UserID: 1, Username: "alice", Name: "Alice"},
+		{UserID: 3, Username: "carol", Name: "Carol"},
+	}
+	assert.Equal(t, want, assignments)
+}
+
+func TestListGroupAddOnPurchasesGraphQLError(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": null, "errors": [{"message": "The resource that you are attempting to access does not exist"}]}`)
+	})
+
+	_, _, err := client.AddOnPurchases.ListGroupAddOnPurchases(1)
+	assert.EqualError(t, err, "graphql: The resource that you are attempting to access does not exist")
+}
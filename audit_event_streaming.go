@@ -0,0 +1,445 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// AuditEventStreamingService handles communication with the audit event
+// streaming destination related methods of the GitLab API. Streaming
+// destinations forward the audit events of a top-level group to an external
+// HTTP endpoint. Streaming destinations are only exposed by the GraphQL API,
+// so all methods of this service use the GraphQL endpoint.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/audit_report.html
+type AuditEventStreamingService struct {
+	client *Client
+}
+
+// AuditEventStreamingDestination represents an external audit event
+// streaming destination. IDs are GraphQL global IDs.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#externalauditeventdestination
+type AuditEventStreamingDestination struct {
+	ID                string
+	Name              string
+	DestinationURL    string
+	VerificationToken string
+	Headers           []*AuditEventStreamingHeader
+	EventTypeFilters  []string
+	NamespaceFilter   *AuditEventStreamingNamespace
+}
+
+func (d AuditEventStreamingDestination) String() string {
+	return Stringify(d)
+}
+
+// AuditEventStreamingHeader represents a custom HTTP header sent with the
+// audit events streamed to a destination.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#auditeventstreamingheader
+type AuditEventStreamingHeader struct {
+	ID     string `json:"id"`
+	Key    string `json:"key"`
+	Value  string `json:"value"`
+	Active bool   `json:"active"`
+}
+
+func (h AuditEventStreamingHeader) String() string {
+	return Stringify(h)
+}
+
+// AuditEventStreamingNamespace represents the subgroup or project a
+// destination is limited to.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#groupnamespacefilter
+type AuditEventStreamingNamespace struct {
+	ID       int
+	FullPath string
+}
+
+// auditEventStreamingDestinationFields are the fields queried for an
+// AuditEventStreamingDestination.
+const auditEventStreamingDestinationFields = `
+      id
+      name
+      destinationUrl
+      verificationToken
+      eventTypeFilters
+      headers {
+        nodes {
+          id
+          key
+          value
+          active
+        }
+      }
+      namespaceFilter {
+        namespace {
+          id
+          fullPath
+        }
+      }`
+
+// graphQLAuditEventStreamingDestination is the GraphQL representation of an
+// AuditEventStreamingDestination.
+type graphQLAuditEventStreamingDestination struct {
+	ID                string   `json:"id"`
+	Name              string   `json:"name"`
+	DestinationURL    string   `json:"destinationUrl"`
+	VerificationToken string   `json:"verificationToken"`
+	EventTypeFilters  []string `json:"eventTypeFilters"`
+	Headers           struct {
+		Nodes []*AuditEventStreamingHeader `json:"nodes"`
+	} `json:"headers"`
+	NamespaceFilter *struct {
+		Namespace *struct {
+			ID       string `json:"id"`
+			FullPath string `json:"fullPath"`
+		} `json:"namespace"`
+	} `json:"namespaceFilter"`
+}
+
+func (d *graphQLAuditEventStreamingDestination) destination() (*AuditEventStreamingDestination, error) {
+	if d == nil {
+		return nil, nil
+	}
+	dest := &AuditEventStreamingDestination{
+		ID:                d.ID,
+		Name:              d.Name,
+		DestinationURL:    d.DestinationURL,
+		VerificationToken: d.VerificationToken,
+		Headers:           d.Headers.Nodes,
+		EventTypeFilters:  d.EventTypeFilters,
+	}
+	if d.NamespaceFilter != nil && d.NamespaceFilter.Namespace != nil {
+		id, err := ParseGlobalID(d.NamespaceFilter.Namespace.ID)
+		if err != nil {
+			return nil, err
+		}
+		dest.NamespaceFilter = &AuditEventStreamingNamespace{ID: id, FullPath: d.NamespaceFilter.Namespace.FullPath}
+	}
+	return dest, nil
+}
+
+// ListAuditEventStreamingDestinations gets a list of the audit event
+// streaming destinations of a top-level group, identified by its full path.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/audit_report.html#list-streaming-destinations
+func (s *AuditEventStreamingService) ListAuditEventStreamingDestinations(groupPath string, options ...RequestOptionFunc) ([]*AuditEventStreamingDestination, error) {
+	query := `
+query($fullPath: ID!, $after: String) {
+  group(fullPath: $fullPath) {
+    externalAuditEventDestinations(after: $after) {
+      nodes {` + auditEventStreamingDestinationFields + `
+      }
+      pageInfo {
+        hasNextPage
+        endCursor
+      }
+    }
+  }
+}`
+
+	variables := map[string]interface{}{"fullPath": groupPath}
+
+	var destinations []*AuditEventStreamingDestination
+	for {
+		var data struct {
+			Group *struct {
+				Destinations struct {
+					Nodes    []*graphQLAuditEventStreamingDestination `json:"nodes"`
+					PageInfo struct {
+						HasNextPage bool   `json:"hasNextPage"`
+						EndCursor   string `json:"endCursor"`
+					} `json:"pageInfo"`
+				} `json:"externalAuditEventDestinations"`
+			} `json:"group"`
+		}
+		if _, err := s.client.doGraphQL(query, variables, &data, options); err != nil {
+			return nil, err
+		}
+		if data.Group == nil {
+			return nil, fmt.Errorf("group %s not found", groupPath)
+		}
+
+		for _, node := range data.Group.Destinations.Nodes {
+			d, err := node.destination()
+			if err != nil {
+				return nil, err
+			}
+			destinations = append(destinations, d)
+		}
+
+		if !data.Group.Destinations.PageInfo.HasNextPage {
+			break
+		}
+		variables["after"] = data.Group.Destinations.PageInfo.EndCursor
+	}
+
+	return destinations, nil
+}
+
+// mutate executes one of the streaming destination mutations, all of which
+// return a list of errors, and decodes the result of the mutation into v.
+func (s *AuditEventStreamingService) mutate(mutation, inputType, fields string, input map[string]interface{}, v interface{}, options []RequestOptionFunc) (*Response, error) {
+	query := fmt.Sprintf(`
+mutation($input: %s!) {
+  %s(input: $input) {
+    errors%s
+  }
+}`, inputType, mutation, fields)
+
+	var data map[string]json.RawMessage
+	resp, err := s.client.doGraphQL(query, map[string]interface{}{"input": input}, &data, options)
+	if err != nil {
+		return resp, err
+	}
+
+	raw := data[mutation]
+	if len(raw) == 0 || string(raw) == "null" {
+		return resp, fmt.Errorf("%s: no result returned", mutation)
+	}
+
+	var result struct {
+		Errors []string `json:"errors"`
+	}
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return resp, err
+	}
+	if err := mutationError(mutation, result.Errors); err != nil {
+		return resp, err
+	}
+
+	if v != nil {
+		if err := json.Unmarshal(raw, v); err != nil {
+			return resp, err
+		}
+	}
+
+	return resp, nil
+}
+
+// destinationMutation executes a mutation returning a streaming destination.
+func (s *AuditEventStreamingService) destinationMutation(mutation, inputType string, input map[string]interface{}, options []RequestOptionFunc) (*AuditEventStreamingDestination, *Response, error) {
+	fields := `
+    externalAuditEventDestination {` + auditEventStreamingDestinationFields + `
+    }`
+
+	var result struct {
+		Destination *graphQLAuditEventStreamingDestination `json:"externalAuditEventDestination"`
+	}
+	resp, err := s.mutate(mutation, inputType, fields, input, &result, options)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	d, err := result.Destination.destination()
+	return d, resp, err
+}
+
+// AuditEventStreamingDestinationOptions represents the available
+// CreateAuditEventStreamingDestination() and
+// UpdateAuditEventStreamingDestination() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationexternalauditeventdestinationcreate
+type AuditEventStreamingDestinationOptions struct {
+	Name           *string
+	DestinationURL *string
+}
+
+// auditEventStreamingDestinationInput returns the mutation input for the
+// given options.
+func auditEventStreamingDestinationInput(opt *AuditEventStreamingDestinationOptions) map[string]interface{} {
+	input := make(map[string]interface{})
+	if opt != nil {
+		if opt.Name != nil {
+			input["name"] = *opt.Name
+		}
+		if opt.DestinationURL != nil {
+			input["destinationUrl"] = *opt.DestinationURL
+		}
+	}
+	return input
+}
+
+// CreateAuditEventStreamingDestination creates an audit event streaming
+// destination for a top-level group, identified by its full path.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationexternalauditeventdestinationcreate
+func (s *AuditEventStreamingService) CreateAuditEventStreamingDestination(groupPath string, opt *AuditEventStreamingDestinationOptions, options ...RequestOptionFunc) (*AuditEventStreamingDestination, *Response, error) {
+	input := auditEventStreamingDestinationInput(opt)
+	input["groupPath"] = groupPath
+
+	return s.destinationMutation("externalAuditEventDestinationCreate", "ExternalAuditEventDestinationCreateInput", input, options)
+}
+
+// UpdateAuditEventStreamingDestination updates an audit event streaming
+// destination, identified by its global ID.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationexternalauditeventdestinationupdate
+func (s *AuditEventStreamingService) UpdateAuditEventStreamingDestination(destination string, opt *AuditEventStreamingDestinationOptions, options ...RequestOptionFunc) (*AuditEventStreamingDestination, *Response, error) {
+	input := auditEventStreamingDestinationInput(opt)
+	input["id"] = destination
+
+	return s.destinationMutation("externalAuditEventDestinationUpdate", "ExternalAuditEventDestinationUpdateInput", input, options)
+}
+
+// DeleteAuditEventStreamingDestination deletes an audit event streaming
+// destination, identified by its global ID.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationexternalauditeventdestinationdestroy
+func (s *AuditEventStreamingService) DeleteAuditEventStreamingDestination(destination string, options ...RequestOptionFunc) (*Response, error) {
+	input := map[string]interface{}{"id": destination}
+
+	return s.mutate("externalAuditEventDestinationDestroy", "ExternalAuditEventDestinationDestroyInput", "", input, nil, options)
+}
+
+// AuditEventStreamingHeaderOptions represents the available
+// AddAuditEventStreamingHeader() and UpdateAuditEventStreamingHeader()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationauditeventsstreamingheaderscreate
+type AuditEventStreamingHeaderOptions struct {
+	Key    *string
+	Value  *string
+	Active *bool
+}
+
+// auditEventStreamingHeaderInput returns the mutation input for the given
+// options.
+func auditEventStreamingHeaderInput(opt *AuditEventStreamingHeaderOptions) map[string]interface{} {
+	input := make(map[string]interface{})
+	if opt != nil {
+		if opt.Key != nil {
+			input["key"] = *opt.Key
+		}
+		if opt.Value != nil {
+			input["value"] = *opt.Value
+		}
+		if opt.Active != nil {
+			input["active"] = *opt.Active
+		}
+	}
+	return input
+}
+
+// headerMutation executes a mutation returning a streaming header.
+func (s *AuditEventStreamingService) headerMutation(mutation, inputType string, input map[string]interface{}, options []RequestOptionFunc) (*AuditEventStreamingHeader, *Response, error) {
+	fields := `
+    header {
+      id
+      key
+      value
+      active
+    }`
+
+	var result struct {
+		Header *AuditEventStreamingHeader `json:"header"`
+	}
+	resp, err := s.mutate(mutation, inputType, fields, input, &result, options)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.Header, resp, nil
+}
+
+// AddAuditEventStreamingHeader adds a custom HTTP header to the requests
+// sent to an audit event streaming destination, identified by its global ID.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationauditeventsstreamingheaderscreate
+func (s *AuditEventStreamingService) AddAuditEventStreamingHeader(destination string, opt *AuditEventStreamingHeaderOptions, options ...RequestOptionFunc) (*AuditEventStreamingHeader, *Response, error) {
+	input := auditEventStreamingHeaderInput(opt)
+	input["destinationId"] = destination
+
+	return s.headerMutation("auditEventsStreamingHeadersCreate", "AuditEventsStreamingHeadersCreateInput", input, options)
+}
+
+// UpdateAuditEventStreamingHeader updates a custom HTTP header of an audit
+// event streaming destination, identified by its global ID.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationauditeventsstreamingheadersupdate
+func (s *AuditEventStreamingService) UpdateAuditEventStreamingHeader(header string, opt *AuditEventStreamingHeaderOptions, options ...RequestOptionFunc) (*AuditEventStreamingHeader, *Response, error) {
+	input := auditEventStreamingHeaderInput(opt)
+	input["headerId"] = header
+
+	return s.headerMutation("auditEventsStreamingHeadersUpdate", "AuditEventsStreamingHeadersUpdateInput", input, options)
+}
+
+// DeleteAuditEventStreamingHeader deletes a custom HTTP header of an audit
+// event streaming destination, identified by its global ID.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationauditeventsstreamingheadersdestroy
+func (s *AuditEventStreamingService) DeleteAuditEventStreamingHeader(header string, options ...RequestOptionFunc) (*Response, error) {
+	input := map[string]interface{}{"headerId": header}
+
+	return s.mutate("auditEventsStreamingHeadersDestroy", "AuditEventsStreamingHeadersDestroyInput", "", input, nil, options)
+}
+
+// AddAuditEventStreamingEventTypeFilters limits the audit events streamed to
+// a destination, identified by its global ID, to the given event types.
+// Without filters, all audit events are streamed. The added filters are
+// returned.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationauditeventsstreamingdestinationeventsadd
+func (s *AuditEventStreamingService) AddAuditEventStreamingEventTypeFilters(destination string, eventTypeFilters []string, options ...RequestOptionFunc) ([]string, *Response, error) {
+	input := map[string]interface{}{
+		"destinationId":    destination,
+		"eventTypeFilters": eventTypeFilters,
+	}
+
+	var result struct {
+		EventTypeFilters []string `json:"eventTypeFilters"`
+	}
+	resp, err := s.mutate("auditEventsStreamingDestinationEventsAdd", "AuditEventsStreamingDestinationEventsAddInput", "\n    eventTypeFilters", input, &result, options)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.EventTypeFilters, resp, nil
+}
+
+// DeleteAuditEventStreamingEventTypeFilters removes event type filters from
+// an audit event streaming destination, identified by its global ID.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationauditeventsstreamingdestinationeventsremove
+func (s *AuditEventStreamingService) DeleteAuditEventStreamingEventTypeFilters(destination string, eventTypeFilters []string, options ...RequestOptionFunc) (*Response, error) {
+	input := map[string]interface{}{
+		"destinationId":    destination,
+		"eventTypeFilters": eventTypeFilters,
+	}
+
+	return s.mutate("auditEventsStreamingDestinationEventsRemove", "AuditEventsStreamingDestinationEventsRemoveInput", "", input, nil, options)
+}
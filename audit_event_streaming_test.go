@@ -0,0 +1,197 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListAuditEventStreamingDestinations(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var req graphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "top", req.Variables["fullPath"])
+
+		if req.Variables["after"] == nil {
+			fmt.Fprint(w, `{"data": {"group": {"externalAuditEventDestinations": {
+				"nodes": [{
+					"id": "gid://gitlab/AuditEvents::ExternalAuditEventDestination/1",
+					"name": "splunk",
+					"destinationUrl": "https://splunk.example.com/services/collector",
+					"verificationToken": "token",
+					"eventTypeFilters": ["user_created"],
+					"headers": {"nodes": [{"id": "gid://gitlab/AuditEvents::Streaming::Header/2", "key": "Authorization", "value": "Splunk secret", "active": true}]},
+					"namespaceFilter": {"namespace": {"id": "gid://gitlab/Namespaces::ProjectNamespace/5", "fullPath": "top/app"}}
+				}],
+				"pageInfo": {"hasNextPage": true, "endCursor": "next"}
+			}}}}`)
+			return
+		}
+
+		assert.Equal(t, "next", req.Variables["after"])
+		fmt.Fprint(w, `{"data": {"group": {"externalAuditEventDestinations": {
+			"nodes": [{"id": "gid://gitlab/AuditEvents::ExternalAuditEventDestination/3", "name": "siem", "eventTypeFilters": [], "headers": {"nodes": []}}],
+			"pageInfo": {"hasNextPage": false, "endCursor": ""}
+		}}}}`)
+	})
+
+	ds, err := client.AuditEventStreaming.ListAuditEventStreamingDestinations("top")
+	require.NoError(t, err)
+
+	want := []*AuditEventStreamingDestination{
+		{
+			ID:                "gid://gitlab/AuditEvents::ExternalAuditEventDestination/1",
+			Name:              "splunk",
+			DestinationURL:    "https://splunk.example.com/services/collector",
+			VerificationToken: "token",
+			Headers:           []*AuditEventStreamingHeader{{ID: "gid://gitlab/AuditEvents::Streaming::Header/2", Key: "Authorization", Value: "Splunk secret", Active: true}},
+			EventTypeFilters:  []string{"user_created"},
+			NamespaceFilter:   &AuditEventStreamingNamespace{ID: 5, FullPath: "top/app"},
+		},
+		{
+			ID:               "gid://gitlab/AuditEvents::ExternalAuditEventDestination/3",
+			Name:             "siem",
+			Headers:          []*AuditEventStreamingHeader{},
+			EventTypeFilters: []string{},
+		},
+	}
+	assert.Equal(t, want, ds)
+}
+
+func TestCreateAuditEventStreamingDestination(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		var req graphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Contains(t, req.Query, "externalAuditEventDestinationCreate(input: $input)")
+		assert.Equal(t, map[string]interface{}{
+			"groupPath":      "top",
+			"name":           "siem",
+			"destinationUrl": "https://siem.example.com",
+		}, req.Variables["input"])
+
+		fmt.Fprint(w, `{"data": {"externalAuditEventDestinationCreate": {"errors": [], "externalAuditEventDestination": {
+			"id": "gid://gitlab/AuditEvents::ExternalAuditEventDestination/3",
+			"name": "siem",
+			"destinationUrl": "https://siem.example.com",
+			"verificationToken": "generated"
+		}}}}`)
+	})
+
+	opt := &AuditEventStreamingDestinationOptions{
+		Name:           String("siem"),
+		DestinationURL: String("https://siem.example.com"),
+	}
+	d, _, err := client.AuditEventStreaming.CreateAuditEventStreamingDestination("top", opt)
+	require.NoError(t, err)
+
+	want := &AuditEventStreamingDestination{
+		ID:                "gid://gitlab/AuditEvents::ExternalAuditEventDestination/3",
+		Name:              "siem",
+		DestinationURL:    "https://siem.example.com",
+		VerificationToken: "generated",
+	}
+	assert.Equal(t, want, d)
+}
+
+func TestDeleteAuditEventStreamingDestinationMutationError(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		var req graphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, map[string]interface{}{"id": "gid://gitlab/AuditEvents::ExternalAuditEventDestination/3"}, req.Variables["input"])
+
+		fmt.Fprint(w, `{"data": {"externalAuditEventDestinationDestroy": {"errors": ["not found"]}}}`)
+	})
+
+	_, err := client.AuditEventStreaming.DeleteAuditEventStreamingDestination("gid://gitlab/AuditEvents::ExternalAuditEventDestination/3")
+	assert.EqualError(t, err, "externalAuditEventDestinationDestroy: [not found]")
+}
+
+func TestAddAuditEventStreamingHeader(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		var req graphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Contains(t, req.Query, "auditEventsStreamingHeadersCreate(input: $input)")
+		assert.Equal(t, map[string]interface{}{
+			"destinationId": "gid://gitlab/AuditEvents::ExternalAuditEventDestination/3",
+			"key":           "X-Source",
+			"value":         "gitlab",
+			"active":        true,
+		}, req.Variables["input"])
+
+		fmt.Fprint(w, `{"data": {"auditEventsStreamingHeadersCreate": {"errors": [], "header": {
+			"id": "gid://gitlab/AuditEvents::Streaming::Header/4", "key": "X-Source", "value": "gitlab", "active": true
+		}}}}`)
+	})
+
+	opt := &AuditEventStreamingHeaderOptions{Key: String("X-Source"), Value: String("gitlab"), Active: Bool(true)}
+	h, _, err := client.AuditEventStreaming.AddAuditEventStreamingHeader("gid://gitlab/AuditEvents::ExternalAuditEventDestination/3", opt)
+	require.NoError(t, err)
+
+	want := &AuditEventStreamingHeader{ID: "gid://gitlab/AuditEvents::Streaming::Header/4", Key: "X-Source", Value: "gitlab", Active: true}
+	assert.Equal(t, want, h)
+}
+
+func TestAuditEventStreamingEventTypeFilters(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		var req graphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		input := req.Variables["input"].(map[string]interface{})
+		assert.Equal(t, "gid://gitlab/AuditEvents::ExternalAuditEventDestination/3", input["destinationId"])
+
+		switch {
+		case assert.ObjectsAreEqual([]interface{}{"user_created", "project_deleted"}, input["eventTypeFilters"]):
+			assert.Contains(t, req.Query, "auditEventsStreamingDestinationEventsAdd(input: $input)")
+			fmt.Fprint(w, `{"data": {"auditEventsStreamingDestinationEventsAdd": {"errors": [], "eventTypeFilters": ["user_created", "project_deleted"]}}}`)
+		default:
+			assert.Contains(t, req.Query, "auditEventsStreamingDestinationEventsRemove(input: $input)")
+			assert.Equal(t, []interface{}{"user_created"}, input["eventTypeFilters"])
+			fmt.Fprint(w, `{"data": {"auditEventsStreamingDestinationEventsRemove": {"errors": []}}}`)
+		}
+	})
+
+	destination := "gid://gitlab/AuditEvents::ExternalAuditEventDestination/3"
+
+	filters, _, err := client.AuditEventStreaming.AddAuditEventStreamingEventTypeFilters(destination, []string{"user_created", "project_deleted"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"user_created", "project_deleted"}, filters)
+
+	_, err = client.AuditEventStreaming.DeleteAuditEventStreamingEventTypeFilters(destination, []string{"user_created"})
+	require.NoError(t, err)
+}
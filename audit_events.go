@@ -1,12 +1,15 @@
 package gitlab
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"sort"
 	"time"
 )
 
-// AuditEvent represents an audit event for a group or project.
+// AuditEvent represents an audit event for the instance, a group or a
+// project.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/audit_events.html
 type AuditEvent struct {
@@ -14,6 +17,7 @@ type AuditEvent struct {
 	AuthorID   int               `json:"author_id"`
 	EntityID   int               `json:"entity_id"`
 	EntityType string            `json:"entity_type"`
+	EventName  string            `json:"event_name"`
 	Details    AuditEventDetails `json:"details"`
 	CreatedAt  *time.Time        `json:"created_at"`
 }
@@ -40,8 +44,8 @@ type AuditEventDetails struct {
 	EntityPath    string      `json:"entity_path"`
 }
 
-// AuditEventsService handles communication with the project/group audit
-// event related methods of the GitLab API.
+// AuditEventsService handles communication with the instance, group and
+// project audit event related methods of the GitLab API.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/audit_events.html
 type AuditEventsService struct {
@@ -58,6 +62,61 @@ type ListAuditEventsOptions struct {
 	CreatedBefore *time.Time `url:"created_before,omitempty" json:"created_before,omitempty"`
 }
 
+// ListInstanceAuditEventsOptions represents the available
+// ListInstanceAuditEvents() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/audit_events.html#retrieve-all-instance-audit-events
+type ListInstanceAuditEventsOptions struct {
+	ListOptions
+	CreatedAfter  *time.Time `url:"created_after,omitempty" json:"created_after,omitempty"`
+	CreatedBefore *time.Time `url:"created_before,omitempty" json:"created_before,omitempty"`
+	EntityType    *string    `url:"entity_type,omitempty" json:"entity_type,omitempty"`
+	EntityID      *int       `url:"entity_id,omitempty" json:"entity_id,omitempty"`
+}
+
+// ListInstanceAuditEvents gets a list of audit events of the instance. This
+// requires administrator access.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/audit_events.html#retrieve-all-instance-audit-events
+func (s *AuditEventsService) ListInstanceAuditEvents(opt *ListInstanceAuditEventsOptions, options ...RequestOptionFunc) ([]*AuditEvent, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "audit_events", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var aes []*AuditEvent
+	resp, err := s.client.Do(req, &aes)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return aes, resp, err
+}
+
+// GetInstanceAuditEvent gets a specific instance audit event. This requires
+// administrator access.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/audit_events.html#retrieve-single-instance-audit-event
+func (s *AuditEventsService) GetInstanceAuditEvent(event int, options ...RequestOptionFunc) (*AuditEvent, *Response, error) {
+	u := fmt.Sprintf("audit_events/%d", event)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ae := new(AuditEvent)
+	resp, err := s.client.Do(req, ae)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ae, resp, err
+}
+
 // ListGroupAuditEvents gets a list of audit events for the specified group
 // viewable by the authenticated user.
 //
@@ -156,3 +215,138 @@ func (s *AuditEventsService) GetProjectAuditEvent(pid interface{}, event int, op
 
 	return ae, resp, err
 }
+
+// AuditEventCursor represents the position of an audit event stream. Store
+// the cursor of the last handled event to resume a stream later.
+type AuditEventCursor struct {
+	ID        int
+	CreatedAt time.Time
+}
+
+// AuditEventCursorOf returns the cursor pointing at the given event.
+func AuditEventCursorOf(ae *AuditEvent) *AuditEventCursor {
+	c := &AuditEventCursor{ID: ae.ID}
+	if ae.CreatedAt != nil {
+		c.CreatedAt = *ae.CreatedAt
+	}
+	return c
+}
+
+// StreamAuditEventsOptions represents the available Stream() options.
+type StreamAuditEventsOptions struct {
+	// GroupID or ProjectID selects the group or project to stream the audit
+	// events of. When neither is set, the audit events of the instance are
+	// streamed, which requires administrator access.
+	GroupID   interface{}
+	ProjectID interface{}
+
+	// Since is the cursor of the last event handled before. When nil, only
+	// events created after the stream is started are handled.
+	Since *AuditEventCursor
+
+	// PollInterval is the time between two polls for new events. Defaults to
+	// 30 seconds.
+	PollInterval time.Duration
+}
+
+// AuditEventHandlerFunc handles an audit event received by Stream.
+type AuditEventHandlerFunc func(ae *AuditEvent) error
+
+// Stream continuously polls for new audit events and calls fn for every new
+// event, oldest first, which makes it suitable for forwarding audit events to
+// a SIEM. The events are retrieved using keyset pagination. Stream returns
+// when ctx is done or when fn or a request returns an error; when fn returns
+// an error, the event is handled again by a stream resumed from the cursor of
+// the last successfully handled event.
+func (s *AuditEventsService) Stream(ctx context.Context, opt *StreamAuditEventsOptions, fn AuditEventHandlerFunc) error {
+	o := StreamAuditEventsOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	if o.GroupID != nil && o.ProjectID != nil {
+		return fmt.Errorf("only one of GroupID and ProjectID can be set")
+	}
+	if o.PollInterval <= 0 {
+		o.PollInterval = 30 * time.Second
+	}
+
+	cursor := AuditEventCursor{CreatedAt: time.Now()}
+	if o.Since != nil {
+		cursor = *o.Since
+	}
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		events, err := s.listAuditEventsSince(ctx, &o, cursor)
+		if err != nil {
+			return err
+		}
+		for _, ae := range events {
+			if err := fn(ae); err != nil {
+				return err
+			}
+			cursor = *AuditEventCursorOf(ae)
+		}
+
+		timer.Reset(o.PollInterval)
+	}
+}
+
+// listAuditEventsSince returns all audit events after the given cursor,
+// ordered by ID.
+func (s *AuditEventsService) listAuditEventsSince(ctx context.Context, opt *StreamAuditEventsOptions, cursor AuditEventCursor) ([]*AuditEvent, error) {
+	var createdAfter *time.Time
+	if !cursor.CreatedAt.IsZero() {
+		createdAfter = Time(cursor.CreatedAt)
+	}
+	listOpt := &ListAuditEventsOptions{
+		ListOptions:  ListOptions{PerPage: 100},
+		CreatedAfter: createdAfter,
+	}
+
+	var events []*AuditEvent
+	err := ForEachPage(func(options ...RequestOptionFunc) (*Response, error) {
+		var (
+			aes  []*AuditEvent
+			resp *Response
+			err  error
+		)
+		switch {
+		case opt.GroupID != nil:
+			aes, resp, err = s.ListGroupAuditEvents(opt.GroupID, listOpt, options...)
+		case opt.ProjectID != nil:
+			aes, resp, err = s.ListProjectAuditEvents(opt.ProjectID, listOpt, options...)
+		default:
+			aes, resp, err = s.ListInstanceAuditEvents(&ListInstanceAuditEventsOptions{
+				ListOptions:  listOpt.ListOptions,
+				CreatedAfter: listOpt.CreatedAfter,
+			}, options...)
+		}
+		if err != nil {
+			return resp, err
+		}
+		for _, ae := range aes {
+			if ae.ID > cursor.ID {
+				events = append(events, ae)
+			}
+		}
+		return resp, nil
+	}, WithKeysetPagination(), WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].ID < events[j].ID
+	})
+
+	return events, nil
+}
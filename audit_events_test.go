@@ -0,0 +1,153 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListInstanceAuditEvents(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/audit_events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/audit_events?entity_id=6&entity_type=Project")
+		fmt.Fprint(w, `[{"id":1,"author_id":1,"entity_id":6,"entity_type":"Project","event_name":"project_created","details":{"add":"project"}}]`)
+	})
+
+	opt := &ListInstanceAuditEventsOptions{EntityType: String("Project"), EntityID: Int(6)}
+	aes, _, err := client.AuditEvents.ListInstanceAuditEvents(opt)
+	if err != nil {
+		t.Fatalf("AuditEvents.ListInstanceAuditEvents returned error: %v", err)
+	}
+
+	want := []*AuditEvent{{
+		ID:         1,
+		AuthorID:   1,
+		EntityID:   6,
+		EntityType: "Project",
+		EventName:  "project_created",
+		Details:    AuditEventDetails{Add: "project"},
+	}}
+	if !reflect.DeepEqual(want, aes) {
+		t.Errorf("AuditEvents.ListInstanceAuditEvents returned %+v, want %+v", aes, want)
+	}
+}
+
+func TestGetInstanceAuditEvent(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/audit_events/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":1,"entity_type":"User"}`)
+	})
+
+	ae, _, err := client.AuditEvents.GetInstanceAuditEvent(1)
+	if err != nil {
+		t.Fatalf("AuditEvents.GetInstanceAuditEvent returned error: %v", err)
+	}
+
+	want := &AuditEvent{ID: 1, EntityType: "User"}
+	if !reflect.DeepEqual(want, ae) {
+		t.Errorf("AuditEvents.GetInstanceAuditEvent returned %+v, want %+v", ae, want)
+	}
+}
+
+func TestStreamAuditEvents(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	polls := 0
+	mux.HandleFunc("/api/v4/groups/1/audit_events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		q := r.URL.Query()
+		if q.Get("pagination") != "keyset" {
+			t.Errorf("Request pagination is %q, want keyset", q.Get("pagination"))
+		}
+		if q.Get("cursor") == "next" {
+			fmt.Fprint(w, `[{"id":3,"created_at":"2023-01-01T00:00:03Z"}]`)
+			return
+		}
+
+		polls++
+		switch polls {
+		case 1:
+			if q.Get("created_after") != "2023-01-01T00:00:01Z" {
+				t.Errorf("Request created_after is %q", q.Get("created_after"))
+			}
+			w.Header().Set("Link", fmt.Sprintf(`<%s/api/v4/groups/1/audit_events?cursor=next&pagination=keyset>; rel="next"`, server.URL))
+			fmt.Fprint(w, `[{"id":4,"created_at":"2023-01-01T00:00:04Z"},{"id":1,"created_at":"2023-01-01T00:00:01Z"},{"id":2,"created_at":"2023-01-01T00:00:02Z"}]`)
+		default:
+			if q.Get("created_after") != "2023-01-01T00:00:04Z" {
+				t.Errorf("Request created_after is %q", q.Get("created_after"))
+			}
+			fmt.Fprint(w, `[{"id":4,"created_at":"2023-01-01T00:00:04Z"},{"id":5,"created_at":"2023-01-01T00:00:05Z"}]`)
+		}
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stop := errors.New("stop")
+	var ids []int
+	err := client.AuditEvents.Stream(ctx, &StreamAuditEventsOptions{
+		GroupID:      1,
+		Since:        &AuditEventCursor{ID: 1, CreatedAt: time.Date(2023, 1, 1, 0, 0, 1, 0, time.UTC)},
+		PollInterval: time.Millisecond,
+	}, func(ae *AuditEvent) error {
+		ids = append(ids, ae.ID)
+		if ae.ID == 5 {
+			return stop
+		}
+		return nil
+	})
+	require.Equal(t, stop, err)
+	assert.Equal(t, []int{2, 3, 4, 5}, ids)
+	assert.Equal(t, 2, polls)
+}
+
+func TestStreamAuditEventsCanceled(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/audit_events", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[]`)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := client.AuditEvents.Stream(ctx, &StreamAuditEventsOptions{PollInterval: time.Millisecond}, func(ae *AuditEvent) error {
+		t.Errorf("Unexpected event %d", ae.ID)
+		return nil
+	})
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), "unexpected error: %v", err)
+
+	err = client.AuditEvents.Stream(context.Background(), &StreamAuditEventsOptions{GroupID: 1, ProjectID: 1}, nil)
+	assert.Error(t, err)
+}
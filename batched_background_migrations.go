@@ -0,0 +1,162 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// BatchedBackgroundMigrationsService handles communication with the batched
+// background migrations related methods of the GitLab API.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/admin/batched_background_migrations.html
+type BatchedBackgroundMigrationsService struct {
+	client *Client
+}
+
+// BatchedBackgroundMigration represents a GitLab batched background
+// migration.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/admin/batched_background_migrations.html
+type BatchedBackgroundMigration struct {
+	ID           int        `json:"id"`
+	JobClassName string     `json:"job_class_name"`
+	TableName    string     `json:"table_name"`
+	ColumnName   string     `json:"column_name"`
+	Status       string     `json:"status"`
+	Progress     float64    `json:"progress"`
+	CreatedAt    *time.Time `json:"created_at"`
+}
+
+func (m BatchedBackgroundMigration) String() string {
+	return Stringify(m)
+}
+
+// IsFinished reports whether the migration has finished, either because all
+// batches were processed or because it was finalized.
+func (m BatchedBackgroundMigration) IsFinished() bool {
+	return m.Status == "finished" || m.Status == "finalized"
+}
+
+// ListBatchedBackgroundMigrationsOptions represents the available
+// ListBatchedBackgroundMigrations() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/admin/batched_background_migrations.html#list-batched-background-migrations
+type ListBatchedBackgroundMigrationsOptions struct {
+	Database *string `url:"database,omitempty" json:"database,omitempty"`
+}
+
+// ListBatchedBackgroundMigrations gets a list of the batched background
+// migrations. Available only for admins.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/admin/batched_background_migrations.html#list-batched-background-migrations
+func (s *BatchedBackgroundMigrationsService) ListBatchedBackgroundMigrations(opt *ListBatchedBackgroundMigrationsOptions, options ...RequestOptionFunc) ([]*BatchedBackgroundMigration, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "admin/batched_background_migrations", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var bbms []*BatchedBackgroundMigration
+	resp, err := s.client.Do(req, &bbms)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return bbms, resp, err
+}
+
+// GetBatchedBackgroundMigrationOptions represents the available
+// GetBatchedBackgroundMigration(), PauseBatchedBackgroundMigration() and
+// ResumeBatchedBackgroundMigration() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/admin/batched_background_migrations.html
+type GetBatchedBackgroundMigrationOptions struct {
+	Database *string `url:"database,omitempty" json:"database,omitempty"`
+}
+
+// GetBatchedBackgroundMigration gets a single batched background migration.
+// Available only for admins.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/admin/batched_background_migrations.html#retrieve-a-batched-background-migration
+func (s *BatchedBackgroundMigrationsService) GetBatchedBackgroundMigration(id int, opt *GetBatchedBackgroundMigrationOptions, options ...RequestOptionFunc) (*BatchedBackgroundMigration, *Response, error) {
+	u := fmt.Sprintf("admin/batched_background_migrations/%d", id)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bbm := new(BatchedBackgroundMigration)
+	resp, err := s.client.Do(req, bbm)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return bbm, resp, err
+}
+
+// PauseBatchedBackgroundMigration pauses a batched background migration.
+// Available only for admins.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/admin/batched_background_migrations.html#pause-a-batched-background-migration
+func (s *BatchedBackgroundMigrationsService) PauseBatchedBackgroundMigration(id int, opt *GetBatchedBackgroundMigrationOptions, options ...RequestOptionFunc) (*BatchedBackgroundMigration, *Response, error) {
+	u := fmt.Sprintf("admin/batched_background_migrations/%d/pause", id)
+
+	req, err := s.client.NewRequest(http.MethodPut, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bbm := new(BatchedBackgroundMigration)
+	resp, err := s.client.Do(req, bbm)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return bbm, resp, err
+}
+
+// ResumeBatchedBackgroundMigration resumes a paused batched background
+// migration. Available only for admins.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/admin/batched_background_migrations.html#resume-a-batched-background-migration
+func (s *BatchedBackgroundMigrationsService) ResumeBatchedBackgroundMigration(id int, opt *GetBatchedBackgroundMigrationOptions, options ...RequestOptionFunc) (*BatchedBackgroundMigration, *Response, error) {
+	u := fmt.Sprintf("admin/batched_background_migrations/%d/resume", id)
+
+	req, err := s.client.NewRequest(http.MethodPut, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bbm := new(BatchedBackgroundMigration)
+	resp, err := s.client.Do(req, bbm)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return bbm, resp, err
+}
@@ -0,0 +1,93 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListBatchedBackgroundMigrations(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/admin/batched_background_migrations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/admin/batched_background_migrations?database=ci")
+		fmt.Fprint(w, `[
+			{"id": 1, "job_class_name": "CopyColumnUsingBackgroundMigrationJob", "table_name": "events", "status": "active", "progress": 50},
+			{"id": 2, "job_class_name": "BackfillNamespaceIds", "table_name": "issues", "status": "finished", "progress": 100}
+		]`)
+	})
+
+	bbms, _, err := client.BatchedBackgroundMigrations.ListBatchedBackgroundMigrations(&ListBatchedBackgroundMigrationsOptions{Database: String("ci")})
+	require.NoError(t, err)
+	require.Len(t, bbms, 2)
+
+	assert.Equal(t, "events", bbms[0].TableName)
+	assert.Equal(t, float64(50), bbms[0].Progress)
+	assert.False(t, bbms[0].IsFinished())
+	assert.True(t, bbms[1].IsFinished())
+}
+
+func TestGetBatchedBackgroundMigration(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/admin/batched_background_migrations/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 1, "job_class_name": "CopyColumnUsingBackgroundMigrationJob", "table_name": "events", "status": "active", "progress": 50}`)
+	})
+
+	bbm, _, err := client.BatchedBackgroundMigrations.GetBatchedBackgroundMigration(1, nil)
+	require.NoError(t, err)
+
+	want := &BatchedBackgroundMigration{
+		ID:           1,
+		JobClassName: "CopyColumnUsingBackgroundMigrationJob",
+		TableName:    "events",
+		Status:       "active",
+		Progress:     50,
+	}
+	assert.Equal(t, want, bbm)
+}
+
+func TestPauseAndResumeBatchedBackgroundMigration(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/admin/batched_background_migrations/1/pause", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		fmt.Fprint(w, `{"id": 1, "status": "paused"}`)
+	})
+	mux.HandleFunc("/api/v4/admin/batched_background_migrations/1/resume", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		fmt.Fprint(w, `{"id": 1, "status": "active"}`)
+	})
+
+	bbm, _, err := client.BatchedBackgroundMigrations.PauseBatchedBackgroundMigration(1, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "paused", bbm.Status)
+
+	bbm, _, err = client.BatchedBackgroundMigrations.ResumeBatchedBackgroundMigration(1, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "active", bbm.Status)
+}
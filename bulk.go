@@ -0,0 +1,375 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// BulkOperation represents a single API call executed by ExecuteBulk. The
+// given request options must be passed on to the API call, so the call can
+// be canceled when the context given to ExecuteBulk is done.
+type BulkOperation func(options ...RequestOptionFunc) (*Response, error)
+
+// BulkResult represents the result of a single BulkOperation.
+type BulkResult struct {
+	// Index is the index of the operation in the slice passed to ExecuteBulk.
+	Index int
+
+	// Response is the response of the last attempt, if any.
+	Response *Response
+
+	// Err is the error of the last attempt, or nil if the operation succeeded.
+	Err error
+
+	// Attempts is the number of times the operation was executed.
+	Attempts int
+}
+
+// BulkOptions represents the available ExecuteBulk() options.
+type BulkOptions struct {
+	// Concurrency is the maximum number of operations executed in parallel.
+	// Defaults to 4.
+	Concurrency int
+
+	// MaxRetries is the maximum number of times a failed operation is retried.
+	// Only operations that failed because of a network error, without
+	// receiving any response, are retried. Rate limited (429) requests are
+	// already retried by the client itself, unless the client was created
+	// using WithoutRetries.
+	MaxRetries int
+
+	// RetryServerErrors enables retrying operations that failed with a server
+	// error (>= 500) as well. Only enable this for idempotent operations, as
+	// the failed request may have been (partially) processed. Note that these
+	// retries come on top of the retries done by the client itself, unless
+	// the client was created using WithoutRetries.
+	RetryServerErrors bool
+
+	// RetryWait is the time to wait before the first retry of an operation.
+	// The wait time is doubled for every following retry. Defaults to 1s.
+	RetryWait time.Duration
+
+	// MinRemaining is the number of remaining requests, as reported by the
+	// RateLimit-Remaining header, at which all workers pause until the time
+	// reported by the RateLimit-Reset header. Defaults to 0, meaning workers
+	// only pause once the rate limit is exhausted.
+	MinRemaining int
+}
+
+// ExecuteBulk executes the given operations using a bounded number of
+// concurrent workers and returns a result for each operation, in the same
+// order as the operations.
+//
+// Failed operations are retried according to the given options. When a
+// response reports that the rate limit is (almost) exhausted, all workers
+// pause until the rate limit is reset, so large batches do not end up
+// hammering the API with requests that will be rejected anyway.
+//
+// When ctx is done, no new operations are started and the results of all
+// remaining operations contain the context error.
+func (c *Client) ExecuteBulk(ctx context.Context, ops []BulkOperation, opt *BulkOptions) []*BulkResult {
+	o := BulkOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	if o.Concurrency < 1 {
+		o.Concurrency = 4
+	}
+	if o.RetryWait <= 0 {
+		o.RetryWait = time.Second
+	}
+
+	p := &bulkPacer{}
+	results := make([]*BulkResult, len(ops))
+
+	indexes := make(chan int)
+	go func() {
+		defer close(indexes)
+		for i := range ops {
+			indexes <- i
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for w := 0; w < o.Concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i] = executeBulkOperation(ctx, i, ops[i], &o, p)
+			}
+		}()
+	}
+	wg.Wait()
+
+	return results
+}
+
+// executeBulkOperation executes a single operation, including its retries.
+func executeBulkOperation(ctx context.Context, index int, op BulkOperation, opt *BulkOptions, p *bulkPacer) *BulkResult {
+	result := &BulkResult{Index: index}
+	wait := opt.RetryWait
+
+	for {
+		if err := p.wait(ctx); err != nil {
+			result.Err = err
+			return result
+		}
+
+		result.Attempts++
+		result.Response, result.Err = op(WithContext(ctx))
+		p.update(result.Response, opt.MinRemaining)
+
+		if result.Err == nil || result.Attempts > opt.MaxRetries || !retryableBulkError(ctx, result.Response, result.Err, opt) {
+			return result
+		}
+
+		select {
+		case <-ctx.Done():
+			result.Err = ctx.Err()
+			return result
+		case <-time.After(wait):
+		}
+		wait *= 2
+	}
+}
+
+// retryableBulkError reports whether a failed operation can be retried.
+func retryableBulkError(ctx context.Context, resp *Response, err error, opt *BulkOptions) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if resp != nil && resp.Response != nil {
+		return opt.RetryServerErrors && resp.StatusCode >= 500
+	}
+
+	// Without a response, only retry errors returned by the transport. Other
+	// errors, like invalid arguments, are local and will never succeed.
+	var uerr *url.Error
+	return errors.As(err, &uerr)
+}
+
+// bulkPacer pauses all workers of a bulk execution once the rate limit is
+// (almost) exhausted.
+type bulkPacer struct {
+	mu    sync.Mutex
+	until time.Time
+}
+
+// wait blocks until the pacer allows new requests or ctx is done.
+func (p *bulkPacer) wait(ctx context.Context) error {
+	p.mu.Lock()
+	d := time.Until(p.until)
+	p.mu.Unlock()
+
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(d):
+		return nil
+	}
+}
+
+// update inspects the rate limit headers of resp and pauses the pacer until
+// the rate limit is reset when needed.
+func (p *bulkPacer) update(resp *Response, minRemaining int) {
+	if resp == nil || resp.Response == nil {
+		return
+	}
+
+	var until time.Time
+	if v := resp.Header.Get("Retry-After"); v != "" && resp.StatusCode == http.StatusTooManyRequests {
+		if secs, err := strconv.Atoi(v); err == nil {
+			until = time.Now().Add(time.Duration(secs) * time.Second)
+		}
+	}
+	if v := resp.Header.Get(headerRateRemaining); v != "" {
+		if remaining, err := strconv.Atoi(v); err == nil && remaining <= minRemaining {
+			if reset, _ := strconv.ParseInt(resp.Header.Get(headerRateReset), 10, 64); reset > 0 {
+				if t := time.Unix(reset, 0); t.After(until) {
+					until = t
+				}
+			}
+		}
+	}
+
+	p.mu.Lock()
+	if until.After(p.until) {
+		p.until = until
+	}
+	p.mu.Unlock()
+}
+
+// BulkUpdateIssuablesOptions represents the available BulkUpdateIssues() and
+// BulkUpdateMergeRequests() options.
+type BulkUpdateIssuablesOptions struct {
+	// AddLabels are the labels added to every issue or merge request.
+	AddLabels Labels
+
+	// RemoveLabels are the labels removed from every issue or merge request.
+	RemoveLabels Labels
+
+	// MilestoneID is the milestone assigned to every issue or merge request.
+	// Use 0 to unassign the milestone.
+	MilestoneID *int
+}
+
+// BulkIssuableResult represents the result of updating a single issue or
+// merge request in bulk.
+type BulkIssuableResult struct {
+	*BulkResult
+
+	// IID is the internal ID of the updated issue or merge request.
+	IID int
+}
+
+// BulkUpdateIssues applies the given label and milestone changes to the
+// issues with the given internal IDs, using ExecuteBulk. A result is returned
+// for every issue, so failed updates can be reported or retried.
+func (c *Client) BulkUpdateIssues(ctx context.Context, pid interface{}, iids []int, opt *BulkUpdateIssuablesOptions, bopt *BulkOptions) []*BulkIssuableResult {
+	if opt == nil {
+		opt = &BulkUpdateIssuablesOptions{}
+	}
+	uopt := &UpdateIssueOptions{
+		AddLabels:    opt.AddLabels,
+		RemoveLabels: opt.RemoveLabels,
+		MilestoneID:  opt.MilestoneID,
+	}
+
+	ops := make([]BulkOperation, len(iids))
+	for i, iid := range iids {
+		iid := iid
+		ops[i] = func(options ...RequestOptionFunc) (*Response, error) {
+			_, resp, err := c.Issues.UpdateIssue(pid, iid, uopt, options...)
+			return resp, err
+		}
+	}
+
+	return bulkIssuableResults(iids, c.ExecuteBulk(ctx, ops, bopt))
+}
+
+// BulkUpdateProjectIssues applies the given label and milestone changes to
+// all project issues matching the given list options. All pages of matching
+// issues are collected before any issue is updated, so the updates cannot
+// influence which issues match.
+func (c *Client) BulkUpdateProjectIssues(ctx context.Context, pid interface{}, lopt *ListProjectIssuesOptions, opt *BulkUpdateIssuablesOptions, bopt *BulkOptions) ([]*BulkIssuableResult, error) {
+	l := ListProjectIssuesOptions{}
+	if lopt != nil {
+		l = *lopt
+	}
+
+	var iids []int
+	for {
+		issues, resp, err := c.Issues.ListProjectIssues(pid, &l, WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		for _, i := range issues {
+			iids = append(iids, i.IID)
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		l.Page = resp.NextPage
+	}
+
+	return c.BulkUpdateIssues(ctx, pid, iids, opt, bopt), nil
+}
+
+// BulkUpdateMergeRequests applies the given label and milestone changes to
+// the merge requests with the given internal IDs, using ExecuteBulk. A result
+// is returned for every merge request, so failed updates can be reported or
+// retried.
+func (c *Client) BulkUpdateMergeRequests(ctx context.Context, pid interface{}, iids []int, opt *BulkUpdateIssuablesOptions, bopt *BulkOptions) []*BulkIssuableResult {
+	if opt == nil {
+		opt = &BulkUpdateIssuablesOptions{}
+	}
+	uopt := &UpdateMergeRequestOptions{
+		AddLabels:    opt.AddLabels,
+		RemoveLabels: opt.RemoveLabels,
+		MilestoneID:  opt.MilestoneID,
+	}
+
+	ops := make([]BulkOperation, len(iids))
+	for i, iid := range iids {
+		iid := iid
+		ops[i] = func(options ...RequestOptionFunc) (*Response, error) {
+			_, resp, err := c.MergeRequests.UpdateMergeRequest(pid, iid, uopt, options...)
+			return resp, err
+		}
+	}
+
+	return bulkIssuableResults(iids, c.ExecuteBulk(ctx, ops, bopt))
+}
+
+// BulkUpdateProjectMergeRequests applies the given label and milestone
+// changes to all project merge requests matching the given list options. All
+// pages of matching merge requests are collected before any merge request is
+// updated, so the updates cannot influence which merge requests match.
+func (c *Client) BulkUpdateProjectMergeRequests(ctx context.Context, pid interface{}, lopt *ListProjectMergeRequestsOptions, opt *BulkUpdateIssuablesOptions, bopt *BulkOptions) ([]*BulkIssuableResult, error) {
+	l := ListProjectMergeRequestsOptions{}
+	if lopt != nil {
+		l = *lopt
+	}
+
+	var iids []int
+	for {
+		mrs, resp, err := c.MergeRequests.ListProjectMergeRequests(pid, &l, WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		for _, mr := range mrs {
+			iids = append(iids, mr.IID)
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		l.Page = resp.NextPage
+	}
+
+	return c.BulkUpdateMergeRequests(ctx, pid, iids, opt, bopt), nil
+}
+
+// bulkIssuableResults pairs the results of a bulk execution with the internal
+// IDs the operations were created for.
+func bulkIssuableResults(iids []int, results []*BulkResult) []*BulkIssuableResult {
+	r := make([]*BulkIssuableResult, len(results))
+	for i, result := range results {
+		r[i] = &BulkIssuableResult{BulkResult: result, IID: iids[result.Index]}
+	}
+	return r
+}
+
+// ignoreNotModified treats a 304 Not Modified response, returned by GitLab
+// when an operation didn't change anything, as a success.
+func ignoreNotModified(resp *Response, err error) (*Response, error) {
+	if err != nil && resp != nil && resp.StatusCode == http.StatusNotModified {
+		return resp, nil
+	}
+	return resp, err
+}
@@ -0,0 +1,322 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// BulkImportsService handles communication with the group and project
+// migration (direct transfer) related methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/bulk_imports.html
+type BulkImportsService struct {
+	client *Client
+}
+
+// BulkImport represents a GitLab group or project migration.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/bulk_imports.html
+type BulkImport struct {
+	ID          int        `json:"id"`
+	Status      string     `json:"status"`
+	SourceType  string     `json:"source_type"`
+	SourceURL   string     `json:"source_url"`
+	HasFailures bool       `json:"has_failures"`
+	CreatedAt   *time.Time `json:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at"`
+}
+
+func (b BulkImport) String() string {
+	return Stringify(b)
+}
+
+// BulkImportEntity represents a single group or project that is part of a
+// GitLab migration.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/bulk_imports.html
+type BulkImportEntity struct {
+	ID                   int                        `json:"id"`
+	BulkImportID         int                        `json:"bulk_import_id"`
+	Status               string                     `json:"status"`
+	EntityType           string                     `json:"entity_type"`
+	SourceFullPath       string                     `json:"source_full_path"`
+	DestinationFullPath  string                     `json:"destination_full_path"`
+	DestinationName      string                     `json:"destination_name"`
+	DestinationSlug      string                     `json:"destination_slug"`
+	DestinationNamespace string                     `json:"destination_namespace"`
+	ParentID             int                        `json:"parent_id"`
+	NamespaceID          int                        `json:"namespace_id"`
+	ProjectID            int                        `json:"project_id"`
+	MigrateProjects      bool                       `json:"migrate_projects"`
+	HasFailures          bool                       `json:"has_failures"`
+	Failures             []*BulkImportEntityFailure `json:"failures"`
+	CreatedAt            *time.Time                 `json:"created_at"`
+	UpdatedAt            *time.Time                 `json:"updated_at"`
+}
+
+func (b BulkImportEntity) String() string {
+	return Stringify(b)
+}
+
+// BulkImportEntityFailure represents a failure that occurred while migrating
+// a bulk import entity.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/bulk_imports.html#get-list-of-failed-import-records-for-group-or-project-migration-entity
+type BulkImportEntityFailure struct {
+	Relation           string     `json:"relation"`
+	Step               string     `json:"step"`
+	ExceptionClass     string     `json:"exception_class"`
+	ExceptionMessage   string     `json:"exception_message"`
+	CorrelationIDValue string     `json:"correlation_id_value"`
+	PipelineClass      string     `json:"pipeline_class"`
+	PipelineStep       string     `json:"pipeline_step"`
+	SourceURL          string     `json:"source_url"`
+	SourceTitle        string     `json:"source_title"`
+	CreatedAt          *time.Time `json:"created_at"`
+}
+
+func (b BulkImportEntityFailure) String() string {
+	return Stringify(b)
+}
+
+// BulkImportConfigurationOptions represents the configuration used to
+// connect to the source GitLab instance of a migration.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/bulk_imports.html#start-a-new-group-or-project-migration
+type BulkImportConfigurationOptions struct {
+	URL         *string `url:"url,omitempty" json:"url,omitempty"`
+	AccessToken *string `url:"access_token,omitempty" json:"access_token,omitempty"`
+}
+
+// BulkImportEntityOptions represents a single group or project to migrate.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/bulk_imports.html#start-a-new-group-or-project-migration
+type BulkImportEntityOptions struct {
+	SourceType           *string `url:"source_type,omitempty" json:"source_type,omitempty"`
+	SourceFullPath       *string `url:"source_full_path,omitempty" json:"source_full_path,omitempty"`
+	DestinationSlug      *string `url:"destination_slug,omitempty" json:"destination_slug,omitempty"`
+	DestinationNamespace *string `url:"destination_namespace,omitempty" json:"destination_namespace,omitempty"`
+	MigrateProjects      *bool   `url:"migrate_projects,omitempty" json:"migrate_projects,omitempty"`
+}
+
+// StartMigrationOptions represents the available StartMigration() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/bulk_imports.html#start-a-new-group-or-project-migration
+type StartMigrationOptions struct {
+	Configuration *BulkImportConfigurationOptions `url:"configuration,omitempty" json:"configuration,omitempty"`
+	Entities      []*BulkImportEntityOptions      `url:"entities,omitempty" json:"entities,omitempty"`
+}
+
+// StartMigration starts a new group or project migration.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/bulk_imports.html#start-a-new-group-or-project-migration
+func (s *BulkImportsService) StartMigration(opt *StartMigrationOptions, options ...RequestOptionFunc) (*BulkImport, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodPost, "bulk_imports", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b := new(BulkImport)
+	resp, err := s.client.Do(req, b)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return b, resp, err
+}
+
+// ListBulkImportsOptions represents the available ListBulkImports() and
+// ListBulkImportEntities() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/bulk_imports.html#list-all-group-or-project-migrations
+type ListBulkImportsOptions struct {
+	ListOptions
+	Sort   *SortValue `url:"sort,omitempty" json:"sort,omitempty"`
+	Status *string    `url:"status,omitempty" json:"status,omitempty"`
+}
+
+// ListBulkImports gets a list of the group and project migrations of the
+// authenticated user.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/bulk_imports.html#list-all-group-or-project-migrations
+func (s *BulkImportsService) ListBulkImports(opt *ListBulkImportsOptions, options ...RequestOptionFunc) ([]*BulkImport, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "bulk_imports", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var bs []*BulkImport
+	resp, err := s.client.Do(req, &bs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return bs, resp, err
+}
+
+// GetBulkImport gets a single group or project migration.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/bulk_imports.html#get-group-or-project-migration-details
+func (s *BulkImportsService) GetBulkImport(id int, options ...RequestOptionFunc) (*BulkImport, *Response, error) {
+	u := fmt.Sprintf("bulk_imports/%d", id)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b := new(BulkImport)
+	resp, err := s.client.Do(req, b)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return b, resp, err
+}
+
+// CancelBulkImport cancels a group or project migration.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/bulk_imports.html#cancel-a-migration
+func (s *BulkImportsService) CancelBulkImport(id int, options ...RequestOptionFunc) (*BulkImport, *Response, error) {
+	u := fmt.Sprintf("bulk_imports/%d/cancel", id)
+
+	req, err := s.client.NewRequest(http.MethodPost, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b := new(BulkImport)
+	resp, err := s.client.Do(req, b)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return b, resp, err
+}
+
+// ListBulkImportEntities gets a list of the entities of a group or project
+// migration.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/bulk_imports.html#list-group-or-project-migration-entities
+func (s *BulkImportsService) ListBulkImportEntities(id int, opt *ListBulkImportsOptions, options ...RequestOptionFunc) ([]*BulkImportEntity, *Response, error) {
+	u := fmt.Sprintf("bulk_imports/%d/entities", id)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var es []*BulkImportEntity
+	resp, err := s.client.Do(req, &es)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return es, resp, err
+}
+
+// GetBulkImportEntity gets a single entity of a group or project migration.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/bulk_imports.html#get-group-or-project-migration-entity-details
+func (s *BulkImportsService) GetBulkImportEntity(id, entity int, options ...RequestOptionFunc) (*BulkImportEntity, *Response, error) {
+	u := fmt.Sprintf("bulk_imports/%d/entities/%d", id, entity)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	e := new(BulkImportEntity)
+	resp, err := s.client.Do(req, e)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return e, resp, err
+}
+
+// ListBulkImportEntityFailures gets a list of the failed import records of a
+// single entity of a group or project migration.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/bulk_imports.html#get-list-of-failed-import-records-for-group-or-project-migration-entity
+func (s *BulkImportsService) ListBulkImportEntityFailures(id, entity int, options ...RequestOptionFunc) ([]*BulkImportEntityFailure, *Response, error) {
+	u := fmt.Sprintf("bulk_imports/%d/entities/%d/failures", id, entity)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fs []*BulkImportEntityFailure
+	resp, err := s.client.Do(req, &fs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return fs, resp, err
+}
+
+// RetryBulkImportEntity retries the migration of a single failed entity by
+// starting a new migration containing only that entity, instead of
+// restarting the whole migration. As GitLab does not expose the source
+// instance configuration of a migration, it has to be given again.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/bulk_imports.html#start-a-new-group-or-project-migration
+func (s *BulkImportsService) RetryBulkImportEntity(id, entity int, config *BulkImportConfigurationOptions, options ...RequestOptionFunc) (*BulkImport, *Response, error) {
+	e, resp, err := s.GetBulkImportEntity(id, entity, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+	if e.Status != "failed" {
+		return nil, resp, fmt.Errorf("entity %d of bulk import %d has status %q, only failed entities can be retried", entity, id, e.Status)
+	}
+
+	// Older GitLab versions only return the destination name.
+	slug := e.DestinationSlug
+	if slug == "" {
+		slug = e.DestinationName
+	}
+
+	opt := &StartMigrationOptions{
+		Configuration: config,
+		Entities: []*BulkImportEntityOptions{{
+			SourceType:           String(e.EntityType),
+			SourceFullPath:       String(e.SourceFullPath),
+			DestinationSlug:      String(slug),
+			DestinationNamespace: String(e.DestinationNamespace),
+			MigrateProjects:      Bool(e.MigrateProjects),
+		}},
+	}
+
+	return s.StartMigration(opt, options...)
+}
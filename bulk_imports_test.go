@@ -0,0 +1,142 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListBulkImportEntities(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/bulk_imports/1/entities", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/bulk_imports/1/entities?status=failed")
+		fmt.Fprint(w, `[{
+			"id": 2,
+			"bulk_import_id": 1,
+			"status": "failed",
+			"entity_type": "project_entity",
+			"source_full_path": "source/project",
+			"destination_slug": "project",
+			"destination_namespace": "destination",
+			"has_failures": true
+		}]`)
+	})
+
+	entities, _, err := client.BulkImports.ListBulkImportEntities(1, &ListBulkImportsOptions{Status: String("failed")})
+	require.NoError(t, err)
+
+	want := []*BulkImportEntity{{
+		ID:                   2,
+		BulkImportID:         1,
+		Status:               "failed",
+		EntityType:           "project_entity",
+		SourceFullPath:       "source/project",
+		DestinationSlug:      "project",
+		DestinationNamespace: "destination",
+		HasFailures:          true,
+	}}
+	assert.Equal(t, want, entities)
+}
+
+func TestListBulkImportEntityFailures(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/bulk_imports/1/entities/2/failures", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{
+			"relation": "issues",
+			"exception_message": "Error!",
+			"exception_class": "StandardError",
+			"correlation_id_value": "06289e4b064329a69de7bb2d7a1b5a97",
+			"source_url": "https://gitlab.example/project/full/path/-/issues/1",
+			"source_title": "Issue title"
+		}]`)
+	})
+
+	failures, _, err := client.BulkImports.ListBulkImportEntityFailures(1, 2)
+	require.NoError(t, err)
+
+	want := []*BulkImportEntityFailure{{
+		Relation:           "issues",
+		ExceptionMessage:   "Error!",
+		ExceptionClass:     "StandardError",
+		CorrelationIDValue: "06289e4b064329a69de7bb2d7a1b5a97",
+		SourceURL:          "https://gitlab.example/project/full/path/-/issues/1",
+		SourceTitle:        "Issue title",
+	}}
+	assert.Equal(t, want, failures)
+}
+
+func TestRetryBulkImportEntity(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/bulk_imports/1/entities/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+			"id": 2,
+			"bulk_import_id": 1,
+			"status": "failed",
+			"entity_type": "group_entity",
+			"source_full_path": "source/group",
+			"destination_name": "group",
+			"destination_namespace": "destination",
+			"migrate_projects": true
+		}`)
+	})
+	mux.HandleFunc("/api/v4/bulk_imports", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"configuration":{"url":"https://source.example","access_token":"secret"},`+
+			`"entities":[{"source_type":"group_entity","source_full_path":"source/group",`+
+			`"destination_slug":"group","destination_namespace":"destination","migrate_projects":true}]}`)
+		fmt.Fprint(w, `{"id": 3, "status": "created", "source_type": "gitlab"}`)
+	})
+
+	config := &BulkImportConfigurationOptions{
+		URL:         String("https://source.example"),
+		AccessToken: String("secret"),
+	}
+
+	b, _, err := client.BulkImports.RetryBulkImportEntity(1, 2, config)
+	require.NoError(t, err)
+	assert.Equal(t, &BulkImport{ID: 3, Status: "created", SourceType: "gitlab"}, b)
+}
+
+func TestRetryBulkImportEntityNotFailed(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/bulk_imports/1/entities/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 2, "bulk_import_id": 1, "status": "finished"}`)
+	})
+	mux.HandleFunc("/api/v4/bulk_imports", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request to start a new migration")
+	})
+
+	_, _, err := client.BulkImports.RetryBulkImportEntity(1, 2, nil)
+	assert.Error(t, err)
+}
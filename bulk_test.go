@@ -0,0 +1,275 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecuteBulk(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"id":1,"archived":true}`)
+	})
+
+	var inFlight, maxInFlight int32
+	var ops []BulkOperation
+	for i := 1; i <= 10; i++ {
+		pid := i
+		ops = append(ops, func(options ...RequestOptionFunc) (*Response, error) {
+			n := atomic.AddInt32(&inFlight, 1)
+			defer atomic.AddInt32(&inFlight, -1)
+			for {
+				m := atomic.LoadInt32(&maxInFlight)
+				if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+
+			_, resp, err := client.Projects.ArchiveProject(pid, options...)
+			return resp, err
+		})
+	}
+
+	results := client.ExecuteBulk(context.Background(), ops, &BulkOptions{Concurrency: 3})
+	require.Len(t, results, 10)
+
+	for i, r := range results {
+		assert.Equal(t, i, r.Index)
+		assert.NoError(t, r.Err)
+		assert.Equal(t, 1, r.Attempts)
+		assert.Equal(t, http.StatusOK, r.Response.StatusCode)
+	}
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxInFlight), int32(3))
+}
+
+func TestExecuteBulkRetries(t *testing.T) {
+	_, server, client := setup(t)
+	defer teardown(server)
+
+	failing := func(status int, failures int) BulkOperation {
+		calls := 0
+		return func(options ...RequestOptionFunc) (*Response, error) {
+			calls++
+			if calls <= failures {
+				resp := &Response{Response: &http.Response{StatusCode: status, Header: http.Header{}}}
+				return resp, errors.New(http.StatusText(status))
+			}
+			return &Response{Response: &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}}, nil
+		}
+	}
+
+	erroring := func(err error, failures int) BulkOperation {
+		calls := 0
+		return func(options ...RequestOptionFunc) (*Response, error) {
+			calls++
+			if calls <= failures {
+				return nil, err
+			}
+			return &Response{Response: &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}}, nil
+		}
+	}
+
+	transportErr := &url.Error{Op: "Post", URL: "https://gitlab.example.com", Err: errors.New("connection reset by peer")}
+
+	ops := []BulkOperation{
+		erroring(transportErr, 2),
+		erroring(errors.New("invalid ID type"), 1),
+		failing(http.StatusInternalServerError, 1),
+		failing(http.StatusTooManyRequests, 1),
+		failing(http.StatusNotFound, 1),
+		erroring(transportErr, 5),
+	}
+
+	results := client.ExecuteBulk(context.Background(), ops, &BulkOptions{
+		MaxRetries: 2,
+		RetryWait:  time.Millisecond,
+	})
+	require.Len(t, results, 6)
+
+	assert.NoError(t, results[0].Err)
+	assert.Equal(t, 3, results[0].Attempts)
+
+	for _, r := range results[1:5] {
+		assert.Error(t, r.Err)
+		assert.Equal(t, 1, r.Attempts)
+	}
+
+	assert.Equal(t, transportErr, results[5].Err)
+	assert.Equal(t, 3, results[5].Attempts)
+}
+
+func TestExecuteBulkRetryServerErrors(t *testing.T) {
+	_, server, client := setup(t)
+	defer teardown(server)
+
+	failing := func(status int, failures int) BulkOperation {
+		calls := 0
+		return func(options ...RequestOptionFunc) (*Response, error) {
+			calls++
+			if calls <= failures {
+				resp := &Response{Response: &http.Response{StatusCode: status, Header: http.Header{}}}
+				return resp, errors.New(http.StatusText(status))
+			}
+			return &Response{Response: &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}}, nil
+		}
+	}
+
+	ops := []BulkOperation{
+		failing(http.StatusInternalServerError, 2),
+		failing(http.StatusNotFound, 1),
+		failing(http.StatusBadGateway, 5),
+	}
+
+	results := client.ExecuteBulk(context.Background(), ops, &BulkOptions{
+		MaxRetries:        2,
+		RetryWait:         time.Millisecond,
+		RetryServerErrors: true,
+	})
+	require.Len(t, results, 3)
+
+	assert.NoError(t, results[0].Err)
+	assert.Equal(t, 3, results[0].Attempts)
+
+	assert.Error(t, results[1].Err)
+	assert.Equal(t, 1, results[1].Attempts)
+
+	assert.Error(t, results[2].Err)
+	assert.Equal(t, 3, results[2].Attempts)
+}
+
+func TestExecuteBulkCanceled(t *testing.T) {
+	_, server, client := setup(t)
+	defer teardown(server)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	ops := []BulkOperation{
+		func(options ...RequestOptionFunc) (*Response, error) {
+			t.Error("operation executed after the context was canceled")
+			return nil, nil
+		},
+	}
+
+	results := client.ExecuteBulk(ctx, ops, nil)
+	require.Len(t, results, 1)
+	assert.Equal(t, context.Canceled, results[0].Err)
+	assert.Equal(t, 0, results[0].Attempts)
+}
+
+func TestBulkPacer(t *testing.T) {
+	reset := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	header := http.Header{}
+	header.Set(headerRateRemaining, "10")
+	header.Set(headerRateReset, strconv.FormatInt(reset.Unix(), 10))
+	resp := &Response{Response: &http.Response{StatusCode: http.StatusOK, Header: header}}
+
+	p := &bulkPacer{}
+	p.update(resp, 5)
+	assert.True(t, p.until.IsZero(), "pacer should not pause while enough requests remain")
+
+	header.Set(headerRateRemaining, "5")
+	p.update(resp, 5)
+	assert.Equal(t, reset, p.until)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, p.wait(ctx))
+}
+
+func TestBulkUpdateProjectIssues(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		assert.Equal(t, "opened", r.URL.Query().Get("state"))
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `[{"id":3,"iid":3}]`)
+			return
+		}
+		w.Header().Set("X-Next-Page", "2")
+		fmt.Fprint(w, `[{"id":1,"iid":1},{"id":2,"iid":2}]`)
+	})
+
+	var updated int32
+	mux.HandleFunc("/api/v4/projects/1/issues/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		if r.URL.Path == "/api/v4/projects/1/issues/2" {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"message":"403 Forbidden"}`)
+			return
+		}
+		testBody(t, r, `{"milestone_id":0,"add_labels":"bug","remove_labels":"triage"}`)
+		atomic.AddInt32(&updated, 1)
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	opt := &BulkUpdateIssuablesOptions{
+		AddLabels:    Labels{"bug"},
+		RemoveLabels: Labels{"triage"},
+		MilestoneID:  Int(0),
+	}
+	results, err := client.BulkUpdateProjectIssues(context.Background(), 1, &ListProjectIssuesOptions{State: String("opened")}, opt, nil)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	for i, r := range results {
+		assert.Equal(t, i+1, r.IID)
+		if r.IID == 2 {
+			assert.Error(t, r.Err)
+		} else {
+			assert.NoError(t, r.Err)
+		}
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&updated))
+}
+
+func TestBulkUpdateMergeRequests(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"add_labels":"ready"}`)
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	results := client.BulkUpdateMergeRequests(context.Background(), 1, []int{7, 8}, &BulkUpdateIssuablesOptions{AddLabels: Labels{"ready"}}, nil)
+	require.Len(t, results, 2)
+	assert.Equal(t, 7, results[0].IID)
+	assert.Equal(t, 8, results[1].IID)
+	assert.NoError(t, results[0].Err)
+	assert.NoError(t, results[1].Err)
+}
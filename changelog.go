@@ -0,0 +1,397 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// BuildChangelogOptions represents the available BuildChangelog() options.
+// Either ProjectID or GroupID must be set.
+type BuildChangelogOptions struct {
+	// ProjectID or GroupID select the merge requests of a project, or of all
+	// projects of a group and its subgroups.
+	ProjectID interface{}
+	GroupID   interface{}
+
+	// MergedAfter and MergedBefore limit the changelog to merge requests
+	// merged in the given range. Milestone and TargetBranch limit it to
+	// merge requests of a milestone and target branch.
+	MergedAfter  *time.Time
+	MergedBefore *time.Time
+	Milestone    *string
+	TargetBranch *string
+
+	// Categories are the labels the changelog is grouped by, for example
+	// "feature" and "bug". A merge request is listed in a single section:
+	// that of the first entry of Categories it was labeled with when it was
+	// merged, so the order of Categories sets the precedence of the labels.
+	// Merge requests with none of them are listed in Other.
+	Categories []string
+
+	// Concurrency is the number of merge requests whose events are
+	// retrieved concurrently. Defaults to 4.
+	Concurrency int
+}
+
+// Changelog represents the merged merge requests of a project or group,
+// grouped by label.
+type Changelog struct {
+	// Sections holds a section per category, in the order of the
+	// categories. Sections without merge requests are included.
+	Sections []*ChangelogSection
+
+	// Other are the merge requests without any of the category labels.
+	Other []*ChangelogEntry
+}
+
+func (c Changelog) String() string {
+	return Stringify(c)
+}
+
+// ChangelogSection represents the merge requests of a changelog category.
+type ChangelogSection struct {
+	Label   string
+	Entries []*ChangelogEntry
+}
+
+// ChangelogEntry represents a merged merge request in a changelog.
+type ChangelogEntry struct {
+	ProjectID      int
+	IID            int
+	Title          string
+	WebURL         string
+	Author         *BasicUser
+	MergedAt       *time.Time
+	MergeCommitSHA string
+
+	// Labels are the labels of the merge request when it was merged, as
+	// reconstructed from its label events.
+	Labels []string
+}
+
+// Markdown renders the changelog as Markdown, with a heading per section and
+// a list item per merge request. Empty sections are left out.
+func (c *Changelog) Markdown() string {
+	var b strings.Builder
+
+	writeSection := func(title string, entries []*ChangelogEntry) {
+		if len(entries) == 0 {
+			return
+		}
+		if b.Len() > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "### %s\n\n", title)
+		for _, e := range entries {
+			fmt.Fprintf(&b, "- %s ([!%d](%s))\n", e.Title, e.IID, e.WebURL)
+		}
+	}
+
+	for _, s := range c.Sections {
+		writeSection(s.Label, s.Entries)
+	}
+	writeSection("Other", c.Other)
+
+	return b.String()
+}
+
+// BuildChangelog composes a changelog from the merged merge requests of a
+// project or group, for example to write release notes. The labels of every
+// merge request are reconstructed from its label events as they were when
+// it was merged, so labels added or removed afterwards don't move it to
+// another section. When GitLab doesn't return the merge time, it is taken
+// from the state events. The first error stops building the changelog.
+func (s *MergeRequestsService) BuildChangelog(ctx context.Context, opt *BuildChangelogOptions) (*Changelog, error) {
+	if opt == nil || (opt.ProjectID == nil) == (opt.GroupID == nil) {
+		return nil, errors.New("either ProjectID or GroupID must be set")
+	}
+	concurrency := opt.Concurrency
+	if concurrency <= 0 {
+		concurrency = 4
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	mrs, err := s.listChangelogMergeRequests(ctx, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+		entries  []*ChangelogEntry
+	)
+
+	fail := func(err error) {
+		mu.Lock()
+		if firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+		mu.Unlock()
+	}
+
+	work := make(chan *MergeRequest)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for mr := range work {
+				e, err := s.changelogEntry(ctx, mr)
+				if err != nil {
+					fail(err)
+					continue
+				}
+				if !mergedInRange(e.MergedAt, opt) {
+					continue
+				}
+				mu.Lock()
+				entries = append(entries, e)
+				mu.Unlock()
+			}
+		}()
+	}
+
+feed:
+	for _, mr := range mrs {
+		select {
+		case work <- mr:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(work)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.MergedAt != nil && b.MergedAt != nil && !a.MergedAt.Equal(*b.MergedAt) {
+			return a.MergedAt.Before(*b.MergedAt)
+		}
+		if a.ProjectID != b.ProjectID {
+			return a.ProjectID < b.ProjectID
+		}
+		return a.IID < b.IID
+	})
+
+	c := &Changelog{}
+	sections := make(map[string]*ChangelogSection)
+	for _, label := range opt.Categories {
+		if _, ok := sections[label]; ok {
+			continue
+		}
+		sections[label] = &ChangelogSection{Label: label}
+		c.Sections = append(c.Sections, sections[label])
+	}
+
+	for _, e := range entries {
+		section := changelogCategory(e.Labels, opt.Categories)
+		if section == "" {
+			c.Other = append(c.Other, e)
+			continue
+		}
+		sections[section].Entries = append(sections[section].Entries, e)
+	}
+
+	return c, nil
+}
+
+// listChangelogMergeRequests lists the merged merge requests of the project
+// or group of opt. Merge requests are updated when merged, so MergedAfter
+// can be used as a lower bound of the update time.
+func (s *MergeRequestsService) listChangelogMergeRequests(ctx context.Context, opt *BuildChangelogOptions) ([]*MergeRequest, error) {
+	var mrs []*MergeRequest
+
+	if opt.ProjectID != nil {
+		listOpt := &ListProjectMergeRequestsOptions{
+			ListOptions:  ListOptions{PerPage: 100},
+			State:        String("merged"),
+			Milestone:    opt.Milestone,
+			TargetBranch: opt.TargetBranch,
+			UpdatedAfter: opt.MergedAfter,
+		}
+		err := ForEachPage(func(options ...RequestOptionFunc) (*Response, error) {
+			ms, resp, err := s.ListProjectMergeRequests(opt.ProjectID, listOpt, options...)
+			mrs = append(mrs, ms...)
+			return resp, err
+		}, WithContext(ctx))
+		return mrs, err
+	}
+
+	listOpt := &ListGroupMergeRequestsOptions{
+		ListOptions:  ListOptions{PerPage: 100},
+		State:        String("merged"),
+		Scope:        String("all"),
+		Milestone:    opt.Milestone,
+		TargetBranch: opt.TargetBranch,
+		UpdatedAfter: opt.MergedAfter,
+	}
+	err := ForEachPage(func(options ...RequestOptionFunc) (*Response, error) {
+		ms, resp, err := s.ListGroupMergeRequests(opt.GroupID, listOpt, options...)
+		mrs = append(mrs, ms...)
+		return resp, err
+	}, WithContext(ctx))
+	return mrs, err
+}
+
+// changelogEntry returns the changelog entry of a merged merge request.
+func (s *MergeRequestsService) changelogEntry(ctx context.Context, mr *MergeRequest) (*ChangelogEntry, error) {
+	e := &ChangelogEntry{
+		ProjectID:      mr.ProjectID,
+		IID:            mr.IID,
+		Title:          mr.Title,
+		WebURL:         mr.WebURL,
+		Author:         mr.Author,
+		MergedAt:       mr.MergedAt,
+		MergeCommitSHA: mr.MergeCommitSHA,
+	}
+	if mr.SquashCommitSHA != "" {
+		e.MergeCommitSHA = mr.SquashCommitSHA
+	}
+
+	if e.MergedAt == nil {
+		var err error
+		e.MergedAt, err = s.mergedAtFromStateEvents(ctx, mr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var events []*LabelEvent
+	listOpt := &ListLabelEventsOptions{ListOptions: ListOptions{PerPage: 100}}
+	err := ForEachPage(func(options ...RequestOptionFunc) (*Response, error) {
+		es, resp, err := s.client.ResourceLabelEvents.ListMergeRequestsLabelEvents(mr.ProjectID, mr.IID, listOpt, options...)
+		events = append(events, es...)
+		return resp, err
+	}, WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(events) == 0 {
+		e.Labels = append([]string(nil), mr.Labels...)
+	} else {
+		e.Labels = labelsAt(events, e.MergedAt)
+	}
+
+	return e, nil
+}
+
+// mergedAtFromStateEvents returns the time of the last merged state event
+// of a merge request.
+func (s *MergeRequestsService) mergedAtFromStateEvents(ctx context.Context, mr *MergeRequest) (*time.Time, error) {
+	var mergedAt *time.Time
+	listOpt := &ListStateEventsOptions{ListOptions: ListOptions{PerPage: 100}}
+	err := ForEachPage(func(options ...RequestOptionFunc) (*Response, error) {
+		es, resp, err := s.client.ResourceStateEvents.ListMergeStateEvents(mr.ProjectID, mr.IID, listOpt, options...)
+		for _, e := range es {
+			if e.State == MergedEventType && e.CreatedAt != nil && (mergedAt == nil || e.CreatedAt.After(*mergedAt)) {
+				mergedAt = e.CreatedAt
+			}
+		}
+		return resp, err
+	}, WithContext(ctx))
+	return mergedAt, err
+}
+
+// labelsAt replays the label events up to t and returns the resulting
+// labels, in the order they were added.
+func labelsAt(events []*LabelEvent, t *time.Time) []string {
+	events = append([]*LabelEvent(nil), events...)
+	sort.SliceStable(events, func(i, j int) bool {
+		a, b := events[i], events[j]
+		if a.CreatedAt != nil && b.CreatedAt != nil && !a.CreatedAt.Equal(*b.CreatedAt) {
+			return a.CreatedAt.Before(*b.CreatedAt)
+		}
+		return a.ID < b.ID
+	})
+
+	var labels []string
+	for _, e := range events {
+		if t != nil && e.CreatedAt != nil && e.CreatedAt.After(*t) {
+			break
+		}
+		// Events of deleted labels have no label name.
+		if e.Label.Name == "" {
+			continue
+		}
+		switch e.Action {
+		case "add":
+			if !containsString(labels, e.Label.Name) {
+				labels = append(labels, e.Label.Name)
+			}
+		case "remove":
+			for i, l := range labels {
+				if l == e.Label.Name {
+					labels = append(labels[:i], labels[i+1:]...)
+					break
+				}
+			}
+		}
+	}
+	return labels
+}
+
+// changelogCategory returns the first of categories that is in labels, or an
+// empty string if labels has none of them.
+func changelogCategory(labels, categories []string) string {
+	for _, c := range categories {
+		if containsString(labels, c) {
+			return c
+		}
+	}
+	return ""
+}
+
+func mergedInRange(mergedAt *time.Time, opt *BuildChangelogOptions) bool {
+	if mergedAt == nil {
+		return opt.MergedAfter == nil && opt.MergedBefore == nil
+	}
+	if opt.MergedAfter != nil && mergedAt.Before(*opt.MergedAfter) {
+		return false
+	}
+	if opt.MergedBefore != nil && !mergedAt.Before(*opt.MergedBefore) {
+		return false
+	}
+	return true
+}
+
+func containsString(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,126 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildChangelog(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/projects/1/merge_requests?milestone=v1.0&per_page=100&state=merged&updated_after=2023-01-01T00%3A00%3A00Z")
+		fmt.Fprint(w, `[
+			{"iid":1,"project_id":1,"title":"Add widgets","web_url":"https://gitlab.example.com/p/-/merge_requests/1","merged_at":"2023-01-03T00:00:00Z","labels":["feature"]},
+			{"iid":2,"project_id":1,"title":"Fix widgets","web_url":"https://gitlab.example.com/p/-/merge_requests/2","merged_at":"2023-01-02T00:00:00Z","labels":["feature"]},
+			{"iid":3,"project_id":1,"title":"Update docs","web_url":"https://gitlab.example.com/p/-/merge_requests/3","merged_at":"2023-01-04T00:00:00Z","labels":["docs"]},
+			{"iid":4,"project_id":1,"title":"Old fix","web_url":"https://gitlab.example.com/p/-/merge_requests/4","labels":["bug"]},
+			{"iid":5,"project_id":1,"title":"Too late","merged_at":"2023-02-01T00:00:00Z","labels":["bug"]}
+		]`)
+	})
+
+	labelEvents := map[int]string{
+		1: `[{"id":1,"action":"add","created_at":"2023-01-01T10:00:00Z","label":{"name":"feature"}}]`,
+		// The label was changed after merging, which must not move the entry.
+		2: `[
+			{"id":3,"action":"remove","created_at":"2023-01-05T00:00:00Z","label":{"name":"bug"}},
+			{"id":2,"action":"add","created_at":"2023-01-01T10:00:00Z","label":{"name":"bug"}},
+			{"id":4,"action":"add","created_at":"2023-01-05T00:00:00Z","label":{"name":"feature"}}
+		]`,
+		3: `[]`,
+		4: `[{"id":5,"action":"add","created_at":"2023-01-01T10:00:00Z","label":{"name":"bug"}}]`,
+		5: `[{"id":6,"action":"add","created_at":"2023-01-01T10:00:00Z","label":{"name":"bug"}}]`,
+	}
+	for iid, events := range labelEvents {
+		events := events
+		mux.HandleFunc(fmt.Sprintf("/api/v4/projects/1/merge_requests/%d/resource_label_events", iid), func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			fmt.Fprint(w, events)
+		})
+	}
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/4/resource_state_events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"id":1,"state":"closed","created_at":"2022-12-01T00:00:00Z"},{"id":2,"state":"merged","created_at":"2023-01-01T12:00:00Z"}]`)
+	})
+
+	after := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC)
+	changelog, err := client.MergeRequests.BuildChangelog(context.Background(), &BuildChangelogOptions{
+		ProjectID:    1,
+		MergedAfter:  &after,
+		MergedBefore: &before,
+		Milestone:    String("v1.0"),
+		Categories:   []string{"feature", "bug", "security"},
+	})
+	require.NoError(t, err)
+
+	iids := func(entries []*ChangelogEntry) []int {
+		var ids []int
+		for _, e := range entries {
+			ids = append(ids, e.IID)
+		}
+		return ids
+	}
+
+	require.Len(t, changelog.Sections, 3)
+	assert.Equal(t, "feature", changelog.Sections[0].Label)
+	assert.Equal(t, []int{1}, iids(changelog.Sections[0].Entries))
+	assert.Equal(t, "bug", changelog.Sections[1].Label)
+	assert.Equal(t, []int{4, 2}, iids(changelog.Sections[1].Entries))
+	assert.Empty(t, changelog.Sections[2].Entries)
+	assert.Equal(t, []int{3}, iids(changelog.Other))
+
+	mergedAt := time.Date(2023, time.January, 1, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, &mergedAt, changelog.Sections[1].Entries[0].MergedAt)
+	assert.Equal(t, []string{"docs"}, changelog.Other[0].Labels)
+
+	want := "### feature\n\n" +
+		"- Add widgets ([!1](https://gitlab.example.com/p/-/merge_requests/1))\n" +
+		"\n### bug\n\n" +
+		"- Old fix ([!4](https://gitlab.example.com/p/-/merge_requests/4))\n" +
+		"- Fix widgets ([!2](https://gitlab.example.com/p/-/merge_requests/2))\n" +
+		"\n### Other\n\n" +
+		"- Update docs ([!3](https://gitlab.example.com/p/-/merge_requests/3))\n"
+	assert.Equal(t, want, changelog.Markdown())
+}
+
+func TestBuildChangelogRequiresProjectOrGroup(t *testing.T) {
+	_, server, client := setup(t)
+	defer teardown(server)
+
+	_, err := client.MergeRequests.BuildChangelog(context.Background(), &BuildChangelogOptions{ProjectID: 1, GroupID: 2})
+	assert.Error(t, err)
+	_, err = client.MergeRequests.BuildChangelog(context.Background(), nil)
+	assert.Error(t, err)
+}
+
+func TestChangelogCategoryPrecedence(t *testing.T) {
+	categories := []string{"security", "bug"}
+	assert.Equal(t, "security", changelogCategory([]string{"bug", "security"}, categories))
+	assert.Equal(t, "bug", changelogCategory([]string{"docs", "bug"}, categories))
+	assert.Equal(t, "", changelogCategory([]string{"docs"}, categories))
+}
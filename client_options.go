@@ -17,9 +17,13 @@
 package gitlab
 
 import (
+	"crypto/tls"
+	"fmt"
 	"net/http"
+	"time"
 
 	retryablehttp "github.com/hashicorp/go-retryablehttp"
+	"golang.org/x/time/rate"
 )
 
 // ClientOptionFunc can be used to customize a new GitLab API client.
@@ -40,7 +44,10 @@ func WithCustomBackoff(backoff retryablehttp.Backoff) ClientOptionFunc {
 	}
 }
 
-// WithCustomLimiter injects a custom rate limiter to the client.
+// WithCustomLimiter injects a custom rate limiter to the client. Any type with
+// a Wait(context.Context) error method can be used, like a *rate.Limiter from
+// golang.org/x/time/rate. Without a custom limiter, the client limits itself
+// based on the RateLimit-Limit header of the first response.
 func WithCustomLimiter(limiter RateLimiter) ClientOptionFunc {
 	return func(c *Client) error {
 		c.configureLimiterOnce.Do(func() {
@@ -58,6 +65,62 @@ func WithCustomRetry(checkRetry retryablehttp.CheckRetry) ClientOptionFunc {
 	}
 }
 
+// WithRateLimit limits the client to the given number of requests per second,
+// allowing bursts of up to burst requests. Use it to make bulk scripts throttle
+// themselves before GitLab starts responding with 429 Too Many Requests.
+func WithRateLimit(requestsPerSecond float64, burst int) ClientOptionFunc {
+	return func(c *Client) error {
+		if requestsPerSecond <= 0 {
+			return fmt.Errorf("invalid rate limit: %v", requestsPerSecond)
+		}
+		if burst <= 0 {
+			return fmt.Errorf("invalid burst size: %d", burst)
+		}
+		return WithCustomLimiter(rate.NewLimiter(rate.Limit(requestsPerSecond), burst))(c)
+	}
+}
+
+// WithResponseHook adds a function that is called with the result of every
+// request, after the response body was decoded, for example to record
+// metrics. The response is nil when no response was received. Hooks are
+// called in the order they were added.
+func WithResponseHook(fn ResponseHookFunc) ClientOptionFunc {
+	return func(c *Client) error {
+		c.responseHooks = append(c.responseHooks, fn)
+		return nil
+	}
+}
+
+// WithRetryPolicy configures the number of retries and the backoff between
+// retries of requests that failed because of a rate limit or a server error.
+func WithRetryPolicy(policy RetryPolicy) ClientOptionFunc {
+	return func(c *Client) error {
+		if policy.MaxRetries < 0 {
+			return fmt.Errorf("invalid maximum number of retries: %d", policy.MaxRetries)
+		}
+		if policy.Jitter < 0 || policy.Jitter > 1 {
+			return fmt.Errorf("invalid jitter fraction: %v", policy.Jitter)
+		}
+		c.retryPolicy = &policy
+		c.client.RetryMax = policy.MaxRetries
+		return nil
+	}
+}
+
+// WithHTTP2 enables or disables HTTP/2 for the connections of the client. It
+// returns an error when a custom HTTP client without an *http.Transport is
+// used.
+func WithHTTP2(enabled bool) ClientOptionFunc {
+	return func(c *Client) error {
+		return c.configureTransport(func(t *http.Transport) {
+			t.ForceAttemptHTTP2 = enabled
+			if !enabled {
+				t.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+			}
+		})
+	}
+}
+
 // WithHTTPClient can be used to configure a custom HTTP client.
 func WithHTTPClient(httpClient *http.Client) ClientOptionFunc {
 	return func(c *Client) error {
@@ -66,6 +129,130 @@ func WithHTTPClient(httpClient *http.Client) ClientOptionFunc {
 	}
 }
 
+// WithIdleConnTimeout sets how long idle connections are kept open before
+// they are closed. It returns an error when a custom HTTP client without an
+// *http.Transport is used.
+func WithIdleConnTimeout(timeout time.Duration) ClientOptionFunc {
+	return func(c *Client) error {
+		if timeout < 0 {
+			return fmt.Errorf("invalid idle connection timeout: %v", timeout)
+		}
+		return c.configureTransport(func(t *http.Transport) {
+			t.IdleConnTimeout = timeout
+		})
+	}
+}
+
+// WithMaxConnsPerHost limits the number of connections to the GitLab
+// instance, including connections in use. Zero means no limit. It returns an
+// error when a custom HTTP client without an *http.Transport is used.
+func WithMaxConnsPerHost(n int) ClientOptionFunc {
+	return func(c *Client) error {
+		if n < 0 {
+			return fmt.Errorf("invalid maximum number of connections: %d", n)
+		}
+		return c.configureTransport(func(t *http.Transport) {
+			t.MaxConnsPerHost = n
+		})
+	}
+}
+
+// WithMaxIdleConnsPerHost sets the maximum number of idle connections to the
+// GitLab instance kept open for reuse. Services doing many concurrent requests
+// should set it to roughly their number of concurrent requests. It returns an
+// error when a custom HTTP client without an *http.Transport is used.
+func WithMaxIdleConnsPerHost(n int) ClientOptionFunc {
+	return func(c *Client) error {
+		if n <= 0 {
+			return fmt.Errorf("invalid maximum number of idle connections: %d", n)
+		}
+		return c.configureTransport(func(t *http.Transport) {
+			t.MaxIdleConnsPerHost = n
+			if t.MaxIdleConns != 0 && t.MaxIdleConns < n {
+				t.MaxIdleConns = n
+			}
+		})
+	}
+}
+
+// WithMaxDecompressionRatio limits the ratio between the decompressed and the
+// compressed size of the downloads the client decompresses itself, like
+// streamed export relations. When the ratio is exceeded, the download is
+// stopped with a *DecompressionRatioError. This protects against
+// decompression bombs.
+func WithMaxDecompressionRatio(ratio float64) ClientOptionFunc {
+	return func(c *Client) error {
+		if ratio <= 1 {
+			return fmt.Errorf("invalid decompression ratio %v, must be greater than 1", ratio)
+		}
+		c.maxDecompressionRatio = ratio
+		return nil
+	}
+}
+
+// WithMaxResponseBodySize limits the size, in bytes, of the response bodies
+// the client decodes, including error responses. Reading a larger body fails
+// with a *ResponseBodyTooLargeError. Downloads streamed into an io.Writer
+// given by the caller are not limited. The limit applies to the body after a
+// transparent decompression by the HTTP transport.
+func WithMaxResponseBodySize(size int64) ClientOptionFunc {
+	return func(c *Client) error {
+		if size <= 0 {
+			return fmt.Errorf("invalid response body size %d, must be greater than 0", size)
+		}
+		c.maxResponseBodySize = size
+		return nil
+	}
+}
+
+// WithRequestHook adds a function that is called with every request right
+// before it is sent, for example to log or count requests. Retries of a
+// request are not reported separately. The function may change the request,
+// but should not read its body. Hooks are called in the order they were
+// added.
+func WithRequestHook(fn RequestHookFunc) ClientOptionFunc {
+	return func(c *Client) error {
+		c.requestHooks = append(c.requestHooks, fn)
+		return nil
+	}
+}
+
+// WithRequestIDFunc sets a function that generates the X-Request-Id header
+// of every request that doesn't have one set using WithRequestID.
+func WithRequestIDFunc(fn func() string) ClientOptionFunc {
+	return func(c *Client) error {
+		c.requestIDFunc = fn
+		return nil
+	}
+}
+
+// WithTLSSessionCache enables TLS session resumption using a cache of the
+// given capacity, which saves a full TLS handshake for new connections. It
+// returns an error when a custom HTTP client without an *http.Transport is
+// used.
+func WithTLSSessionCache(capacity int) ClientOptionFunc {
+	return func(c *Client) error {
+		if capacity <= 0 {
+			return fmt.Errorf("invalid TLS session cache capacity: %d", capacity)
+		}
+		return c.configureTransport(func(t *http.Transport) {
+			if t.TLSClientConfig == nil {
+				t.TLSClientConfig = &tls.Config{}
+			}
+			t.TLSClientConfig.ClientSessionCache = tls.NewLRUClientSessionCache(capacity)
+		})
+	}
+}
+
+// WithoutJobTokenRestrictions allows a client created using NewJobClient to
+// call endpoints that are not known to accept CI/CD job tokens.
+func WithoutJobTokenRestrictions() ClientOptionFunc {
+	return func(c *Client) error {
+		c.jobTokenUnrestricted = true
+		return nil
+	}
+}
+
 // WithoutRetries disables the default retry logic.
 func WithoutRetries() ClientOptionFunc {
 	return func(c *Client) error {
@@ -73,3 +260,26 @@ func WithoutRetries() ClientOptionFunc {
 		return nil
 	}
 }
+
+// configureTransport applies fn to a copy of the transport of the HTTP client
+// used by c, so the transport of a client created using NewInstanceClient can
+// be changed without changing the transport of its parent client.
+func (c *Client) configureTransport(fn func(*http.Transport)) error {
+	var t *http.Transport
+	switch rt := c.client.HTTPClient.Transport.(type) {
+	case nil:
+		t = http.DefaultTransport.(*http.Transport).Clone()
+	case *http.Transport:
+		t = rt.Clone()
+	default:
+		return fmt.Errorf("unsupported transport %T, expected *http.Transport", rt)
+	}
+	fn(t)
+
+	// Copy the HTTP client, as it might be shared with other clients.
+	httpClient := *c.client.HTTPClient
+	httpClient.Transport = t
+	c.client.HTTPClient = &httpClient
+
+	return nil
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"sync"
 	"time"
 )
 
@@ -589,3 +590,81 @@ func (s *CommitsService) GetGPGSiganature(pid interface{}, sha string, options .
 
 	return sig, resp, err
 }
+
+// UnverifiedCommit represents a commit without a verified GPG signature. The
+// Signature is nil when the commit is not signed at all.
+type UnverifiedCommit struct {
+	Commit    *Commit
+	Signature *GPGSignature
+}
+
+// ListUnverifiedCommits walks all commits matching the given options (use
+// RefName with a "from..to" range to check a specific range) and returns
+// the commits that are either unsigned or whose signature is not verified.
+// Signatures are fetched using at most concurrency parallel requests.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/commits.html#get-gpg-signature-of-a-commit
+func (s *CommitsService) ListUnverifiedCommits(pid interface{}, opt *ListCommitsOptions, concurrency int, options ...RequestOptionFunc) ([]*UnverifiedCommit, error) {
+	o := ListCommitsOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	if o.PerPage == 0 {
+		o.PerPage = 100
+	}
+
+	var commits []*Commit
+	for {
+		cs, resp, err := s.ListCommits(pid, &o, options...)
+		if err != nil {
+			return nil, err
+		}
+		commits = append(commits, cs...)
+		if resp.NextPage == 0 {
+			break
+		}
+		o.Page = resp.NextPage
+	}
+
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	sigs := make([]*GPGSignature, len(commits))
+	errs := make([]error, len(commits))
+	sem := make(chan struct{}, concurrency)
+
+	var wg sync.WaitGroup
+	for i, c := range commits {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, sha string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			sig, resp, err := s.GetGPGSiganature(pid, sha, options...)
+			if err != nil {
+				// A 404 means the commit is not signed.
+				if resp == nil || resp.StatusCode != http.StatusNotFound {
+					errs[i] = err
+				}
+				return
+			}
+			sigs[i] = sig
+		}(i, c.ID)
+	}
+	wg.Wait()
+
+	var unverified []*UnverifiedCommit
+	for i, c := range commits {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		if sigs[i] == nil || sigs[i].VerificationStatus != "verified" {
+			unverified = append(unverified, &UnverifiedCommit{Commit: c, Signature: sigs[i]})
+		}
+	}
+
+	return unverified, nil
+}
@@ -256,3 +256,34 @@ func TestGetGPGSignature(t *testing.T) {
 
 	assert.Equal(t, want, sig)
 }
+
+func TestListUnverifiedCommits(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/projects/1/repository/commits?per_page=100&ref_name=v1.0.0..master")
+		fmt.Fprint(w, `[{"id":"aaa"},{"id":"bbb"},{"id":"ccc"}]`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/aaa/signature", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"gpg_key_id":1,"verification_status":"verified"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/bbb/signature", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"404 GPG Signature Not Found"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/ccc/signature", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"gpg_key_id":2,"verification_status":"unverified_key"}`)
+	})
+
+	opt := &ListCommitsOptions{RefName: String("v1.0.0..master")}
+	unverified, err := client.Commits.ListUnverifiedCommits(1, opt, 2)
+	require.NoError(t, err)
+
+	want := []*UnverifiedCommit{
+		{Commit: &Commit{ID: "bbb"}},
+		{Commit: &Commit{ID: "ccc"}, Signature: &GPGSignature{KeyID: 2, VerificationStatus: "unverified_key"}},
+	}
+	assert.Equal(t, want, unverified)
+}
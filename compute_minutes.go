@@ -0,0 +1,235 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"time"
+)
+
+// ComputeMinutesService handles communication with the compute minutes
+// related methods of the GitLab API. The monthly usage is only exposed by the
+// GraphQL API, so it uses the GraphQL endpoint. The quota of a namespace is
+// part of Namespace, the cost factors of a runner are part of RunnerDetails.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/ci/pipelines/compute_minutes.html
+type ComputeMinutesService struct {
+	client *Client
+}
+
+// ComputeMinutesUsage represents the compute minutes used by a namespace in
+// a single month.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#ciminutesnamespacemonthlyusage
+type ComputeMinutesUsage struct {
+	Month string
+	// MonthStart is the first day of the month.
+	MonthStart *ISOTime
+	// Minutes is the number of compute minutes used, after applying the
+	// cost factors of the runners.
+	Minutes int
+	// SharedRunnersDuration is the time the jobs ran on shared runners.
+	SharedRunnersDuration time.Duration
+	Projects              []*ProjectComputeMinutesUsage
+}
+
+func (u ComputeMinutesUsage) String() string {
+	return Stringify(u)
+}
+
+// ProjectComputeMinutesUsage represents the compute minutes used by a single
+// project of a namespace in a single month.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#ciminutesprojectmonthlyusage
+type ProjectComputeMinutesUsage struct {
+	ProjectID             int
+	Name                  string
+	FullPath              string
+	Minutes               int
+	SharedRunnersDuration time.Duration
+}
+
+func (u ProjectComputeMinutesUsage) String() string {
+	return Stringify(u)
+}
+
+// ListComputeMinutesUsageOptions represents the available
+// ListNamespaceComputeMinutesUsage() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#queryciminutesusage
+type ListComputeMinutesUsageOptions struct {
+	// Date limits the usage to the month of the given date.
+	Date *ISOTime
+}
+
+// computeMinutesUsageNode is the GraphQL representation of a
+// ComputeMinutesUsage.
+type computeMinutesUsageNode struct {
+	Month                 string   `json:"month"`
+	MonthIso8601          *ISOTime `json:"monthIso8601"`
+	Minutes               int      `json:"minutes"`
+	SharedRunnersDuration int      `json:"sharedRunnersDuration"`
+	Projects              struct {
+		Nodes []struct {
+			Minutes               int `json:"minutes"`
+			SharedRunnersDuration int `json:"sharedRunnersDuration"`
+			Project               *struct {
+				ID       string `json:"id"`
+				Name     string `json:"name"`
+				FullPath string `json:"fullPath"`
+			} `json:"project"`
+		} `json:"nodes"`
+		PageInfo graphQLPageInfo `json:"pageInfo"`
+	} `json:"projects"`
+}
+
+// addProjects adds the project usage of a page of the projects connection
+// to u.
+func (n *computeMinutesUsageNode) addProjects(u *ComputeMinutesUsage) error {
+	for _, p := range n.Projects.Nodes {
+		pu := &ProjectComputeMinutesUsage{
+			Minutes:               p.Minutes,
+			SharedRunnersDuration: time.Duration(p.SharedRunnersDuration) * time.Second,
+		}
+		if p.Project != nil {
+			id, err := ParseGlobalID(p.Project.ID)
+			if err != nil {
+				return err
+			}
+			pu.ProjectID = id
+			pu.Name = p.Project.Name
+			pu.FullPath = p.Project.FullPath
+		}
+		u.Projects = append(u.Projects, pu)
+	}
+	return nil
+}
+
+// ListNamespaceComputeMinutesUsage lists the monthly compute minutes usage of
+// a namespace, including the usage per project. Without a namespace, the
+// usage of the instance is returned, which requires administrator access.
+// Both the months and the projects of every month are paginated, so this
+// may send several requests.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#queryciminutesusage
+func (s *ComputeMinutesService) ListNamespaceComputeMinutesUsage(namespace int, opt *ListComputeMinutesUsageOptions, options ...RequestOptionFunc) ([]*ComputeMinutesUsage, error) {
+	query := `
+query($namespaceId: NamespaceID, $date: Date, $after: String, $projectsAfter: String) {
+  ciMinutesUsage(namespaceId: $namespaceId, date: $date, after: $after) {
+    nodes {
+      month
+      monthIso8601
+      minutes
+      sharedRunnersDuration
+      projects(after: $projectsAfter) {
+        nodes {
+          minutes
+          sharedRunnersDuration
+          project {
+            id
+            name
+            fullPath
+          }
+        }
+        pageInfo {
+          hasNextPage
+          endCursor
+        }
+      }
+    }
+    pageInfo {
+      hasNextPage
+      endCursor
+    }
+  }
+}`
+
+	variables := map[string]interface{}{}
+	if namespace != 0 {
+		variables["namespaceId"] = GlobalID("Namespace", namespace)
+	}
+	if opt != nil && opt.Date != nil {
+		variables["date"] = opt.Date.String()
+	}
+
+	type usagePage struct {
+		CIMinutesUsage struct {
+			Nodes    []*computeMinutesUsageNode `json:"nodes"`
+			PageInfo graphQLPageInfo            `json:"pageInfo"`
+		} `json:"ciMinutesUsage"`
+	}
+
+	var usage []*ComputeMinutesUsage
+	for {
+		var data usagePage
+		if _, err := s.client.doGraphQL(query, variables, &data, options); err != nil {
+			return nil, err
+		}
+
+		for _, n := range data.CIMinutesUsage.Nodes {
+			u := &ComputeMinutesUsage{
+				Month:                 n.Month,
+				MonthStart:            n.MonthIso8601,
+				Minutes:               n.Minutes,
+				SharedRunnersDuration: time.Duration(n.SharedRunnersDuration) * time.Second,
+			}
+			if err := n.addProjects(u); err != nil {
+				return nil, err
+			}
+
+			// Get the remaining projects of the month by limiting the
+			// usage to that month.
+			for pageInfo := n.Projects.PageInfo; pageInfo.HasNextPage; {
+				if n.MonthIso8601 == nil {
+					return nil, fmt.Errorf("can't list the remaining projects of %s without its start date", n.Month)
+				}
+				monthVariables := map[string]interface{}{
+					"date":          n.MonthIso8601.String(),
+					"projectsAfter": pageInfo.EndCursor,
+				}
+				if id, ok := variables["namespaceId"]; ok {
+					monthVariables["namespaceId"] = id
+				}
+
+				var month usagePage
+				if _, err := s.client.doGraphQL(query, monthVariables, &month, options); err != nil {
+					return nil, err
+				}
+				if len(month.CIMinutesUsage.Nodes) == 0 {
+					break
+				}
+				if err := month.CIMinutesUsage.Nodes[0].addProjects(u); err != nil {
+					return nil, err
+				}
+				pageInfo = month.CIMinutesUsage.Nodes[0].Projects.PageInfo
+			}
+
+			usage = append(usage, u)
+		}
+
+		if !data.CIMinutesUsage.PageInfo.HasNextPage {
+			break
+		}
+		variables["after"] = data.CIMinutesUsage.PageInfo.EndCursor
+	}
+
+	return usage, nil
+}
@@ -0,0 +1,124 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListNamespaceComputeMinutesUsage(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var req graphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "gid://gitlab/Namespace/7", req.Variables["namespaceId"])
+		assert.Equal(t, "2024-03-01", req.Variables["date"])
+
+		fmt.Fprint(w, `{"data": {"ciMinutesUsage": {"nodes": [{
+			"month": "March",
+			"monthIso8601": "2024-03-01",
+			"minutes": 120,
+			"sharedRunnersDuration": 3600,
+			"projects": {"nodes": [{
+				"minutes": 120,
+				"sharedRunnersDuration": 3600,
+				"project": {"id": "gid://gitlab/Project/3", "name": "project", "fullPath": "group/project"}
+			}]}
+		}]}}}`)
+	})
+
+	date := ISOTime(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))
+	usage, err := client.ComputeMinutes.ListNamespaceComputeMinutesUsage(7, &ListComputeMinutesUsageOptions{Date: &date})
+	require.NoError(t, err)
+
+	want := []*ComputeMinutesUsage{{
+		Month:                 "March",
+		MonthStart:            &date,
+		Minutes:               120,
+		SharedRunnersDuration: time.Hour,
+		Projects: []*ProjectComputeMinutesUsage{{
+			ProjectID:             3,
+			Name:                  "project",
+			FullPath:              "group/project",
+			Minutes:               120,
+			SharedRunnersDuration: time.Hour,
+		}},
+	}}
+	assert.Equal(t, want, usage)
+}
+
+func TestListNamespaceComputeMinutesUsagePagination(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		var req graphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "gid://gitlab/Namespace/7", req.Variables["namespaceId"])
+
+		switch {
+		case req.Variables["projectsAfter"] == "p1":
+			assert.Equal(t, "2024-03-01", req.Variables["date"])
+			fmt.Fprint(w, `{"data": {"ciMinutesUsage": {"nodes": [{
+				"month": "March", "monthIso8601": "2024-03-01",
+				"projects": {
+					"nodes": [{"minutes": 2, "project": {"id": "gid://gitlab/Project/2", "name": "b", "fullPath": "g/b"}}],
+					"pageInfo": {"hasNextPage": false, "endCursor": ""}
+				}
+			}], "pageInfo": {"hasNextPage": false, "endCursor": ""}}}}`)
+		case req.Variables["after"] == "m1":
+			fmt.Fprint(w, `{"data": {"ciMinutesUsage": {"nodes": [{
+				"month": "February", "monthIso8601": "2024-02-01", "minutes": 3,
+				"projects": {
+					"nodes": [{"minutes": 3, "project": {"id": "gid://gitlab/Project/1", "name": "a", "fullPath": "g/a"}}],
+					"pageInfo": {"hasNextPage": false, "endCursor": ""}
+				}
+			}], "pageInfo": {"hasNextPage": false, "endCursor": ""}}}}`)
+		default:
+			assert.Nil(t, req.Variables["date"])
+			fmt.Fprint(w, `{"data": {"ciMinutesUsage": {"nodes": [{
+				"month": "March", "monthIso8601": "2024-03-01", "minutes": 3,
+				"projects": {
+					"nodes": [{"minutes": 1, "project": {"id": "gid://gitlab/Project/1", "name": "a", "fullPath": "g/a"}}],
+					"pageInfo": {"hasNextPage": true, "endCursor": "p1"}
+				}
+			}], "pageInfo": {"hasNextPage": true, "endCursor": "m1"}}}}`)
+		}
+	})
+
+	usage, err := client.ComputeMinutes.ListNamespaceComputeMinutesUsage(7, nil)
+	require.NoError(t, err)
+
+	require.Len(t, usage, 2)
+	assert.Equal(t, "March", usage[0].Month)
+	require.Len(t, usage[0].Projects, 2)
+	assert.Equal(t, 1, usage[0].Projects[0].ProjectID)
+	assert.Equal(t, 2, usage[0].Projects[1].ProjectID)
+	assert.Equal(t, "February", usage[1].Month)
+	require.Len(t, usage[1].Projects, 1)
+}
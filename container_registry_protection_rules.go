@@ -0,0 +1,175 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// ContainerRegistryProtectionRulesService handles communication with the
+// container registry protection rules related methods of the GitLab API.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/container_repository_protection_rules.html
+type ContainerRegistryProtectionRulesService struct {
+	client *Client
+}
+
+// ContainerRegistryTagProtectionRule represents a GitLab container registry
+// tag protection rule.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/container_repository_protection_rules.html
+type ContainerRegistryTagProtectionRule struct {
+	ID                          int                            `json:"id"`
+	ProjectID                   int                            `json:"project_id"`
+	TagNamePattern              string                         `json:"tag_name_pattern"`
+	MinimumAccessLevelForPush   ProtectionRuleAccessLevelValue `json:"minimum_access_level_for_push"`
+	MinimumAccessLevelForDelete ProtectionRuleAccessLevelValue `json:"minimum_access_level_for_delete"`
+}
+
+func (s ContainerRegistryTagProtectionRule) String() string {
+	return Stringify(s)
+}
+
+// ListContainerRegistryTagProtectionRulesOptions represents the available
+// ListContainerRegistryTagProtectionRules() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/container_repository_protection_rules.html#list-container-registry-tag-protection-rules
+type ListContainerRegistryTagProtectionRulesOptions ListOptions
+
+// ListContainerRegistryTagProtectionRules gets a list of the container
+// registry tag protection rules of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/container_repository_protection_rules.html#list-container-registry-tag-protection-rules
+func (s *ContainerRegistryProtectionRulesService) ListContainerRegistryTagProtectionRules(pid interface{}, opt *ListContainerRegistryTagProtectionRulesOptions, options ...RequestOptionFunc) ([]*ContainerRegistryTagProtectionRule, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/registry/protection/tag/rules", pathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rules []*ContainerRegistryTagProtectionRule
+	resp, err := s.client.Do(req, &rules)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return rules, resp, err
+}
+
+// CreateContainerRegistryTagProtectionRuleOptions represents the available
+// CreateContainerRegistryTagProtectionRule() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/container_repository_protection_rules.html#create-a-container-registry-tag-protection-rule
+type CreateContainerRegistryTagProtectionRuleOptions struct {
+	TagNamePattern              *string                         `url:"tag_name_pattern,omitempty" json:"tag_name_pattern,omitempty"`
+	MinimumAccessLevelForPush   *ProtectionRuleAccessLevelValue `url:"minimum_access_level_for_push,omitempty" json:"minimum_access_level_for_push,omitempty"`
+	MinimumAccessLevelForDelete *ProtectionRuleAccessLevelValue `url:"minimum_access_level_for_delete,omitempty" json:"minimum_access_level_for_delete,omitempty"`
+}
+
+// CreateContainerRegistryTagProtectionRule creates a new container registry
+// tag protection rule.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/container_repository_protection_rules.html#create-a-container-registry-tag-protection-rule
+func (s *ContainerRegistryProtectionRulesService) CreateContainerRegistryTagProtectionRule(pid interface{}, opt *CreateContainerRegistryTagProtectionRuleOptions, options ...RequestOptionFunc) (*ContainerRegistryTagProtectionRule, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/registry/protection/tag/rules", pathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodPost, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rule := new(ContainerRegistryTagProtectionRule)
+	resp, err := s.client.Do(req, rule)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return rule, resp, err
+}
+
+// UpdateContainerRegistryTagProtectionRuleOptions represents the available
+// UpdateContainerRegistryTagProtectionRule() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/container_repository_protection_rules.html#update-a-container-registry-tag-protection-rule
+type UpdateContainerRegistryTagProtectionRuleOptions struct {
+	TagNamePattern              *string                         `url:"tag_name_pattern,omitempty" json:"tag_name_pattern,omitempty"`
+	MinimumAccessLevelForPush   *ProtectionRuleAccessLevelValue `url:"minimum_access_level_for_push,omitempty" json:"minimum_access_level_for_push,omitempty"`
+	MinimumAccessLevelForDelete *ProtectionRuleAccessLevelValue `url:"minimum_access_level_for_delete,omitempty" json:"minimum_access_level_for_delete,omitempty"`
+}
+
+// UpdateContainerRegistryTagProtectionRule updates an existing container
+// registry tag protection rule.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/container_repository_protection_rules.html#update-a-container-registry-tag-protection-rule
+func (s *ContainerRegistryProtectionRulesService) UpdateContainerRegistryTagProtectionRule(pid interface{}, rule int, opt *UpdateContainerRegistryTagProtectionRuleOptions, options ...RequestOptionFunc) (*ContainerRegistryTagProtectionRule, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/registry/protection/tag/rules/%d", pathEscape(project), rule)
+
+	req, err := s.client.NewRequest(http.MethodPatch, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(ContainerRegistryTagProtectionRule)
+	resp, err := s.client.Do(req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, err
+}
+
+// DeleteContainerRegistryTagProtectionRule deletes a container registry tag
+// protection rule.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/container_repository_protection_rules.html#delete-a-container-registry-tag-protection-rule
+func (s *ContainerRegistryProtectionRulesService) DeleteContainerRegistryTagProtectionRule(pid interface{}, rule int, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/registry/protection/tag/rules/%d", pathEscape(project), rule)
+
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
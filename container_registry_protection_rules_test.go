@@ -0,0 +1,88 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListContainerRegistryTagProtectionRules(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/7/registry/protection/tag/rules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{
+			"id": 1,
+			"project_id": 7,
+			"tag_name_pattern": "v*",
+			"minimum_access_level_for_push": "maintainer",
+			"minimum_access_level_for_delete": "owner"
+		}]`)
+	})
+
+	rules, _, err := client.ContainerRegistryProtectionRules.ListContainerRegistryTagProtectionRules(7, nil)
+	require.NoError(t, err)
+
+	want := []*ContainerRegistryTagProtectionRule{{
+		ID:                          1,
+		ProjectID:                   7,
+		TagNamePattern:              "v*",
+		MinimumAccessLevelForPush:   MaintainerProtectionRuleAccessLevel,
+		MinimumAccessLevelForDelete: OwnerProtectionRuleAccessLevel,
+	}}
+	assert.Equal(t, want, rules)
+}
+
+func TestCreateContainerRegistryTagProtectionRule(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/7/registry/protection/tag/rules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"tag_name_pattern":"release-*","minimum_access_level_for_push":"maintainer","minimum_access_level_for_delete":"admin"}`)
+		fmt.Fprint(w, `{"id": 2, "project_id": 7, "tag_name_pattern": "release-*", "minimum_access_level_for_push": "maintainer", "minimum_access_level_for_delete": "admin"}`)
+	})
+
+	opt := &CreateContainerRegistryTagProtectionRuleOptions{
+		TagNamePattern:              String("release-*"),
+		MinimumAccessLevelForPush:   ProtectionRuleAccessLevel(MaintainerProtectionRuleAccessLevel),
+		MinimumAccessLevelForDelete: ProtectionRuleAccessLevel(AdminProtectionRuleAccessLevel),
+	}
+
+	rule, _, err := client.ContainerRegistryProtectionRules.CreateContainerRegistryTagProtectionRule(7, opt)
+	require.NoError(t, err)
+	assert.Equal(t, "release-*", rule.TagNamePattern)
+}
+
+func TestDeleteContainerRegistryTagProtectionRule(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/7/registry/protection/tag/rules/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.ContainerRegistryProtectionRules.DeleteContainerRegistryTagProtectionRule(7, 2)
+	require.NoError(t, err)
+}
@@ -0,0 +1,100 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// DependenciesService handles communication with the dependency list related
+// methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/dependencies.html
+type DependenciesService struct {
+	client *Client
+}
+
+// Dependency represents a project dependency detected by dependency or
+// container scanning.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/dependencies.html
+type Dependency struct {
+	Name               string                     `json:"name"`
+	Version            string                     `json:"version"`
+	PackageManager     string                     `json:"package_manager"`
+	DependencyFilePath string                     `json:"dependency_file_path"`
+	Vulnerabilities    []*DependencyVulnerability `json:"vulnerabilities"`
+	Licenses           []*DependencyLicense       `json:"licenses"`
+}
+
+func (d Dependency) String() string {
+	return Stringify(d)
+}
+
+// DependencyVulnerability represents a vulnerability of a dependency.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/dependencies.html
+type DependencyVulnerability struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Severity string `json:"severity"`
+	URL      string `json:"url"`
+}
+
+// DependencyLicense represents a license of a dependency.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/dependencies.html
+type DependencyLicense struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// ListProjectDependenciesOptions represents the available
+// ListProjectDependencies() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/dependencies.html#list-project-dependencies
+type ListProjectDependenciesOptions struct {
+	ListOptions
+	PackageManager []string `url:"package_manager[],omitempty" json:"package_manager,omitempty"`
+}
+
+// ListProjectDependencies gets a list of the dependencies of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/dependencies.html#list-project-dependencies
+func (s *DependenciesService) ListProjectDependencies(pid interface{}, opt *ListProjectDependenciesOptions, options ...RequestOptionFunc) ([]*Dependency, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/dependencies", pathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ds []*Dependency
+	resp, err := s.client.Do(req, &ds)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ds, resp, err
+}
@@ -0,0 +1,67 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestListProjectDependencies(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/dependencies", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/projects/1/dependencies?package_manager%5B%5D=yarn&package_manager%5B%5D=bundler")
+		fmt.Fprint(w, `[
+			{
+				"name": "rails",
+				"version": "5.0.1",
+				"package_manager": "bundler",
+				"dependency_file_path": "Gemfile.lock",
+				"vulnerabilities": [{"id": 144827, "name": "DDoS", "severity": "unknown", "url": "https://gitlab.example.com/-/security/vulnerabilities/144827"}],
+				"licenses": [{"name": "MIT", "url": "https://opensource.org/licenses/MIT"}]
+			}
+		]`)
+	})
+
+	opt := &ListProjectDependenciesOptions{PackageManager: []string{"yarn", "bundler"}}
+	ds, _, err := client.Dependencies.ListProjectDependencies(1, opt)
+	if err != nil {
+		t.Fatalf("Dependencies.ListProjectDependencies returned error: %v", err)
+	}
+
+	want := []*Dependency{{
+		Name:               "rails",
+		Version:            "5.0.1",
+		PackageManager:     "bundler",
+		DependencyFilePath: "Gemfile.lock",
+		Vulnerabilities: []*DependencyVulnerability{{
+			ID:       144827,
+			Name:     "DDoS",
+			Severity: "unknown",
+			URL:      "https://gitlab.example.com/-/security/vulnerabilities/144827",
+		}},
+		Licenses: []*DependencyLicense{{Name: "MIT", URL: "https://opensource.org/licenses/MIT"}},
+	}}
+	if !reflect.DeepEqual(want, ds) {
+		t.Errorf("Dependencies.ListProjectDependencies returned %+v, want %+v", ds, want)
+	}
+}
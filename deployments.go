@@ -66,18 +66,42 @@ type Deployment struct {
 	} `json:"deployable"`
 }
 
+// DeploymentOrderByValue represents a column deployments can be ordered by.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/deployments.html#list-project-deployments
+type DeploymentOrderByValue string
+
+// These constants represent all valid columns to order deployments by.
+const (
+	DeploymentOrderByID         DeploymentOrderByValue = "id"
+	DeploymentOrderByIID        DeploymentOrderByValue = "iid"
+	DeploymentOrderByCreatedAt  DeploymentOrderByValue = "created_at"
+	DeploymentOrderByUpdatedAt  DeploymentOrderByValue = "updated_at"
+	DeploymentOrderByFinishedAt DeploymentOrderByValue = "finished_at"
+	DeploymentOrderByRef        DeploymentOrderByValue = "ref"
+)
+
+// DeploymentOrderBy is a helper routine that allocates a new DeploymentOrderByValue
+// to store v and returns a pointer to it.
+func DeploymentOrderBy(v DeploymentOrderByValue) *DeploymentOrderByValue {
+	p := new(DeploymentOrderByValue)
+	*p = v
+	return p
+}
+
 // ListProjectDeploymentsOptions represents the available ListProjectDeployments() options.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/deployments.html#list-project-deployments
 type ListProjectDeploymentsOptions struct {
 	ListOptions
-	OrderBy       *string    `url:"order_by,omitempty" json:"order_by,omitempty"`
-	Sort          *string    `url:"sort,omitempty" json:"sort,omitempty"`
-	UpdatedAfter  *time.Time `url:"updated_after,omitempty" json:"updated_after,omitempty"`
-	UpdatedBefore *time.Time `url:"updated_before,omitempty" json:"updated_before,omitempty"`
-	Environment   *string    `url:"environment,omitempty" json:"environment,omitempty"`
-	Status        *string    `url:"status,omitempty" json:"status,omitempty"`
+	OrderBy       *DeploymentOrderByValue `url:"order_by,omitempty" json:"order_by,omitempty"`
+	Sort          *SortValue              `url:"sort,omitempty" json:"sort,omitempty"`
+	UpdatedAfter  *time.Time              `url:"updated_after,omitempty" json:"updated_after,omitempty"`
+	UpdatedBefore *time.Time              `url:"updated_before,omitempty" json:"updated_before,omitempty"`
+	Environment   *string                 `url:"environment,omitempty" json:"environment,omitempty"`
+	Status        *string                 `url:"status,omitempty" json:"status,omitempty"`
 }
 
 // ListProjectDeployments gets a list of deployments in a project.
@@ -0,0 +1,196 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// DiffLineTypeValue represents the type of a line in a diff hunk.
+type DiffLineTypeValue string
+
+// List of available diff line types.
+const (
+	DiffLineContext DiffLineTypeValue = "context"
+	DiffLineAdded   DiffLineTypeValue = "added"
+	DiffLineRemoved DiffLineTypeValue = "removed"
+)
+
+// DiffLine represents a single line of a diff hunk. OldLine is 0 for added
+// lines and NewLine is 0 for removed lines.
+type DiffLine struct {
+	Type                 DiffLineTypeValue
+	Content              string
+	OldLine              int
+	NewLine              int
+	NoNewlineAtEndOfFile bool
+}
+
+func (l DiffLine) String() string {
+	return Stringify(l)
+}
+
+// DiffHunk represents a hunk of a unified diff.
+type DiffHunk struct {
+	OldStart int
+	OldLines int
+	NewStart int
+	NewLines int
+	Section  string
+	Lines    []*DiffLine
+}
+
+func (h DiffHunk) String() string {
+	return Stringify(h)
+}
+
+var diffHunkHeader = regexp.MustCompile(`^@@ -(\d+)(?:,(\d+))? \+(\d+)(?:,(\d+))? @@ ?(.*)$`)
+
+// ParseDiff parses a unified diff, as returned in the diff field of the
+// commit, merge request and repository compare endpoints, into its hunks.
+// File headers preceding a hunk are skipped, so the output of git diff for
+// one or more files can be parsed as well, in which case the hunks of all
+// files are returned in order.
+func ParseDiff(diff string) ([]*DiffHunk, error) {
+	var hunks []*DiffHunk
+	var hunk *DiffHunk
+	var oldLine, newLine, oldEnd, newEnd int
+
+	lines := strings.Split(strings.TrimSuffix(diff, "\n"), "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(line, "@@") {
+			m := diffHunkHeader.FindStringSubmatch(line)
+			if m == nil {
+				return nil, fmt.Errorf("invalid hunk header on line %d: %q", i+1, line)
+			}
+			hunk = &DiffHunk{
+				OldStart: atoiDefault(m[1], 0),
+				OldLines: atoiDefault(m[2], 1),
+				NewStart: atoiDefault(m[3], 0),
+				NewLines: atoiDefault(m[4], 1),
+				Section:  m[5],
+			}
+			hunks = append(hunks, hunk)
+			oldLine, newLine = hunk.OldStart, hunk.NewStart
+			oldEnd, newEnd = hunk.OldStart+hunk.OldLines, hunk.NewStart+hunk.NewLines
+			continue
+		}
+
+		if strings.HasPrefix(line, "diff --git ") {
+			// The header of the next file.
+			hunk = nil
+			continue
+		}
+
+		if hunk == nil || (oldLine >= oldEnd && newLine >= newEnd && !strings.HasPrefix(line, "\\")) {
+			// Skip file headers like "index", "---" and "+++", which follow
+			// the last line of the previous hunk.
+			continue
+		}
+
+		if line == "" {
+			// Some tools strip the leading space of empty context lines.
+			line = " "
+		}
+
+		switch line[0] {
+		case ' ':
+			hunk.Lines = append(hunk.Lines, &DiffLine{
+				Type:    DiffLineContext,
+				Content: line[1:],
+				OldLine: oldLine,
+				NewLine: newLine,
+			})
+			oldLine++
+			newLine++
+		case '-':
+			hunk.Lines = append(hunk.Lines, &DiffLine{
+				Type:    DiffLineRemoved,
+				Content: line[1:],
+				OldLine: oldLine,
+			})
+			oldLine++
+		case '+':
+			hunk.Lines = append(hunk.Lines, &DiffLine{
+				Type:    DiffLineAdded,
+				Content: line[1:],
+				NewLine: newLine,
+			})
+			newLine++
+		case '\\':
+			if len(hunk.Lines) > 0 {
+				hunk.Lines[len(hunk.Lines)-1].NoNewlineAtEndOfFile = true
+			}
+		default:
+			return nil, fmt.Errorf("invalid diff line %d: %q", i+1, line)
+		}
+	}
+
+	return hunks, nil
+}
+
+// Hunks parses the diff of the file into its hunks.
+func (d Diff) Hunks() ([]*DiffHunk, error) {
+	return ParseDiff(d.Diff)
+}
+
+// FindNewLine returns the line of the diff for the given line number in the
+// new version of the file, or nil if that line is not part of the diff. This
+// is useful to check if a comment can be placed on a line.
+func FindNewLine(hunks []*DiffHunk, line int) *DiffLine {
+	if line <= 0 {
+		return nil
+	}
+	for _, h := range hunks {
+		for _, l := range h.Lines {
+			if l.NewLine == line {
+				return l
+			}
+		}
+	}
+	return nil
+}
+
+// FindOldLine returns the line of the diff for the given line number in the
+// old version of the file, or nil if that line is not part of the diff.
+func FindOldLine(hunks []*DiffHunk, line int) *DiffLine {
+	if line <= 0 {
+		return nil
+	}
+	for _, h := range hunks {
+		for _, l := range h.Lines {
+			if l.OldLine == line {
+				return l
+			}
+		}
+	}
+	return nil
+}
+
+func atoiDefault(s string, def int) int {
+	if s == "" {
+		return def
+	}
+	i, err := strconv.Atoi(s)
+	if err != nil {
+		return def
+	}
+	return i
+}
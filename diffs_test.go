@@ -0,0 +1,123 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDiff(t *testing.T) {
+	d := Diff{
+		Diff: "--- a/main.go\n+++ b/main.go\n" +
+			"@@ -1,4 +1,5 @@ package main\n" +
+			" import \"fmt\"\n" +
+			"-func a() {}\n" +
+			"+func b() {}\n" +
+			"+func c() {}\n" +
+			"\n" +
+			" func main() {}\n" +
+			"@@ -10 +11 @@\n" +
+			"-old\n" +
+			"\\ No newline at end of file\n" +
+			"+new\n",
+	}
+
+	hunks, err := d.Hunks()
+	require.NoError(t, err)
+	require.Len(t, hunks, 2)
+
+	want := &DiffHunk{
+		OldStart: 1, OldLines: 4, NewStart: 1, NewLines: 5, Section: "package main",
+		Lines: []*DiffLine{
+			{Type: DiffLineContext, Content: `import "fmt"`, OldLine: 1, NewLine: 1},
+			{Type: DiffLineRemoved, Content: "func a() {}", OldLine: 2},
+			{Type: DiffLineAdded, Content: "func b() {}", NewLine: 2},
+			{Type: DiffLineAdded, Content: "func c() {}", NewLine: 3},
+			{Type: DiffLineContext, Content: "", OldLine: 3, NewLine: 4},
+			{Type: DiffLineContext, Content: "func main() {}", OldLine: 4, NewLine: 5},
+		},
+	}
+	assert.Equal(t, want, hunks[0])
+
+	want = &DiffHunk{
+		OldStart: 10, OldLines: 1, NewStart: 11, NewLines: 1,
+		Lines: []*DiffLine{
+			{Type: DiffLineRemoved, Content: "old", OldLine: 10, NoNewlineAtEndOfFile: true},
+			{Type: DiffLineAdded, Content: "new", NewLine: 11},
+		},
+	}
+	assert.Equal(t, want, hunks[1])
+
+	assert.Equal(t, "func c() {}", FindNewLine(hunks, 3).Content)
+	assert.Equal(t, "old", FindOldLine(hunks, 10).Content)
+	assert.Nil(t, FindNewLine(hunks, 7))
+	assert.Nil(t, FindNewLine(hunks, 0))
+}
+
+func TestParseDiffMultipleFiles(t *testing.T) {
+	diff := "diff --git a/a.go b/a.go\n" +
+		"index 1111111..2222222 100644\n" +
+		"--- a/a.go\n" +
+		"+++ b/a.go\n" +
+		"@@ -1,2 +1,2 @@\n" +
+		" package a\n" +
+		"-var x = 1\n" +
+		"+var x = 2\n" +
+		"diff --git a/b.go b/b.go\n" +
+		"new file mode 100644\n" +
+		"--- /dev/null\n" +
+		"+++ b/b.go\n" +
+		"@@ -0,0 +1 @@\n" +
+		"+package b\n" +
+		"--- a/c.go\n" +
+		"+++ b/c.go\n" +
+		"@@ -3 +3 @@\n" +
+		"-old\n" +
+		"+new\n"
+
+	hunks, err := ParseDiff(diff)
+	require.NoError(t, err)
+	require.Len(t, hunks, 3)
+
+	assert.Equal(t, []*DiffLine{
+		{Type: DiffLineContext, Content: "package a", OldLine: 1, NewLine: 1},
+		{Type: DiffLineRemoved, Content: "var x = 1", OldLine: 2},
+		{Type: DiffLineAdded, Content: "var x = 2", NewLine: 2},
+	}, hunks[0].Lines)
+	assert.Equal(t, []*DiffLine{
+		{Type: DiffLineAdded, Content: "package b", NewLine: 1},
+	}, hunks[1].Lines)
+	assert.Equal(t, []*DiffLine{
+		{Type: DiffLineRemoved, Content: "old", OldLine: 3},
+		{Type: DiffLineAdded, Content: "new", NewLine: 3},
+	}, hunks[2].Lines)
+}
+
+func TestParseDiffInvalid(t *testing.T) {
+	_, err := ParseDiff("@@ -1,2 +1,2 @@\n foo\n*bar\n")
+	assert.EqualError(t, err, `invalid diff line 3: "*bar"`)
+
+	_, err = ParseDiff("@@ invalid @@\n")
+	assert.EqualError(t, err, `invalid hunk header on line 1: "@@ invalid @@"`)
+
+	hunks, err := ParseDiff("")
+	require.NoError(t, err)
+	assert.Empty(t, hunks)
+}
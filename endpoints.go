@@ -0,0 +1,208 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"context"
+	"strings"
+)
+
+// endpointContextKey is the context key used to store the endpoint label of a
+// request.
+type endpointContextKey struct{}
+
+// endpointTemplate represents an endpoint registered using RegisterEndpoint.
+type endpointTemplate struct {
+	method   string
+	template string
+	segments []string
+}
+
+// match reports whether the given method and path segments match the
+// endpoint template.
+func (t *endpointTemplate) match(method string, segments []string) bool {
+	if t.method != "" && t.method != method {
+		return false
+	}
+	if len(t.segments) != len(segments) {
+		return false
+	}
+	for i, s := range t.segments {
+		if !strings.HasPrefix(s, ":") && s != segments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// RegisterEndpoint registers an endpoint template used to label requests
+// sent using Do. The template is a path relative to the base URL in
+// which segments starting with a colon match any value, for example
+// "projects/:id/custom_resources/:resource". An empty method matches requests
+// of any method.
+//
+// The endpoint label of a request can be retrieved from the request context
+// using EndpointFromContext, for instance in a custom http.RoundTripper that
+// records metrics. Registering templates for custom endpoints makes sure these
+// labels have a low cardinality.
+func (c *Client) RegisterEndpoint(method, template string) {
+	template = strings.Trim(template, "/")
+
+	c.endpointsLock.Lock()
+	defer c.endpointsLock.Unlock()
+
+	c.endpoints = append(c.endpoints, &endpointTemplate{
+		method:   method,
+		template: template,
+		segments: strings.Split(template, "/"),
+	})
+}
+
+// valueCollections are the path segments that are followed by a name rather
+// than a numeric ID, such as a group path, a tag name or a file path.
+var valueCollections = map[string]bool{
+	"blobs":              true,
+	"branches":           true,
+	"commits":            true,
+	"custom_attributes":  true,
+	"domains":            true,
+	"feature_flags":      true,
+	"features":           true,
+	"files":              true,
+	"groups":             true,
+	"labels":             true,
+	"namespaces":         true,
+	"projects":           true,
+	"protected_branches": true,
+	"protected_tags":     true,
+	"ref":                true,
+	"releases":           true,
+	"tags":               true,
+	"users":              true,
+	"variables":          true,
+	"wikis":              true,
+}
+
+// reservedSegments are the path segments that follow one of the
+// valueCollections but are part of the endpoint rather than a name.
+var reservedSegments = map[string]bool{
+	"import":           true,
+	"permalink":        true,
+	"remote-import":    true,
+	"remote-import-s3": true,
+}
+
+// isKeyword reports whether the path segment looks like a fixed part of an
+// endpoint, which consist of lowercase letters, underscores and dashes.
+func isKeyword(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && r != '_' && r != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+// endpointFor returns the endpoint label of a request for the given method
+// and path. If none of the registered templates match, a label is derived
+// from the path by replacing all segments that identify a resource (such as
+// project IDs, URL-encoded project paths, group paths and ref names) with
+// ":id", so the number of distinct labels stays bounded.
+func (c *Client) endpointFor(method, path string) string {
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	c.endpointsLock.RLock()
+	for _, t := range c.endpoints {
+		if t.match(method, segments) {
+			c.endpointsLock.RUnlock()
+			return t.template
+		}
+	}
+	c.endpointsLock.RUnlock()
+
+	normalized := make([]string, 0, len(segments))
+	for i, s := range segments {
+		if s == "artifacts" {
+			if rest := artifactsSegments(segments, i); rest != nil {
+				normalized = append(normalized, s)
+				normalized = append(normalized, rest...)
+				break
+			}
+		}
+		switch {
+		case i > 0 && segments[i-1] == "repository" && strings.HasPrefix(s, "archive."):
+			// The archive format is part of the path, e.g. archive.tar.gz.
+			s = "archive"
+		case !isKeyword(s):
+			s = ":id"
+		case i > 0 && valueCollections[segments[i-1]] && !reservedSegments[s]:
+			s = ":id"
+		}
+		normalized = append(normalized, s)
+	}
+	return strings.Join(normalized, "/")
+}
+
+// artifactsSegments returns the normalized path segments following the
+// "artifacts" segment at index i of a job artifacts endpoint, or nil if the
+// remaining segments should be normalized as usual. Both the ref name and the
+// artifact path of these endpoints can span multiple segments, so they are
+// collapsed into a single ":id" segment each.
+func artifactsSegments(segments []string, i int) []string {
+	rest := segments[i+1:]
+	if i < 1 || len(rest) == 0 {
+		return nil
+	}
+
+	if segments[i-1] == "jobs" {
+		// jobs/artifacts/:ref_name/download and
+		// jobs/artifacts/:ref_name/raw/*artifact_path
+		if rest[len(rest)-1] == "download" {
+			return []string{":id", "download"}
+		}
+		for i := 1; i < len(rest); i++ {
+			if rest[i] == "raw" {
+				return []string{":id", "raw", ":id"}
+			}
+		}
+		return []string{":id"}
+	}
+
+	// jobs/:job_id/artifacts/*artifact_path, but not jobs/:job_id/artifacts/keep.
+	if i < 2 || segments[i-2] != "jobs" || (len(rest) == 1 && rest[0] == "keep") {
+		return nil
+	}
+	return []string{":id"}
+}
+
+// withEndpoint returns a copy of ctx carrying the given endpoint label.
+func withEndpoint(ctx context.Context, endpoint string) context.Context {
+	return context.WithValue(ctx, endpointContextKey{}, endpoint)
+}
+
+// EndpointFromContext returns the endpoint label of a request sent using Do,
+// or an empty string if ctx does not carry an endpoint label.
+func EndpointFromContext(ctx context.Context) string {
+	endpoint, _ := ctx.Value(endpointContextKey{}).(string)
+	return endpoint
+}
@@ -0,0 +1,153 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// endpointRecorder is a http.RoundTripper recording the endpoint labels of
+// all requests.
+type endpointRecorder struct {
+	mu        sync.Mutex
+	endpoints []string
+}
+
+func (r *endpointRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.mu.Lock()
+	r.endpoints = append(r.endpoints, EndpointFromContext(req.Context()))
+	r.mu.Unlock()
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestRequestEndpointLabels(t *testing.T) {
+	mux, server, _ := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+
+	rec := &endpointRecorder{}
+	client, err := NewClient("", WithBaseURL(server.URL), WithHTTPClient(&http.Client{Transport: rec}), WithoutRetries())
+	require.NoError(t, err)
+
+	client.RegisterEndpoint(http.MethodGet, "projects/:id/custom_resources/:resource")
+
+	// A modeled endpoint, labeled using the derived endpoint name.
+	_, _, err = client.Projects.GetProject("group/project", nil)
+	require.NoError(t, err)
+
+	// A custom endpoint, labeled using the registered template.
+	req, err := client.NewRequest(http.MethodGet, "projects/1/custom_resources/foo", nil, nil)
+	require.NoError(t, err)
+	_, err = client.Do(req, nil)
+	require.NoError(t, err)
+
+	// A custom endpoint with an explicit label.
+	req, err = client.NewRequest(http.MethodPost, "projects/1/things/bar", nil, []RequestOptionFunc{
+		WithContext(context.Background()),
+		WithEndpoint("projects/:id/things/:thing"),
+	})
+	require.NoError(t, err)
+	_, err = client.Do(req, nil)
+	require.NoError(t, err)
+
+	assert.Contains(t, rec.endpoints, "projects/:id")
+	assert.Contains(t, rec.endpoints, "projects/:id/custom_resources/:resource")
+	assert.Contains(t, rec.endpoints, "projects/:id/things/:thing")
+}
+
+func TestEndpointFor(t *testing.T) {
+	client, err := NewClient("")
+	require.NoError(t, err)
+
+	client.RegisterEndpoint("", "/users/:user/custom/")
+
+	tests := []struct {
+		method, path, want string
+	}{
+		{http.MethodGet, "projects/42/issues/7", "projects/:id/issues/:id"},
+		{http.MethodGet, "projects/group%2Fproject/merge_requests", "projects/:id/merge_requests"},
+		{http.MethodPut, "users/jane/custom", "users/:user/custom"},
+		{http.MethodGet, "version", "version"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, client.endpointFor(tt.method, tt.path), tt.path)
+	}
+}
+
+func TestEndpointForCollapsesNames(t *testing.T) {
+	_, server, client := setup(t)
+	defer teardown(server)
+
+	tests := map[string]string{
+		"projects/1/repository/tags/v1.2.3":               "projects/:id/repository/tags/:id",
+		"projects/group%2Fproject/repository/tags/latest": "projects/:id/repository/tags/:id",
+		"groups/mygroup":                                "groups/:id",
+		"groups/mygroup/members":                        "groups/:id/members",
+		"projects/1/repository/branches/main/unprotect": "projects/:id/repository/branches/:id/unprotect",
+		"projects/import":                               "projects/import",
+		"runners/all":                                   "runners/all",
+		"projects/1/issues?state=opened":                "projects/:id/issues",
+
+		"projects/1/jobs/artifacts/main/download?job=test":          "projects/:id/jobs/artifacts/:id/download",
+		"projects/1/jobs/artifacts/feature/login/download?job=test": "projects/:id/jobs/artifacts/:id/download",
+		"projects/1/jobs/artifacts/main/raw/coverage/index.html":    "projects/:id/jobs/artifacts/:id/raw/:id",
+		"projects/1/jobs/42/artifacts":                              "projects/:id/jobs/:id/artifacts",
+		"projects/1/jobs/42/artifacts/keep":                         "projects/:id/jobs/:id/artifacts/keep",
+		"projects/1/jobs/42/artifacts/coverage/index.html":          "projects/:id/jobs/:id/artifacts/:id",
+		"projects/1/repository/archive?sha=main":                    "projects/:id/repository/archive",
+		"projects/1/repository/archive.tar.gz?sha=main":             "projects/:id/repository/archive",
+		"projects/1/repository/tree?ref=main&path=docs":             "projects/:id/repository/tree",
+		"projects/1/ref/main/trigger/pipeline":                      "projects/:id/ref/:id/trigger/pipeline",
+	}
+	for path, want := range tests {
+		assert.Equal(t, want, client.endpointFor(http.MethodGet, path), path)
+	}
+}
+
+func TestWithContextKeepsRequestValues(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/things/bar", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+
+	var called bool
+	req, err := client.NewRequest(http.MethodGet, "projects/1/things/bar", nil, []RequestOptionFunc{
+		WithEndpoint("projects/:id/things/:thing"),
+		WithResponseCallback(func(resp *Response, err error) { called = true }),
+		WithContext(context.Background()),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "projects/:id/things/:thing", EndpointFromContext(req.Context()))
+
+	_, err = client.Do(req, nil)
+	require.NoError(t, err)
+	assert.True(t, called)
+}
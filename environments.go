@@ -19,6 +19,8 @@ package gitlab
 import (
 	"fmt"
 	"net/http"
+	"strings"
+	"time"
 )
 
 // EnvironmentsService handles communication with the environment related methods
@@ -33,13 +35,33 @@ type EnvironmentsService struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/environments.html
 type Environment struct {
-	ID             int         `json:"id"`
-	Name           string      `json:"name"`
-	Slug           string      `json:"slug"`
-	State          string      `json:"state"`
-	ExternalURL    string      `json:"external_url"`
-	Project        *Project    `json:"project"`
-	LastDeployment *Deployment `json:"last_deployment"`
+	ID                  int                      `json:"id"`
+	Name                string                   `json:"name"`
+	Slug                string                   `json:"slug"`
+	Description         string                   `json:"description"`
+	State               string                   `json:"state"`
+	Tier                string                   `json:"tier"`
+	ExternalURL         string                   `json:"external_url"`
+	Project             *Project                 `json:"project"`
+	LastDeployment      *Deployment              `json:"last_deployment"`
+	ClusterAgent        *EnvironmentClusterAgent `json:"cluster_agent"`
+	KubernetesNamespace string                   `json:"kubernetes_namespace"`
+	FluxResourcePath    string                   `json:"flux_resource_path"`
+	AutoStopAt          *time.Time               `json:"auto_stop_at"`
+	CreatedAt           *time.Time               `json:"created_at"`
+	UpdatedAt           *time.Time               `json:"updated_at"`
+}
+
+// EnvironmentClusterAgent represents the GitLab agent for Kubernetes that is
+// associated with an environment.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/environments.html
+type EnvironmentClusterAgent struct {
+	ID              int        `json:"id"`
+	Name            string     `json:"name"`
+	ConfigProject   *Project   `json:"config_project"`
+	CreatedAt       *time.Time `json:"created_at"`
+	CreatedByUserID int        `json:"created_by_user_id"`
 }
 
 func (env Environment) String() string {
@@ -113,8 +135,13 @@ func (s *EnvironmentsService) GetEnvironment(pid interface{}, environment int, o
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/environments.html#create-a-new-environment
 type CreateEnvironmentOptions struct {
-	Name        *string `url:"name,omitempty" json:"name,omitempty"`
-	ExternalURL *string `url:"external_url,omitempty" json:"external_url,omitempty"`
+	Name                *string `url:"name,omitempty" json:"name,omitempty"`
+	Description         *string `url:"description,omitempty" json:"description,omitempty"`
+	ExternalURL         *string `url:"external_url,omitempty" json:"external_url,omitempty"`
+	Tier                *string `url:"tier,omitempty" json:"tier,omitempty"`
+	ClusterAgentID      *int    `url:"cluster_agent_id,omitempty" json:"cluster_agent_id,omitempty"`
+	KubernetesNamespace *string `url:"kubernetes_namespace,omitempty" json:"kubernetes_namespace,omitempty"`
+	FluxResourcePath    *string `url:"flux_resource_path,omitempty" json:"flux_resource_path,omitempty"`
 }
 
 // CreateEnvironment adds an environment to a project. This is an idempotent
@@ -150,8 +177,13 @@ func (s *EnvironmentsService) CreateEnvironment(pid interface{}, opt *CreateEnvi
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/environments.html#edit-an-existing-environment
 type EditEnvironmentOptions struct {
-	Name        *string `url:"name,omitempty" json:"name,omitempty"`
-	ExternalURL *string `url:"external_url,omitempty" json:"external_url,omitempty"`
+	Name                *string `url:"name,omitempty" json:"name,omitempty"`
+	Description         *string `url:"description,omitempty" json:"description,omitempty"`
+	ExternalURL         *string `url:"external_url,omitempty" json:"external_url,omitempty"`
+	Tier                *string `url:"tier,omitempty" json:"tier,omitempty"`
+	ClusterAgentID      *int    `url:"cluster_agent_id,omitempty" json:"cluster_agent_id,omitempty"`
+	KubernetesNamespace *string `url:"kubernetes_namespace,omitempty" json:"kubernetes_namespace,omitempty"`
+	FluxResourcePath    *string `url:"flux_resource_path,omitempty" json:"flux_resource_path,omitempty"`
 }
 
 // EditEnvironment updates a project team environment to a specified access level..
@@ -216,3 +248,94 @@ func (s *EnvironmentsService) StopEnvironment(pid interface{}, environmentID int
 
 	return s.client.Do(req, nil)
 }
+
+// StopStaleEnvironmentsOptions represents the available
+// StopStaleEnvironments() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/environments.html#stop-stale-environments
+type StopStaleEnvironmentsOptions struct {
+	Before *time.Time `url:"before,omitempty" json:"before,omitempty"`
+}
+
+// StopStaleEnvironments issues stop requests to all environments that were
+// last modified or deployed to before a specified date.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/environments.html#stop-stale-environments
+func (s *EnvironmentsService) StopStaleEnvironments(pid interface{}, opt *StopStaleEnvironmentsOptions, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/environments/stop_stale", pathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodPost, u, opt, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// StopStaleReviewApps stops all available review apps (environments in the
+// "review/" folder) of a project that have not been updated or deployed to
+// for longer than the given duration. It returns the environments that were
+// stopped, also when an error occurs halfway through. Environments without
+// any timestamp are never considered stale.
+func (s *EnvironmentsService) StopStaleReviewApps(pid interface{}, olderThan time.Duration, options ...RequestOptionFunc) ([]*Environment, error) {
+	cutoff := time.Now().Add(-olderThan)
+
+	opt := &ListEnvironmentsOptions{
+		ListOptions: ListOptions{PerPage: 100},
+		Search:      String("review/"),
+		States:      String("available"),
+	}
+
+	var stale []*Environment
+	for {
+		envs, resp, err := s.ListEnvironments(pid, opt, options...)
+		if err != nil {
+			return nil, err
+		}
+		for _, env := range envs {
+			if !strings.HasPrefix(env.Name, "review/") {
+				continue
+			}
+			if last := environmentLastActivity(env); !last.IsZero() && last.Before(cutoff) {
+				stale = append(stale, env)
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	var stopped []*Environment
+	for _, env := range stale {
+		if _, err := s.StopEnvironment(pid, env.ID, options...); err != nil {
+			return stopped, err
+		}
+		stopped = append(stopped, env)
+	}
+
+	return stopped, nil
+}
+
+// environmentLastActivity returns the most recent point in time at which the
+// environment was created, updated or deployed to, or the zero time if the
+// environment has no timestamps at all.
+func environmentLastActivity(env *Environment) time.Time {
+	var last time.Time
+	switch {
+	case env.UpdatedAt != nil:
+		last = *env.UpdatedAt
+	case env.CreatedAt != nil:
+		last = *env.CreatedAt
+	}
+	if env.LastDeployment != nil && env.LastDeployment.CreatedAt != nil && env.LastDeployment.CreatedAt.After(last) {
+		last = *env.LastDeployment.CreatedAt
+	}
+	return last
+}
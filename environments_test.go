@@ -23,6 +23,7 @@ import (
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -138,6 +139,63 @@ func TestStopEnvironment(t *testing.T) {
 	}
 }
 
+func TestStopStaleEnvironments(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	before := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	mux.HandleFunc("/api/v4/projects/1/environments/stop_stale", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"before":"2021-06-01T00:00:00Z"}`)
+	})
+
+	_, err := client.Environments.StopStaleEnvironments(1, &StopStaleEnvironmentsOptions{Before: &before})
+	if err != nil {
+		t.Errorf("Environments.StopStaleEnvironments returned error: %v", err)
+	}
+}
+
+func TestStopStaleReviewApps(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	old := time.Now().Add(-72 * time.Hour).UTC().Format(time.RFC3339)
+	recent := time.Now().Add(-1 * time.Hour).UTC().Format(time.RFC3339)
+
+	mux.HandleFunc("/api/v4/projects/1/environments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/projects/1/environments?per_page=100&search=review%2F&states=available")
+		fmt.Fprintf(w, `[
+			{"id":1,"name":"review/old","updated_at":%q},
+			{"id":2,"name":"review/recent","updated_at":%q},
+			{"id":3,"name":"review/redeployed","updated_at":%q,"last_deployment":{"id":7,"created_at":%q}},
+			{"id":4,"name":"production-review/old","updated_at":%q},
+			{"id":5,"name":"review/created","created_at":%q},
+			{"id":6,"name":"review/unknown"}
+		]`, old, recent, old, recent, old, old)
+	})
+
+	var stoppedIDs []int
+	for _, id := range []int{1, 2, 3, 4, 5, 6} {
+		id := id
+		mux.HandleFunc(fmt.Sprintf("/api/v4/projects/1/environments/%d/stop", id), func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodPost)
+			stoppedIDs = append(stoppedIDs, id)
+		})
+	}
+
+	stopped, err := client.Environments.StopStaleReviewApps(1, 24*time.Hour)
+	if err != nil {
+		t.Fatalf("Environments.StopStaleReviewApps returned error: %v", err)
+	}
+
+	assert.Len(t, stopped, 2)
+	assert.Equal(t, "review/old", stopped[0].Name)
+	assert.Equal(t, "review/created", stopped[1].Name)
+	assert.Equal(t, []int{1, 5}, stoppedIDs)
+}
+
 func TestUnmarshal(t *testing.T) {
 	jsonObject := `
     {
@@ -190,3 +248,45 @@ func TestUnmarshal(t *testing.T) {
 		}
 	}
 }
+
+func TestCreateEnvironmentWithKubernetesResources(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/environments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"name":"production","tier":"production","cluster_agent_id":2,"kubernetes_namespace":"prod","flux_resource_path":"helm.toolkit.fluxcd.io/v2beta1/namespaces/prod/helmreleases/app"}`)
+		fmt.Fprint(w, `{
+			"id": 1,
+			"name": "production",
+			"tier": "production",
+			"cluster_agent": {"id": 2, "name": "agent", "created_by_user_id": 3},
+			"kubernetes_namespace": "prod",
+			"flux_resource_path": "helm.toolkit.fluxcd.io/v2beta1/namespaces/prod/helmreleases/app"
+		}`)
+	})
+
+	opt := &CreateEnvironmentOptions{
+		Name:                String("production"),
+		Tier:                String("production"),
+		ClusterAgentID:      Int(2),
+		KubernetesNamespace: String("prod"),
+		FluxResourcePath:    String("helm.toolkit.fluxcd.io/v2beta1/namespaces/prod/helmreleases/app"),
+	}
+	env, _, err := client.Environments.CreateEnvironment(1, opt)
+	if err != nil {
+		t.Fatalf("Environments.CreateEnvironment returned error: %v", err)
+	}
+
+	want := &Environment{
+		ID:                  1,
+		Name:                "production",
+		Tier:                "production",
+		ClusterAgent:        &EnvironmentClusterAgent{ID: 2, Name: "agent", CreatedByUserID: 3},
+		KubernetesNamespace: "prod",
+		FluxResourcePath:    "helm.toolkit.fluxcd.io/v2beta1/namespaces/prod/helmreleases/app",
+	}
+	if !reflect.DeepEqual(want, env) {
+		t.Errorf("Environments.CreateEnvironment returned %+v, want %+v", env, want)
+	}
+}
@@ -74,25 +74,46 @@ func (e Epic) String() string {
 	return Stringify(e)
 }
 
+// EpicOrderByValue represents a column epics can be ordered by.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/epics.html#list-epics-for-a-group
+type EpicOrderByValue string
+
+// These constants represent all valid columns to order epics by.
+const (
+	EpicOrderByCreatedAt EpicOrderByValue = "created_at"
+	EpicOrderByUpdatedAt EpicOrderByValue = "updated_at"
+	EpicOrderByTitle     EpicOrderByValue = "title"
+)
+
+// EpicOrderBy is a helper routine that allocates a new EpicOrderByValue
+// to store v and returns a pointer to it.
+func EpicOrderBy(v EpicOrderByValue) *EpicOrderByValue {
+	p := new(EpicOrderByValue)
+	*p = v
+	return p
+}
+
 // ListGroupEpicsOptions represents the available ListGroupEpics() options.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/epics.html#list-epics-for-a-group
 type ListGroupEpicsOptions struct {
 	ListOptions
-	AuthorID                *int       `url:"author_id,omitempty" json:"author_id,omitempty"`
-	Labels                  Labels     `url:"labels,comma,omitempty" json:"labels,omitempty"`
-	WithLabelDetails        *bool      `url:"with_labels_details,omitempty" json:"with_labels_details,omitempty"`
-	OrderBy                 *string    `url:"order_by,omitempty" json:"order_by,omitempty"`
-	Sort                    *string    `url:"sort,omitempty" json:"sort,omitempty"`
-	Search                  *string    `url:"search,omitempty" json:"search,omitempty"`
-	State                   *string    `url:"state,omitempty" json:"state,omitempty"`
-	CreatedAfter            *time.Time `url:"created_after,omitempty" json:"created_after,omitempty"`
-	CreatedBefore           *time.Time `url:"created_before,omitempty" json:"created_before,omitempty"`
-	UpdatedAfter            *time.Time `url:"updated_after,omitempty" json:"updated_after,omitempty"`
-	UpdatedBefore           *time.Time `url:"updated_before,omitempty" json:"updated_before,omitempty"`
-	IncludeAncestorGroups   *bool      `url:"include_ancestor_groups,omitempty" json:"include_ancestor_groups,omitempty"`
-	IncludeDescendantGroups *bool      `url:"include_descendant_groups,omitempty" json:"include_descendant_groups,omitempty"`
-	MyReactionEmoji         *string    `url:"my_reaction_emoji,omitempty" json:"my_reaction_emoji,omitempty"`
+	AuthorID                *int              `url:"author_id,omitempty" json:"author_id,omitempty"`
+	Labels                  Labels            `url:"labels,comma,omitempty" json:"labels,omitempty"`
+	WithLabelDetails        *bool             `url:"with_labels_details,omitempty" json:"with_labels_details,omitempty"`
+	OrderBy                 *EpicOrderByValue `url:"order_by,omitempty" json:"order_by,omitempty"`
+	Sort                    *SortValue        `url:"sort,omitempty" json:"sort,omitempty"`
+	Search                  *string           `url:"search,omitempty" json:"search,omitempty"`
+	State                   *string           `url:"state,omitempty" json:"state,omitempty"`
+	CreatedAfter            *time.Time        `url:"created_after,omitempty" json:"created_after,omitempty"`
+	CreatedBefore           *time.Time        `url:"created_before,omitempty" json:"created_before,omitempty"`
+	UpdatedAfter            *time.Time        `url:"updated_after,omitempty" json:"updated_after,omitempty"`
+	UpdatedBefore           *time.Time        `url:"updated_before,omitempty" json:"updated_before,omitempty"`
+	IncludeAncestorGroups   *bool             `url:"include_ancestor_groups,omitempty" json:"include_ancestor_groups,omitempty"`
+	IncludeDescendantGroups *bool             `url:"include_descendant_groups,omitempty" json:"include_descendant_groups,omitempty"`
+	MyReactionEmoji         *string           `url:"my_reaction_emoji,omitempty" json:"my_reaction_emoji,omitempty"`
 }
 
 // ListGroupEpics gets a list of group epics. This function accepts pagination
@@ -29,9 +29,12 @@ type EventType string
 const (
 	EventTypeBuild         EventType = "Build Hook"
 	EventTypeDeployment    EventType = "Deployment Hook"
+	EventTypeEmoji         EventType = "Emoji Hook"
+	EventTypeFeatureFlag   EventType = "Feature Flag Hook"
 	EventTypeIssue         EventType = "Issue Hook"
 	EventConfidentialIssue EventType = "Confidential Issue Hook"
 	EventTypeJob           EventType = "Job Hook"
+	EventTypeMember        EventType = "Member Hook"
 	EventTypeMergeRequest  EventType = "Merge Request Hook"
 	EventTypeNote          EventType = "Note Hook"
 	EventConfidentialNote  EventType = "Confidential Note Hook"
@@ -144,6 +147,7 @@ func ParseSystemhook(payload []byte) (event interface{}, err error) {
 	case
 		"user_create",
 		"user_destroy",
+		"user_failed_login",
 		"user_rename":
 		event = &UserSystemEvent{}
 	case
@@ -203,10 +207,16 @@ func ParseWebhook(eventType EventType, payload []byte) (event interface{}, err e
 		event = &BuildEvent{}
 	case EventTypeDeployment:
 		event = &DeploymentEvent{}
+	case EventTypeEmoji:
+		event = &EmojiEvent{}
+	case EventTypeFeatureFlag:
+		event = &FeatureFlagEvent{}
 	case EventTypeIssue, EventConfidentialIssue:
 		event = &IssueEvent{}
 	case EventTypeJob:
 		event = &JobEvent{}
+	case EventTypeMember:
+		event = &MemberEvent{}
 	case EventTypeMergeRequest:
 		event = &MergeEvent{}
 	case EventTypePipeline:
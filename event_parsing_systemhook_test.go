@@ -35,6 +35,13 @@ func TestParseSystemhookPush(t *testing.T) {
 		t.Errorf("Expected PushSystemHookEvent, but parsing produced %T", parsedEvent)
 	}
 	assert.Equal(t, "push", event.EventName)
+	assert.Equal(t, "refs/heads/master", event.Ref)
+	assert.Equal(t, 15, event.ProjectID)
+	assert.Equal(t, "mike/diaspora", event.Project.PathWithNamespace)
+	assert.Equal(t, "Diaspora", event.Repository.Name)
+	assert.Len(t, event.Commits, 1)
+	assert.Equal(t, "Dmitriy Zaporozhets", event.Commits[0].Author.Name)
+	assert.Equal(t, 1, event.TotalCommitsCount)
 }
 
 func TestParseSystemhookTagPush(t *testing.T) {
@@ -50,6 +57,7 @@ func TestParseSystemhookTagPush(t *testing.T) {
 		t.Errorf("Expected TagPushSystemHookEvent, but parsing produced %T", parsedEvent)
 	}
 	assert.Equal(t, "tag_push", event.EventName)
+	assert.Equal(t, "refs/tags/v1.0.0", event.Ref)
 }
 
 func TestParseSystemhookMergeRequest(t *testing.T) {
@@ -80,6 +88,12 @@ func TestParseSystemhookRepositoryUpdate(t *testing.T) {
 		t.Errorf("Expected RepositoryUpdateSystemHookEvent, but parsing produced %T", parsedEvent)
 	}
 	assert.Equal(t, "repository_update", event.EventName)
+	assert.Equal(t, 1, event.UserID)
+	assert.Equal(t, "jsmith/example", event.Project.PathWithNamespace)
+	if assert.Len(t, event.Changes, 1) {
+		assert.Equal(t, "4045ea7a3df38697b3730a20fb73c8bed8a3e69e", event.Changes[0].After)
+	}
+	assert.Equal(t, []string{"refs/heads/master"}, event.Refs)
 }
 
 func TestParseSystemhookProject(t *testing.T) {
@@ -139,6 +153,7 @@ func TestParseSystemhookUser(t *testing.T) {
 	}{
 		{"user_create", loadFixture("testdata/systemhooks/user_create.json")},
 		{"user_destroy", loadFixture("testdata/systemhooks/user_destroy.json")},
+		{"user_failed_login", loadFixture("testdata/systemhooks/user_failed_login.json")},
 		{"user_rename", loadFixture("testdata/systemhooks/user_rename.json")},
 	}
 	for _, tc := range tests {
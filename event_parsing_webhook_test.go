@@ -418,3 +418,67 @@ func TestParseWikiPageHook(t *testing.T) {
 		t.Errorf("Message is %v, want %v", event.ObjectAttributes.Message, "adding an awesome page to the wiki")
 	}
 }
+
+func TestParseEmojiHook(t *testing.T) {
+	raw := loadFixture("testdata/webhooks/emoji.json")
+
+	parsedEvent, err := ParseWebhook("Emoji Hook", raw)
+	if err != nil {
+		t.Errorf("Error parsing emoji hook: %s", err)
+	}
+
+	event, ok := parsedEvent.(*EmojiEvent)
+	if !ok {
+		t.Fatalf("Expected EmojiEvent, but parsing produced %T", parsedEvent)
+	}
+
+	assert.Equal(t, "emoji", event.ObjectKind)
+	assert.Equal(t, "award", event.EventType)
+	assert.Equal(t, "thumbsup", event.ObjectAttributes.Name)
+	assert.Equal(t, "Issue", event.ObjectAttributes.AwardableType)
+	assert.Equal(t, 6, event.Project.ID)
+	if assert.NotNil(t, event.Issue) {
+		assert.Equal(t, 5, event.Issue.IID)
+	}
+	assert.Nil(t, event.MergeRequest)
+}
+
+func TestParseFeatureFlagHook(t *testing.T) {
+	raw := loadFixture("testdata/webhooks/feature_flag.json")
+
+	parsedEvent, err := ParseWebhook("Feature Flag Hook", raw)
+	if err != nil {
+		t.Errorf("Error parsing feature flag hook: %s", err)
+	}
+
+	event, ok := parsedEvent.(*FeatureFlagEvent)
+	if !ok {
+		t.Fatalf("Expected FeatureFlagEvent, but parsing produced %T", parsedEvent)
+	}
+
+	assert.Equal(t, "feature_flag", event.ObjectKind)
+	assert.Equal(t, "test-feature-flag", event.ObjectAttributes.Name)
+	assert.True(t, event.ObjectAttributes.Active)
+	assert.Equal(t, "root", event.User.Username)
+}
+
+func TestParseMemberHook(t *testing.T) {
+	raw := loadFixture("testdata/webhooks/member.json")
+
+	parsedEvent, err := ParseWebhook("Member Hook", raw)
+	if err != nil {
+		t.Errorf("Error parsing member hook: %s", err)
+	}
+
+	event, ok := parsedEvent.(*MemberEvent)
+	if !ok {
+		t.Fatalf("Expected MemberEvent, but parsing produced %T", parsedEvent)
+	}
+
+	assert.Equal(t, "user_add_to_group", event.EventName)
+	assert.Equal(t, 100, event.GroupID)
+	assert.Equal(t, "Guest", event.GroupAccess)
+	if assert.NotNil(t, event.ExpiresAt) {
+		assert.Equal(t, 2020, event.ExpiresAt.Year())
+	}
+}
@@ -163,6 +163,117 @@ type DeploymentEvent struct {
 	CommitTitle string `json:"commit_title"`
 }
 
+// EmojiEvent represents an emoji event.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/project/integrations/webhook_events.html#emoji-events
+type EmojiEvent struct {
+	ObjectKind string `json:"object_kind"`
+	EventType  string `json:"event_type"`
+	User       struct {
+		ID        int    `json:"id"`
+		Name      string `json:"name"`
+		Username  string `json:"username"`
+		AvatarURL string `json:"avatar_url"`
+		Email     string `json:"email"`
+	} `json:"user"`
+	ProjectID int `json:"project_id"`
+	Project   struct {
+		ID                int     `json:"id"`
+		Name              string  `json:"name"`
+		Description       string  `json:"description"`
+		WebURL            string  `json:"web_url"`
+		AvatarURL         *string `json:"avatar_url"`
+		GitSSHURL         string  `json:"git_ssh_url"`
+		GitHTTPURL        string  `json:"git_http_url"`
+		Namespace         string  `json:"namespace"`
+		VisibilityLevel   int     `json:"visibility_level"`
+		PathWithNamespace string  `json:"path_with_namespace"`
+		DefaultBranch     string  `json:"default_branch"`
+		CIConfigPath      string  `json:"ci_config_path"`
+		Homepage          string  `json:"homepage"`
+		URL               string  `json:"url"`
+		SSHURL            string  `json:"ssh_url"`
+		HTTPURL           string  `json:"http_url"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		ID            int    `json:"id"`
+		UserID        int    `json:"user_id"`
+		Name          string `json:"name"`
+		AwardableType string `json:"awardable_type"`
+		AwardableID   int    `json:"awardable_id"`
+		CreatedAt     string `json:"created_at"` // Should be *time.Time (see Gitlab issue #21468)
+		UpdatedAt     string `json:"updated_at"` // Should be *time.Time (see Gitlab issue #21468)
+	} `json:"object_attributes"`
+	Note         *EmojiEventAwardable `json:"note"`
+	Issue        *EmojiEventAwardable `json:"issue"`
+	MergeRequest *EmojiEventAwardable `json:"merge_request"`
+	Snippet      *EmojiEventAwardable `json:"snippet"`
+	Commit       *struct {
+		ID      string `json:"id"`
+		Message string `json:"message"`
+		Title   string `json:"title"`
+		URL     string `json:"url"`
+	} `json:"commit"`
+}
+
+// EmojiEventAwardable represents the issue, merge request, snippet or note an
+// emoji was awarded to.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/project/integrations/webhook_events.html#emoji-events
+type EmojiEventAwardable struct {
+	ID          int    `json:"id"`
+	IID         int    `json:"iid"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Note        string `json:"note"`
+	AuthorID    int    `json:"author_id"`
+	ProjectID   int    `json:"project_id"`
+	State       string `json:"state"`
+	URL         string `json:"url"`
+}
+
+// FeatureFlagEvent represents a feature flag event.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/project/integrations/webhook_events.html#feature-flag-events
+type FeatureFlagEvent struct {
+	ObjectKind string `json:"object_kind"`
+	Project    struct {
+		ID                int     `json:"id"`
+		Name              string  `json:"name"`
+		Description       string  `json:"description"`
+		WebURL            string  `json:"web_url"`
+		AvatarURL         *string `json:"avatar_url"`
+		GitSSHURL         string  `json:"git_ssh_url"`
+		GitHTTPURL        string  `json:"git_http_url"`
+		Namespace         string  `json:"namespace"`
+		VisibilityLevel   int     `json:"visibility_level"`
+		PathWithNamespace string  `json:"path_with_namespace"`
+		DefaultBranch     string  `json:"default_branch"`
+		CIConfigPath      string  `json:"ci_config_path"`
+		Homepage          string  `json:"homepage"`
+		URL               string  `json:"url"`
+		SSHURL            string  `json:"ssh_url"`
+		HTTPURL           string  `json:"http_url"`
+	} `json:"project"`
+	User struct {
+		ID        int    `json:"id"`
+		Name      string `json:"name"`
+		Username  string `json:"username"`
+		AvatarURL string `json:"avatar_url"`
+		Email     string `json:"email"`
+	} `json:"user"`
+	UserURL          string `json:"user_url"`
+	ObjectAttributes struct {
+		ID          int    `json:"id"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		Active      bool   `json:"active"`
+	} `json:"object_attributes"`
+}
+
 // IssueCommentEvent represents a comment on an issue event.
 //
 // GitLab API docs:
@@ -633,6 +744,26 @@ func (p *MergeParams) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// MemberEvent represents a member event.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/project/integrations/webhook_events.html#group-member-events
+type MemberEvent struct {
+	CreatedAt    *time.Time `json:"created_at"`
+	UpdatedAt    *time.Time `json:"updated_at"`
+	GroupName    string     `json:"group_name"`
+	GroupPath    string     `json:"group_path"`
+	GroupID      int        `json:"group_id"`
+	UserUsername string     `json:"user_username"`
+	UserName     string     `json:"user_name"`
+	UserEmail    string     `json:"user_email"`
+	UserID       int        `json:"user_id"`
+	GroupAccess  string     `json:"group_access"`
+	GroupPlan    string     `json:"group_plan"`
+	ExpiresAt    *time.Time `json:"expires_at"`
+	EventName    string     `json:"event_name"`
+}
+
 // PipelineEvent represents a pipeline event.
 //
 // GitLab API docs:
@@ -19,6 +19,9 @@ package gitlab
 import (
 	"fmt"
 	"net/http"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -76,7 +79,7 @@ type ListContributionEventsOptions struct {
 	TargetType *EventTargetTypeValue `url:"target_type,omitempty" json:"target_type,omitempty"`
 	Before     *ISOTime              `url:"before,omitempty" json:"before,omitempty"`
 	After      *ISOTime              `url:"after,omitempty" json:"after,omitempty"`
-	Sort       *string               `url:"sort,omitempty" json:"sort,omitempty"`
+	Sort       *SortValue            `url:"sort,omitempty" json:"sort,omitempty"`
 }
 
 // ListUserContributionEvents retrieves user contribution events
@@ -123,6 +126,36 @@ func (s *EventsService) ListCurrentUserContributionEvents(opt *ListContributionE
 	return cs, resp, err
 }
 
+// ListCurrentUserEventsOptions represents the available
+// ListCurrentUserEvents() options.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/events.html#list-currently-authenticated-user-39-s-events
+type ListCurrentUserEventsOptions struct {
+	ListContributionEventsOptions
+	Scope *EventScopeValue `url:"scope,omitempty" json:"scope,omitempty"`
+}
+
+// ListCurrentUserEvents gets a list of the currently authenticated user's
+// events. Unlike ListCurrentUserContributionEvents, the scope of the events
+// can be set, for example to include the events of all projects the user is
+// a member of.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/events.html#list-currently-authenticated-user-39-s-events
+func (s *EventsService) ListCurrentUserEvents(opt *ListCurrentUserEventsOptions, options ...RequestOptionFunc) ([]*ContributionEvent, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "events", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var cs []*ContributionEvent
+	resp, err := s.client.Do(req, &cs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return cs, resp, err
+}
+
 // ListProjectVisibleEvents gets a list of visible events for a particular project
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/events.html#list-a-project-s-visible-events
@@ -146,3 +179,106 @@ func (s *EventsService) ListProjectVisibleEvents(pid interface{}, opt *ListContr
 
 	return cs, resp, err
 }
+
+// UserContributionDay represents the aggregated contributions of a user on
+// a single (UTC) day.
+type UserContributionDay struct {
+	Date    ISOTime
+	Events  int
+	Pushes  int
+	Commits int
+}
+
+// GetUserContributionCalendar aggregates the contribution events of the
+// specified user into calendar-style counts per day, sorted by date. When the
+// total number of pages is known, the remaining pages are fetched using at
+// most concurrency parallel requests.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/events.html#get-user-contribution-events
+func (s *UsersService) GetUserContributionCalendar(uid interface{}, opt *ListContributionEventsOptions, concurrency int, options ...RequestOptionFunc) ([]*UserContributionDay, error) {
+	o := ListContributionEventsOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	if o.PerPage == 0 {
+		o.PerPage = 100
+	}
+	o.Page = 1
+
+	events, resp, err := s.ListUserContributionEvents(uid, &o, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.TotalPages > 1 {
+		if concurrency < 1 {
+			concurrency = 1
+		}
+
+		pages := make([][]*ContributionEvent, resp.TotalPages+1)
+		errs := make([]error, resp.TotalPages+1)
+		sem := make(chan struct{}, concurrency)
+
+		var wg sync.WaitGroup
+		for page := 2; page <= resp.TotalPages; page++ {
+			wg.Add(1)
+			sem <- struct{}{}
+			go func(po ListContributionEventsOptions, page int) {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+				po.Page = page
+				pages[page], _, errs[page] = s.ListUserContributionEvents(uid, &po, options...)
+			}(o, page)
+		}
+		wg.Wait()
+
+		for page := 2; page <= resp.TotalPages; page++ {
+			if errs[page] != nil {
+				return nil, errs[page]
+			}
+			events = append(events, pages[page]...)
+		}
+	} else {
+		for resp.NextPage != 0 {
+			o.Page = resp.NextPage
+			var es []*ContributionEvent
+			es, resp, err = s.ListUserContributionEvents(uid, &o, options...)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, es...)
+		}
+	}
+
+	days := make(map[string]*UserContributionDay)
+	for _, e := range events {
+		if e.CreatedAt == nil {
+			continue
+		}
+		date := e.CreatedAt.UTC().Format("2006-01-02")
+		day, ok := days[date]
+		if !ok {
+			t, _ := time.Parse("2006-01-02", date)
+			day = &UserContributionDay{Date: ISOTime(t)}
+			days[date] = day
+		}
+		day.Events++
+		if strings.HasPrefix(e.ActionName, "pushed") {
+			day.Pushes++
+			day.Commits += e.PushData.CommitCount
+		}
+	}
+
+	calendar := make([]*UserContributionDay, 0, len(days))
+	for _, day := range days {
+		calendar = append(calendar, day)
+	}
+	sort.Slice(calendar, func(i, j int) bool {
+		return time.Time(calendar[i].Date).Before(time.Time(calendar[j].Date))
+	})
+
+	return calendar, nil
+}
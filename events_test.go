@@ -0,0 +1,111 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetUserContributionCalendar(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/users/1/events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		w.Header().Set("X-Total-Pages", "2")
+		switch r.URL.Query().Get("page") {
+		case "1":
+			fmt.Fprint(w, `[
+				{"id":1,"action_name":"pushed to","created_at":"2021-03-02T10:00:00Z","push_data":{"commit_count":3}},
+				{"id":2,"action_name":"opened","created_at":"2021-03-02T11:00:00Z"}
+			]`)
+		case "2":
+			fmt.Fprint(w, `[
+				{"id":3,"action_name":"pushed new","created_at":"2021-03-01T09:00:00Z","push_data":{"commit_count":1}}
+			]`)
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+
+	calendar, err := client.Users.GetUserContributionCalendar(1, nil, 2)
+	require.NoError(t, err)
+
+	want := []*UserContributionDay{
+		{Date: ISOTime(time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)), Events: 1, Pushes: 1, Commits: 1},
+		{Date: ISOTime(time.Date(2021, 3, 2, 0, 0, 0, 0, time.UTC)), Events: 2, Pushes: 1, Commits: 3},
+	}
+	assert.Equal(t, want, calendar)
+}
+
+func TestListCurrentUserEvents(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/events?action=approved&after=2021-01-01&before=2021-02-01&scope=all&target_type=merge_request")
+		fmt.Fprint(w, `[{"id": 1, "action_name": "approved", "target_type": "MergeRequest"}]`)
+	})
+
+	after := ISOTime(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+	before := ISOTime(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC))
+	opt := &ListCurrentUserEventsOptions{
+		ListContributionEventsOptions: ListContributionEventsOptions{
+			Action:     EventAction(ApprovedEventType),
+			TargetType: EventTargetType(MergeRequestEventTargetType),
+			After:      &after,
+			Before:     &before,
+		},
+		Scope: EventScope(AllEventScope),
+	}
+
+	events, _, err := client.Events.ListCurrentUserEvents(opt)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "approved", events[0].ActionName)
+}
+
+func TestListProjectVisibleEventsFilters(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/projects/1/events?action=pushed&after=2021-01-01&page=2&target_type=issue")
+		fmt.Fprint(w, `[{"project_id": 1, "action_name": "pushed to"}]`)
+	})
+
+	after := ISOTime(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+	opt := &ListContributionEventsOptions{
+		ListOptions: ListOptions{Page: 2},
+		Action:      EventAction(PushedEventType),
+		TargetType:  EventTargetType(IssueEventTargetType),
+		After:       &after,
+	}
+
+	events, _, err := client.Events.ListProjectVisibleEvents(1, opt)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "pushed to", events[0].ActionName)
+}
@@ -38,8 +38,8 @@ func pipelineExample() {
 		Username:      gitlab.String("username"),
 		UpdatedAfter:  gitlab.Time(time.Now().Add(-24 * 365 * time.Hour)),
 		UpdatedBefore: gitlab.Time(time.Now().Add(-7 * 24 * time.Hour)),
-		OrderBy:       gitlab.String("status"),
-		Sort:          gitlab.String("asc"),
+		OrderBy:       gitlab.PipelineOrderBy(gitlab.PipelineOrderByStatus),
+		Sort:          gitlab.Sort(gitlab.SortAsc),
 	}
 
 	pipelines, _, err := git.Pipelines.ListProjectPipelines(2743054, opt)
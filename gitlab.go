@@ -20,9 +20,11 @@ package gitlab
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"math"
 	"math/rand"
 	"net/http"
 	"net/url"
@@ -44,8 +46,10 @@ const (
 	apiVersionPath = "api/v4/"
 	userAgent      = "go-gitlab"
 
-	headerRateLimit = "RateLimit-Limit"
-	headerRateReset = "RateLimit-Reset"
+	headerRateLimit     = "RateLimit-Limit"
+	headerRateObserved  = "RateLimit-Observed"
+	headerRateRemaining = "RateLimit-Remaining"
+	headerRateReset     = "RateLimit-Reset"
 )
 
 // authType represents an authentication type within GitLab.
@@ -58,11 +62,17 @@ type authType int
 // GitLab API docs: https://docs.gitlab.com/ce/api/
 const (
 	basicAuth authType = iota
+	jobToken
 	oAuthToken
 	privateToken
 )
 
 // A Client manages communication with the GitLab API.
+//
+// A Client is safe for concurrent use by multiple goroutines, as long as its
+// exported fields are not changed after it is first used. Use a single Client
+// per GitLab instance to share its connection pool, and call Close when it is
+// no longer needed.
 type Client struct {
 	// HTTP client used to communicate with the API.
 	client *retryablehttp.Client
@@ -75,6 +85,34 @@ type Client struct {
 	// disableRetries is used to disable the default retry logic.
 	disableRetries bool
 
+	// retryPolicy is used to configure the backoff between retries.
+	retryPolicy *RetryPolicy
+
+	// jobTokenUnrestricted allows clients using a CI job token to call
+	// endpoints that are not known to accept job tokens.
+	jobTokenUnrestricted bool
+
+	// requestIDFunc generates the request ID of requests without one.
+	requestIDFunc func() string
+
+	// requestHooks and responseHooks are called for every request.
+	requestHooks  []RequestHookFunc
+	responseHooks []ResponseHookFunc
+
+	// tracer and metrics instrument every API call.
+	tracer  CallTracer
+	metrics CallMetrics
+
+	// responseCache stores responses for conditional requests.
+	responseCache ResponseCache
+
+	// maxResponseBodySize limits the size of decoded and error responses.
+	maxResponseBodySize int64
+
+	// maxDecompressionRatio limits the decompression ratio of compressed
+	// downloads decompressed by the client.
+	maxDecompressionRatio float64
+
 	// configureLimiterOnce is used to make sure the limiter is configured exactly
 	// once and block all other calls until the initial (one) call is done.
 	configureLimiterOnce sync.Once
@@ -97,92 +135,119 @@ type Client struct {
 	// User agent used when communicating with the GitLab API.
 	UserAgent string
 
+	// Endpoint templates registered using RegisterEndpoint.
+	endpoints     []*endpointTemplate
+	endpointsLock sync.RWMutex
+
 	// Services used for talking to different parts of the GitLab API.
-	AccessRequests        *AccessRequestsService
-	Applications          *ApplicationsService
-	AuditEvents           *AuditEventsService
-	AwardEmoji            *AwardEmojiService
-	Boards                *IssueBoardsService
-	Branches              *BranchesService
-	BroadcastMessage      *BroadcastMessagesService
-	CIYMLTemplate         *CIYMLTemplatesService
-	Commits               *CommitsService
-	ContainerRegistry     *ContainerRegistryService
-	CustomAttribute       *CustomAttributesService
-	DeployKeys            *DeployKeysService
-	DeployTokens          *DeployTokensService
-	Deployments           *DeploymentsService
-	Discussions           *DiscussionsService
-	Environments          *EnvironmentsService
-	EpicIssues            *EpicIssuesService
-	Epics                 *EpicsService
-	Events                *EventsService
-	Features              *FeaturesService
-	FreezePeriods         *FreezePeriodsService
-	GitIgnoreTemplates    *GitIgnoreTemplatesService
-	GroupBadges           *GroupBadgesService
-	GroupCluster          *GroupClustersService
-	GroupImportExport     *GroupImportExportService
-	GroupIssueBoards      *GroupIssueBoardsService
-	GroupLabels           *GroupLabelsService
-	GroupMembers          *GroupMembersService
-	GroupMilestones       *GroupMilestonesService
-	GroupVariables        *GroupVariablesService
-	GroupWikis            *GroupWikisService
-	Groups                *GroupsService
-	InstanceCluster       *InstanceClustersService
-	InstanceVariables     *InstanceVariablesService
-	Invites               *InvitesService
-	IssueLinks            *IssueLinksService
-	Issues                *IssuesService
-	IssuesStatistics      *IssuesStatisticsService
-	Jobs                  *JobsService
-	Keys                  *KeysService
-	Labels                *LabelsService
-	License               *LicenseService
-	LicenseTemplates      *LicenseTemplatesService
-	MergeRequestApprovals *MergeRequestApprovalsService
-	MergeRequests         *MergeRequestsService
-	Milestones            *MilestonesService
-	Namespaces            *NamespacesService
-	Notes                 *NotesService
-	NotificationSettings  *NotificationSettingsService
-	Packages              *PackagesService
-	PagesDomains          *PagesDomainsService
-	PipelineSchedules     *PipelineSchedulesService
-	PipelineTriggers      *PipelineTriggersService
-	Pipelines             *PipelinesService
-	ProjectBadges         *ProjectBadgesService
-	ProjectAccessTokens   *ProjectAccessTokensService
-	ProjectCluster        *ProjectClustersService
-	ProjectImportExport   *ProjectImportExportService
-	ProjectMembers        *ProjectMembersService
-	ProjectMirrors        *ProjectMirrorService
-	ProjectSnippets       *ProjectSnippetsService
-	ProjectVariables      *ProjectVariablesService
-	Projects              *ProjectsService
-	ProtectedBranches     *ProtectedBranchesService
-	ProtectedEnvironments *ProtectedEnvironmentsService
-	ProtectedTags         *ProtectedTagsService
-	ReleaseLinks          *ReleaseLinksService
-	Releases              *ReleasesService
-	Repositories          *RepositoriesService
-	RepositoryFiles       *RepositoryFilesService
-	ResourceLabelEvents   *ResourceLabelEventsService
-	ResourceStateEvents   *ResourceStateEventsService
-	Runners               *RunnersService
-	Search                *SearchService
-	Services              *ServicesService
-	Settings              *SettingsService
-	Sidekiq               *SidekiqService
-	Snippets              *SnippetsService
-	SystemHooks           *SystemHooksService
-	Tags                  *TagsService
-	Todos                 *TodosService
-	Users                 *UsersService
-	Validate              *ValidateService
-	Version               *VersionService
-	Wikis                 *WikisService
+	AccessRequests                   *AccessRequestsService
+	Achievements                     *AchievementsService
+	AddOnPurchases                   *AddOnPurchasesService
+	Applications                     *ApplicationsService
+	AuditEvents                      *AuditEventsService
+	AuditEventStreaming              *AuditEventStreamingService
+	AwardEmoji                       *AwardEmojiService
+	BatchedBackgroundMigrations      *BatchedBackgroundMigrationsService
+	Boards                           *IssueBoardsService
+	Branches                         *BranchesService
+	BroadcastMessage                 *BroadcastMessagesService
+	BulkImports                      *BulkImportsService
+	CIYMLTemplate                    *CIYMLTemplatesService
+	Commits                          *CommitsService
+	ComputeMinutes                   *ComputeMinutesService
+	ContainerRegistry                *ContainerRegistryService
+	ContainerRegistryProtectionRules *ContainerRegistryProtectionRulesService
+	CustomAttribute                  *CustomAttributesService
+	Dependencies                     *DependenciesService
+	DeployKeys                       *DeployKeysService
+	DeployTokens                     *DeployTokensService
+	Deployments                      *DeploymentsService
+	Discussions                      *DiscussionsService
+	Environments                     *EnvironmentsService
+	EpicIssues                       *EpicIssuesService
+	Epics                            *EpicsService
+	Events                           *EventsService
+	Features                         *FeaturesService
+	FreezePeriods                    *FreezePeriodsService
+	GitIgnoreTemplates               *GitIgnoreTemplatesService
+	GraphQL                          *GraphQLService
+	GroupAccessTokens                *GroupAccessTokensService
+	GroupBadges                      *GroupBadgesService
+	GroupCluster                     *GroupClustersService
+	GroupImportExport                *GroupImportExportService
+	GroupIssueBoards                 *GroupIssueBoardsService
+	GroupLabels                      *GroupLabelsService
+	GroupMembers                     *GroupMembersService
+	GroupMilestones                  *GroupMilestonesService
+	GroupVariables                   *GroupVariablesService
+	GroupWikis                       *GroupWikisService
+	Groups                           *GroupsService
+	InstanceCluster                  *InstanceClustersService
+	InstanceVariables                *InstanceVariablesService
+	Invites                          *InvitesService
+	IssueLinks                       *IssueLinksService
+	Issues                           *IssuesService
+	IssuesStatistics                 *IssuesStatisticsService
+	Jobs                             *JobsService
+	JobTokenScope                    *JobTokenScopeService
+	Keys                             *KeysService
+	Labels                           *LabelsService
+	License                          *LicenseService
+	LicenseTemplates                 *LicenseTemplatesService
+	MergeRequestApprovals            *MergeRequestApprovalsService
+	MergeRequests                    *MergeRequestsService
+	Milestones                       *MilestonesService
+	Namespaces                       *NamespacesService
+	Notes                            *NotesService
+	NotificationSettings             *NotificationSettingsService
+	PackageProtectionRules           *PackageProtectionRulesService
+	Packages                         *PackagesService
+	Pages                            *PagesService
+	PagesDomains                     *PagesDomainsService
+	PersonalAccessTokens             *PersonalAccessTokensService
+	PipelineSchedules                *PipelineSchedulesService
+	PipelineTriggers                 *PipelineTriggersService
+	Pipelines                        *PipelinesService
+	ProjectBadges                    *ProjectBadgesService
+	ProjectAccessTokens              *ProjectAccessTokensService
+	ProjectCISettings                *ProjectCISettingsService
+	ProjectCluster                   *ProjectClustersService
+	ProjectImportExport              *ProjectImportExportService
+	ProjectMembers                   *ProjectMembersService
+	ProjectMirrors                   *ProjectMirrorService
+	ProjectRelationsExport           *ProjectRelationsExportService
+	ProjectSnippets                  *ProjectSnippetsService
+	ProjectTemplates                 *ProjectTemplatesService
+	ProjectVariables                 *ProjectVariablesService
+	Projects                         *ProjectsService
+	ProtectedBranches                *ProtectedBranchesService
+	ProtectedEnvironments            *ProtectedEnvironmentsService
+	ProtectedTags                    *ProtectedTagsService
+	ReleaseLinks                     *ReleaseLinksService
+	Releases                         *ReleasesService
+	Repositories                     *RepositoriesService
+	RepositoryFiles                  *RepositoryFilesService
+	ResourceLabelEvents              *ResourceLabelEventsService
+	ResourceStateEvents              *ResourceStateEventsService
+	ResourceWeightEvents             *ResourceWeightEventsService
+	Runners                          *RunnersService
+	SavedReplies                     *SavedRepliesService
+	Search                           *SearchService
+	SecurityPolicies                 *SecurityPoliciesService
+	ServiceAccounts                  *ServiceAccountsService
+	Services                         *ServicesService
+	Settings                         *SettingsService
+	Sidekiq                          *SidekiqService
+	Snippets                         *SnippetsService
+	SystemHooks                      *SystemHooksService
+	Tags                             *TagsService
+	Todos                            *TodosService
+	Topics                           *TopicsService
+	Users                            *UsersService
+	Validate                         *ValidateService
+	Version                          *VersionService
+	Vulnerabilities                  *VulnerabilitiesService
+	Wikis                            *WikisService
 }
 
 // ListOptions specifies the optional parameters to various List methods that
@@ -239,6 +304,86 @@ func NewOAuthClient(token string, options ...ClientOptionFunc) (*Client, error)
 	return client, nil
 }
 
+// NewInstanceClient returns a new GitLab API client for another GitLab
+// instance, authenticated with the given token using the same kind of token as
+// c. The new client shares the transport, hooks and retry behavior of c, as
+// well as the endpoints registered on c, which makes it cheap to create
+// clients for many instances. Rate limiting is configured separately for
+// every instance. The given options are applied to the new client only, but
+// note that the retry behavior is always inherited from c.
+func (c *Client) NewInstanceClient(baseURL, token string, options ...ClientOptionFunc) (*Client, error) {
+	client, err := newClient(WithBaseURL(baseURL))
+	if err != nil {
+		return nil, err
+	}
+
+	// Use a copy of the HTTP client, so options applied to the new client
+	// don't change c.
+	client.client = &retryablehttp.Client{
+		Backoff:         c.client.Backoff,
+		CheckRetry:      c.client.CheckRetry,
+		ErrorHandler:    c.client.ErrorHandler,
+		HTTPClient:      c.client.HTTPClient,
+		Logger:          c.client.Logger,
+		RequestLogHook:  c.client.RequestLogHook,
+		ResponseLogHook: c.client.ResponseLogHook,
+		RetryWaitMin:    c.client.RetryWaitMin,
+		RetryWaitMax:    c.client.RetryWaitMax,
+		RetryMax:        c.client.RetryMax,
+	}
+	client.disableRetries = c.disableRetries
+	client.retryPolicy = c.retryPolicy
+	client.jobTokenUnrestricted = c.jobTokenUnrestricted
+	client.maxResponseBodySize = c.maxResponseBodySize
+	client.maxDecompressionRatio = c.maxDecompressionRatio
+	client.requestIDFunc = c.requestIDFunc
+	client.requestHooks = append(client.requestHooks, c.requestHooks...)
+	client.responseHooks = append(client.responseHooks, c.responseHooks...)
+	client.tracer = c.tracer
+	client.metrics = c.metrics
+	client.responseCache = c.responseCache
+	client.UserAgent = c.UserAgent
+
+	client.authType = c.authType
+	if client.authType == basicAuth {
+		client.authType = oAuthToken
+	}
+	client.token = token
+
+	c.endpointsLock.RLock()
+	client.endpoints = append(client.endpoints, c.endpoints...)
+	c.endpointsLock.RUnlock()
+
+	// Apply any given client options.
+	for _, fn := range options {
+		if fn == nil {
+			continue
+		}
+		if err := fn(client); err != nil {
+			return nil, err
+		}
+	}
+
+	return client, nil
+}
+
+// NewJobClient returns a new GitLab API client that authenticates using a
+// CI/CD job token, such as the CI_JOB_TOKEN variable of a running job. Job
+// tokens can only be used for a limited set of endpoints, requests to other
+// endpoints fail with ErrJobTokenEndpointNotAllowed without being sent. Use
+// WithoutJobTokenRestrictions to disable this check.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/ci/jobs/ci_job_token.html
+func NewJobClient(token string, options ...ClientOptionFunc) (*Client, error) {
+	client, err := newClient(options...)
+	if err != nil {
+		return nil, err
+	}
+	client.authType = jobToken
+	client.token = token
+	return client, nil
+}
+
 func newClient(options ...ClientOptionFunc) (*Client, error) {
 	c := &Client{UserAgent: userAgent}
 
@@ -271,16 +416,24 @@ func newClient(options ...ClientOptionFunc) (*Client, error) {
 
 	// Create all the public services.
 	c.AccessRequests = &AccessRequestsService{client: c}
+	c.Achievements = &AchievementsService{client: c}
+	c.AddOnPurchases = &AddOnPurchasesService{client: c}
 	c.Applications = &ApplicationsService{client: c}
 	c.AuditEvents = &AuditEventsService{client: c}
+	c.AuditEventStreaming = &AuditEventStreamingService{client: c}
 	c.AwardEmoji = &AwardEmojiService{client: c}
+	c.BatchedBackgroundMigrations = &BatchedBackgroundMigrationsService{client: c}
 	c.Boards = &IssueBoardsService{client: c}
 	c.Branches = &BranchesService{client: c}
 	c.BroadcastMessage = &BroadcastMessagesService{client: c}
+	c.BulkImports = &BulkImportsService{client: c}
 	c.CIYMLTemplate = &CIYMLTemplatesService{client: c}
 	c.Commits = &CommitsService{client: c}
+	c.ComputeMinutes = &ComputeMinutesService{client: c}
 	c.ContainerRegistry = &ContainerRegistryService{client: c}
+	c.ContainerRegistryProtectionRules = &ContainerRegistryProtectionRulesService{client: c}
 	c.CustomAttribute = &CustomAttributesService{client: c}
+	c.Dependencies = &DependenciesService{client: c}
 	c.DeployKeys = &DeployKeysService{client: c}
 	c.DeployTokens = &DeployTokensService{client: c}
 	c.Deployments = &DeploymentsService{client: c}
@@ -292,6 +445,8 @@ func newClient(options ...ClientOptionFunc) (*Client, error) {
 	c.Features = &FeaturesService{client: c}
 	c.FreezePeriods = &FreezePeriodsService{client: c}
 	c.GitIgnoreTemplates = &GitIgnoreTemplatesService{client: c}
+	c.GraphQL = &GraphQLService{client: c}
+	c.GroupAccessTokens = &GroupAccessTokensService{client: c}
 	c.GroupBadges = &GroupBadgesService{client: c}
 	c.GroupCluster = &GroupClustersService{client: c}
 	c.GroupImportExport = &GroupImportExportService{client: c}
@@ -309,6 +464,7 @@ func newClient(options ...ClientOptionFunc) (*Client, error) {
 	c.Issues = &IssuesService{client: c, timeStats: timeStats}
 	c.IssuesStatistics = &IssuesStatisticsService{client: c}
 	c.Jobs = &JobsService{client: c}
+	c.JobTokenScope = &JobTokenScopeService{client: c}
 	c.Keys = &KeysService{client: c}
 	c.Labels = &LabelsService{client: c}
 	c.License = &LicenseService{client: c}
@@ -319,18 +475,24 @@ func newClient(options ...ClientOptionFunc) (*Client, error) {
 	c.Namespaces = &NamespacesService{client: c}
 	c.Notes = &NotesService{client: c}
 	c.NotificationSettings = &NotificationSettingsService{client: c}
+	c.PackageProtectionRules = &PackageProtectionRulesService{client: c}
 	c.Packages = &PackagesService{client: c}
+	c.Pages = &PagesService{client: c}
 	c.PagesDomains = &PagesDomainsService{client: c}
+	c.PersonalAccessTokens = &PersonalAccessTokensService{client: c}
 	c.PipelineSchedules = &PipelineSchedulesService{client: c}
 	c.PipelineTriggers = &PipelineTriggersService{client: c}
 	c.Pipelines = &PipelinesService{client: c}
 	c.ProjectBadges = &ProjectBadgesService{client: c}
 	c.ProjectAccessTokens = &ProjectAccessTokensService{client: c}
+	c.ProjectCISettings = &ProjectCISettingsService{client: c}
 	c.ProjectCluster = &ProjectClustersService{client: c}
 	c.ProjectImportExport = &ProjectImportExportService{client: c}
 	c.ProjectMembers = &ProjectMembersService{client: c}
 	c.ProjectMirrors = &ProjectMirrorService{client: c}
+	c.ProjectRelationsExport = &ProjectRelationsExportService{client: c}
 	c.ProjectSnippets = &ProjectSnippetsService{client: c}
+	c.ProjectTemplates = &ProjectTemplatesService{client: c}
 	c.ProjectVariables = &ProjectVariablesService{client: c}
 	c.Projects = &ProjectsService{client: c}
 	c.ProtectedBranches = &ProtectedBranchesService{client: c}
@@ -342,8 +504,12 @@ func newClient(options ...ClientOptionFunc) (*Client, error) {
 	c.RepositoryFiles = &RepositoryFilesService{client: c}
 	c.ResourceLabelEvents = &ResourceLabelEventsService{client: c}
 	c.ResourceStateEvents = &ResourceStateEventsService{client: c}
+	c.ResourceWeightEvents = &ResourceWeightEventsService{client: c}
 	c.Runners = &RunnersService{client: c}
+	c.SavedReplies = &SavedRepliesService{client: c}
 	c.Search = &SearchService{client: c}
+	c.SecurityPolicies = &SecurityPoliciesService{client: c}
+	c.ServiceAccounts = &ServiceAccountsService{client: c}
 	c.Services = &ServicesService{client: c}
 	c.Settings = &SettingsService{client: c}
 	c.Sidekiq = &SidekiqService{client: c}
@@ -351,9 +517,11 @@ func newClient(options ...ClientOptionFunc) (*Client, error) {
 	c.SystemHooks = &SystemHooksService{client: c}
 	c.Tags = &TagsService{client: c}
 	c.Todos = &TodosService{client: c}
+	c.Topics = &TopicsService{client: c}
 	c.Users = &UsersService{client: c}
 	c.Validate = &ValidateService{client: c}
 	c.Version = &VersionService{client: c}
+	c.Vulnerabilities = &VulnerabilitiesService{client: c}
 	c.Wikis = &WikisService{client: c}
 
 	return c, nil
@@ -377,6 +545,11 @@ func (c *Client) retryHTTPCheck(ctx context.Context, resp *http.Response, err er
 // retryHTTPBackoff provides a generic callback for Client.Backoff which
 // will pass through all calls based on the status code of the response.
 func (c *Client) retryHTTPBackoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	// Use the configured retry policy, if any.
+	if c.retryPolicy != nil {
+		return c.retryPolicy.backoff(attemptNum, resp)
+	}
+
 	// Use the rate limit backoff function when we are rate limited.
 	if resp != nil && resp.StatusCode == 429 {
 		return rateLimitBackoff(min, max, attemptNum, resp)
@@ -397,26 +570,114 @@ func (c *Client) retryHTTPBackoff(min, max time.Duration, attemptNum int, resp *
 // the reset time retrieved from the headers. But if the final wait time is
 // less then min, min will be used instead.
 func rateLimitBackoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
-	// rnd is used to generate pseudo-random numbers.
-	rnd := rand.New(rand.NewSource(time.Now().UnixNano()))
-
 	// First create some jitter bounded by the min and max durations.
-	jitter := time.Duration(rnd.Float64() * float64(max-min))
-
-	if resp != nil {
-		if v := resp.Header.Get(headerRateReset); v != "" {
-			if reset, _ := strconv.ParseInt(v, 10, 64); reset > 0 {
-				// Only update min if the given time to wait is longer.
-				if wait := time.Until(time.Unix(reset, 0)); wait > min {
-					min = wait
-				}
-			}
-		}
+	jitter := time.Duration(randFloat64() * float64(max-min))
+
+	// Only update min if the given time to wait is longer.
+	if wait := serverRetryWait(resp); wait > min {
+		min = wait
 	}
 
 	return min + jitter
 }
 
+// rnd is used to generate the pseudo-random jitter of the backoff functions.
+// A rand.Rand is not safe for concurrent use, so access is guarded by rndMu.
+var (
+	rnd   = rand.New(rand.NewSource(time.Now().UnixNano()))
+	rndMu sync.Mutex
+)
+
+// randFloat64 returns a pseudo-random number in [0.0,1.0) from rnd.
+func randFloat64() float64 {
+	rndMu.Lock()
+	defer rndMu.Unlock()
+	return rnd.Float64()
+}
+
+// serverRetryWait returns the time to wait before retrying a request as
+// requested by the server, using either the Retry-After header or the
+// RateLimit-Reset header. It returns 0 if the server didn't specify a time.
+func serverRetryWait(resp *http.Response) time.Duration {
+	if resp == nil {
+		return 0
+	}
+
+	if v := resp.Header.Get("Retry-After"); v != "" {
+		if seconds, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return time.Duration(seconds) * time.Second
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			return time.Until(t)
+		}
+	}
+
+	if v := resp.Header.Get(headerRateReset); v != "" {
+		if reset, _ := strconv.ParseInt(v, 10, 64); reset > 0 {
+			return time.Until(time.Unix(reset, 0))
+		}
+	}
+
+	return 0
+}
+
+// RetryPolicy configures how long to wait between retries of requests that
+// failed because of a rate limit (429) or a server error (>= 500).
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of times a request is retried.
+	MaxRetries int
+
+	// WaitMin is the time to wait before the first retry. The wait time is
+	// doubled for every following retry.
+	WaitMin time.Duration
+
+	// WaitMax is the maximum time to wait between retries, including the time
+	// to wait as requested by the server. Zero means no maximum.
+	WaitMax time.Duration
+
+	// Jitter is the fraction of the wait time, between 0 and 1, that is
+	// randomized to prevent many clients from retrying at the same time.
+	Jitter float64
+
+	// IgnoreServerWait ignores the time to wait as requested by the server
+	// using the Retry-After and RateLimit-Reset headers. If not set, the
+	// requested time is used when it is longer than the computed wait time,
+	// bounded by WaitMax.
+	IgnoreServerWait bool
+}
+
+// backoff returns the time to wait before the given retry attempt.
+func (p *RetryPolicy) backoff(attemptNum int, resp *http.Response) time.Duration {
+	wait := p.WaitMin
+	for i := 0; i < attemptNum && (p.WaitMax <= 0 || wait < p.WaitMax); i++ {
+		// Stop doubling before the duration overflows.
+		if wait > math.MaxInt64/2 {
+			break
+		}
+		wait *= 2
+	}
+	if p.WaitMax > 0 && wait > p.WaitMax {
+		wait = p.WaitMax
+	}
+
+	if p.Jitter > 0 {
+		jitter := time.Duration(randFloat64() * p.Jitter * float64(wait))
+		wait = wait - time.Duration(p.Jitter*float64(wait)/2) + jitter
+	}
+
+	if !p.IgnoreServerWait {
+		sw := serverRetryWait(resp)
+		if p.WaitMax > 0 && sw > p.WaitMax {
+			sw = p.WaitMax
+		}
+		if sw > wait {
+			wait = sw
+		}
+	}
+
+	return wait
+}
+
 // configureLimiter configures the rate limiter.
 func (c *Client) configureLimiter(ctx context.Context) error {
 	// Set default values for when rate limiting is disabled.
@@ -465,6 +726,15 @@ func (c *Client) BaseURL() *url.URL {
 	return &u
 }
 
+// Close closes any idle connections kept open by the HTTP client of c. It
+// doesn't interrupt requests in flight, and c can still be used afterwards,
+// in which case new connections are opened as needed. Note that clients
+// created using NewInstanceClient share the connection pool of c, unless they
+// were created with transport options.
+func (c *Client) Close() {
+	c.client.HTTPClient.CloseIdleConnections()
+}
+
 // setBaseURL sets the base URL for API requests to a custom endpoint.
 func (c *Client) setBaseURL(urlStr string) error {
 	// Make sure the given URL end with a slash
@@ -492,6 +762,10 @@ func (c *Client) setBaseURL(urlStr string) error {
 // Relative URL paths should always be specified without a preceding slash. If
 // specified, the value pointed to by body is JSON encoded and included as the
 // request body.
+//
+// NewRequest and Do can be used to call endpoints that are not (yet) modeled
+// by this package. Every request is labeled with a low-cardinality endpoint
+// name that can be used by metrics and tracing, see RegisterEndpoint.
 func (c *Client) NewRequest(method, path string, opt interface{}, options []RequestOptionFunc) (*retryablehttp.Request, error) {
 	u := *c.baseURL
 	unescaped, err := url.PathUnescape(path)
@@ -568,12 +842,53 @@ type Response struct {
 	CurrentPage  int
 	NextPage     int
 	PreviousPage int
+
+	// These fields provide the URLs of the relations found in the Link
+	// header. Like the page values above, any or all of these may be empty.
+	FirstLink    string
+	LastLink     string
+	NextLink     string
+	PreviousLink string
+
+	// RequestID is the ID GitLab assigned to the request, as returned in the
+	// X-Request-Id header. It can be used to find the request in the GitLab
+	// logs.
+	RequestID string
+
+	// RateLimit holds the rate limit values returned in the RateLimit
+	// headers. It is nil when GitLab didn't return rate limit headers, which
+	// is the case for endpoints and instances without rate limits.
+	RateLimit *RateLimit
+
+	// FromCache is true when GitLab responded with 304 Not Modified and the
+	// body was read from the response cache, see WithResponseCache.
+	FromCache bool
+}
+
+// RateLimit represents the rate limit state of the client as reported by
+// GitLab.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/administration/settings/user_and_ip_rate_limits.html#response-headers
+type RateLimit struct {
+	// Limit is the number of requests allowed per period, Observed the
+	// number of requests made in the current period and Remaining the
+	// number of requests left in the current period.
+	Limit     int
+	Observed  int
+	Remaining int
+
+	// Reset is the time the current period ends.
+	Reset time.Time
 }
 
 // newResponse creates a new Response for the provided http.Response.
 func newResponse(r *http.Response) *Response {
 	response := &Response{Response: r}
 	response.populatePageValues()
+	response.populateLinkValues()
+	response.populateRateLimit()
+	response.RequestID = r.Header.Get(xRequestID)
 	return response
 }
 
@@ -584,6 +899,8 @@ const (
 	xPage       = "X-Page"
 	xNextPage   = "X-Next-Page"
 	xPrevPage   = "X-Prev-Page"
+	linkHeader  = "Link"
+	xRequestID  = "X-Request-Id"
 )
 
 // populatePageValues parses the HTTP Link response headers and populates the
@@ -609,12 +926,142 @@ func (r *Response) populatePageValues() {
 	}
 }
 
+// populateRateLimit parses the rate limit response headers and populates the
+// RateLimit of the Response.
+func (r *Response) populateRateLimit() {
+	limit := r.Response.Header.Get(headerRateLimit)
+	if limit == "" {
+		return
+	}
+
+	rl := &RateLimit{}
+	rl.Limit, _ = strconv.Atoi(limit)
+	rl.Observed, _ = strconv.Atoi(r.Response.Header.Get(headerRateObserved))
+	rl.Remaining, _ = strconv.Atoi(r.Response.Header.Get(headerRateRemaining))
+	if reset, _ := strconv.ParseInt(r.Response.Header.Get(headerRateReset), 10, 64); reset > 0 {
+		rl.Reset = time.Unix(reset, 0)
+	}
+	r.RateLimit = rl
+}
+
+// populateLinkValues parses the HTTP Link response headers and populates the
+// various link values in the Response. When GitLab omits the X-Next-Page or
+// X-Prev-Page headers, the page values are derived from the links instead.
+func (r *Response) populateLinkValues() {
+	for _, header := range r.Response.Header[linkHeader] {
+		for _, link := range strings.Split(header, ",") {
+			segments := strings.Split(strings.TrimSpace(link), ";")
+			if len(segments) < 2 {
+				continue
+			}
+
+			target := strings.TrimSpace(segments[0])
+			if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+				continue
+			}
+			target = target[1 : len(target)-1]
+
+			for _, param := range segments[1:] {
+				param = strings.TrimSpace(param)
+				if !strings.HasPrefix(param, "rel=") {
+					continue
+				}
+				for _, rel := range strings.Fields(strings.Trim(param[len("rel="):], `"`)) {
+					switch rel {
+					case "first":
+						r.FirstLink = target
+					case "last":
+						r.LastLink = target
+					case "next":
+						r.NextLink = target
+					case "prev":
+						r.PreviousLink = target
+					}
+				}
+			}
+		}
+	}
+
+	if r.NextPage == 0 && r.NextLink != "" {
+		r.NextPage = pageFromLink(r.NextLink)
+	}
+	if r.PreviousPage == 0 && r.PreviousLink != "" {
+		r.PreviousPage = pageFromLink(r.PreviousLink)
+	}
+}
+
+// pageFromLink returns the value of the page query parameter of the given
+// link, or 0 if the link does not contain a (valid) page parameter.
+func pageFromLink(link string) int {
+	u, err := url.Parse(link)
+	if err != nil {
+		return 0
+	}
+	page, _ := strconv.Atoi(u.Query().Get("page"))
+	return page
+}
+
+// TotalCountWithheld reports whether GitLab withheld the total number of items
+// and pages of a paginated response. GitLab omits the X-Total and
+// X-Total-Pages headers for collections with more than 10,000 items, in which
+// case TotalItems and TotalPages are 0 and the end of the collection can only
+// be detected using NextPage or NextLink.
+func (r *Response) TotalCountWithheld() bool {
+	if r.Response == nil || r.Response.Header.Get(xPerPage) == "" {
+		return false
+	}
+	return r.Response.Header.Get(xTotal) == "" || r.Response.Header.Get(xTotalPages) == ""
+}
+
 // Do sends an API request and returns the API response. The API response is
 // JSON decoded and stored in the value pointed to by v, or returned as an
 // error if an API error has occurred. If v implements the io.Writer
 // interface, the raw response body will be written to v, without attempting to
 // first decode it.
 func (c *Client) Do(req *retryablehttp.Request, v interface{}) (*Response, error) {
+	// Label the request with its endpoint, unless it was set explicitly.
+	if EndpointFromContext(req.Context()) == "" {
+		path := strings.TrimPrefix(req.URL.EscapedPath(), c.baseURL.Path)
+		req = req.WithContext(withEndpoint(req.Context(), c.endpointFor(req.Method, path)))
+	}
+
+	var (
+		call *APICall
+		span CallSpan
+	)
+	if c.tracer != nil || c.metrics != nil {
+		call = &APICall{Method: req.Method, Endpoint: EndpointFromContext(req.Context()), Start: time.Now()}
+	}
+	if c.tracer != nil {
+		var ctx context.Context
+		ctx, span = c.tracer.StartCall(req.Context(), call)
+		req = req.WithContext(ctx)
+	}
+
+	resp, err := c.do(req, v)
+
+	if call != nil {
+		result := newAPICallResult(call.Start, resp, err)
+		if span != nil {
+			span.End(result)
+		}
+		if c.metrics != nil {
+			c.metrics.RecordCall(call, result)
+		}
+	}
+
+	for _, fn := range c.responseHooks {
+		fn(resp, err)
+	}
+	if fn, ok := req.Context().Value(responseCallbackKey{}).(ResponseHookFunc); ok {
+		fn(resp, err)
+	}
+
+	return resp, err
+}
+
+// do implements Do, without calling the response hooks.
+func (c *Client) do(req *retryablehttp.Request, v interface{}) (*Response, error) {
 	// If not yet configured, try to configure the rate limiter. Fail
 	// silently as the limiter will be disabled in case of an error.
 	c.configureLimiterOnce.Do(func() { c.configureLimiter(req.Context()) })
@@ -641,12 +1088,33 @@ func (c *Client) Do(req *retryablehttp.Request, v interface{}) (*Response, error
 			}
 		}
 		req.Header.Set("Authorization", "Bearer "+basicAuthToken)
+	case jobToken:
+		if !c.jobTokenUnrestricted && !jobTokenAllowed(req.Method, strings.TrimPrefix(req.URL.EscapedPath(), c.baseURL.Path)) {
+			return nil, fmt.Errorf("%w: %s %s", ErrJobTokenEndpointNotAllowed, req.Method, req.URL.Path)
+		}
+		req.Header.Set("JOB-TOKEN", c.token)
 	case oAuthToken:
 		req.Header.Set("Authorization", "Bearer "+c.token)
 	case privateToken:
 		req.Header.Set("PRIVATE-TOKEN", c.token)
 	}
 
+	if c.requestIDFunc != nil && req.Header.Get(xRequestID) == "" {
+		req.Header.Set(xRequestID, c.requestIDFunc())
+	}
+
+	var (
+		cacheKey string
+		cached   *CachedResponse
+	)
+	if _, ok := v.(io.Writer); c.responseCache != nil && !ok {
+		cacheKey, cached = c.prepareConditionalRequest(req)
+	}
+
+	for _, fn := range c.requestHooks {
+		fn(req)
+	}
+
 	resp, err := c.client.Do(req)
 	if err != nil {
 		return nil, err
@@ -658,14 +1126,36 @@ func (c *Client) Do(req *retryablehttp.Request, v interface{}) (*Response, error
 		if _, err := c.requestOAuthToken(req.Context(), basicAuthToken); err != nil {
 			return nil, err
 		}
-		return c.Do(req, v)
+		return c.do(req, v)
 	}
 	defer resp.Body.Close()
 
+	fromCache := resp.StatusCode == http.StatusNotModified && cached != nil
+	if fromCache {
+		useCachedResponse(resp, cached)
+	}
+
+	// Streamed downloads are not limited, as the caller controls the writer.
+	var body *limitedBody
+	if _, ok := v.(io.Writer); c.maxResponseBodySize > 0 && (!ok || resp.StatusCode >= http.StatusBadRequest) {
+		body = &limitedBody{r: resp.Body, remaining: c.maxResponseBodySize, limit: c.maxResponseBodySize}
+		resp.Body = body
+	}
+
+	if cacheKey != "" && resp.StatusCode == http.StatusOK {
+		if err := c.storeResponse(cacheKey, resp); err != nil {
+			return newResponse(resp), err
+		}
+	}
+
 	response := newResponse(resp)
+	response.FromCache = fromCache
 
 	err = CheckResponse(resp)
 	if err != nil {
+		if body != nil && body.err != nil {
+			return response, body.err
+		}
 		// Even though there was an error, we still return the response
 		// in case the caller wants to inspect it further.
 		return response, err
@@ -734,12 +1224,32 @@ type ErrorResponse struct {
 	Body     []byte
 	Response *http.Response
 	Message  string
+
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+
+	// ErrorMessage is the error message returned by GitLab, when the error
+	// isn't reported per field.
+	ErrorMessage string
+
+	// RequestID is the ID GitLab assigned to the request, as returned in the
+	// X-Request-Id header.
+	RequestID string
+
+	// Errors contains the validation errors per field, as returned by GitLab
+	// for invalid requests. Fields of embedded entities are joined with a dot,
+	// for example "namespace.path".
+	Errors map[string][]string
 }
 
 func (e *ErrorResponse) Error() string {
 	path, _ := url.QueryUnescape(e.Response.Request.URL.Path)
 	u := fmt.Sprintf("%s://%s%s", e.Response.Request.URL.Scheme, e.Response.Request.URL.Host, path)
-	return fmt.Sprintf("%s %s: %d %s", e.Response.Request.Method, u, e.Response.StatusCode, e.Message)
+	msg := fmt.Sprintf("%s %s: %d %s", e.Response.Request.Method, u, e.Response.StatusCode, e.Message)
+	if e.RequestID != "" {
+		msg += fmt.Sprintf(" (request ID: %s)", e.RequestID)
+	}
+	return msg
 }
 
 // CheckResponse checks the API response for errors, and returns them if present.
@@ -749,7 +1259,7 @@ func CheckResponse(r *http.Response) error {
 		return nil
 	}
 
-	errorResponse := &ErrorResponse{Response: r}
+	errorResponse := &ErrorResponse{Response: r, StatusCode: r.StatusCode, RequestID: r.Header.Get(xRequestID)}
 	data, err := ioutil.ReadAll(r.Body)
 	if err == nil && data != nil {
 		errorResponse.Body = data
@@ -759,12 +1269,85 @@ func CheckResponse(r *http.Response) error {
 			errorResponse.Message = "failed to parse unknown error format"
 		} else {
 			errorResponse.Message = parseError(raw)
+			errorResponse.parseDetails(raw)
 		}
 	}
 
 	return errorResponse
 }
 
+// parseDetails sets the error message and field errors of the response from
+// the decoded response body.
+func (e *ErrorResponse) parseDetails(raw interface{}) {
+	m, ok := raw.(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	for _, key := range []string{"message", "error"} {
+		switch v := m[key].(type) {
+		case string:
+			if e.ErrorMessage == "" {
+				e.ErrorMessage = v
+			}
+		case []interface{}:
+			if e.ErrorMessage == "" {
+				e.ErrorMessage = strings.Trim(parseError(v), "[]")
+			}
+		case map[string]interface{}:
+			e.Errors = make(map[string][]string)
+			collectFieldErrors(e.Errors, "", v)
+		}
+	}
+}
+
+// collectFieldErrors adds the field errors of a (nested) validation error
+// message to errs.
+func collectFieldErrors(errs map[string][]string, prefix string, raw map[string]interface{}) {
+	for k, v := range raw {
+		field := prefix + k
+		switch v := v.(type) {
+		case map[string]interface{}:
+			collectFieldErrors(errs, field+".", v)
+		case []interface{}:
+			for _, msg := range v {
+				errs[field] = append(errs[field], parseError(msg))
+			}
+		default:
+			errs[field] = append(errs[field], parseError(v))
+		}
+	}
+}
+
+// HasStatusCode reports whether err is, or wraps, an *ErrorResponse with the
+// given HTTP status code.
+func HasStatusCode(err error, code int) bool {
+	var errResp *ErrorResponse
+	return errors.As(err, &errResp) && errResp.StatusCode == code
+}
+
+// IsNotFound reports whether err is caused by a 404 Not Found response.
+func IsNotFound(err error) bool {
+	return HasStatusCode(err, http.StatusNotFound)
+}
+
+// IsForbidden reports whether err is caused by a 403 Forbidden response.
+func IsForbidden(err error) bool {
+	return HasStatusCode(err, http.StatusForbidden)
+}
+
+// IsUnauthorized reports whether err is caused by a 401 Unauthorized
+// response.
+func IsUnauthorized(err error) bool {
+	return HasStatusCode(err, http.StatusUnauthorized)
+}
+
+// IsRateLimited reports whether err is caused by a 429 Too Many Requests
+// response.
+func IsRateLimited(err error) bool {
+	return HasStatusCode(err, http.StatusTooManyRequests)
+}
+
 // Format:
 // {
 //     "message": {
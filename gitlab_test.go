@@ -20,16 +20,20 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	retryablehttp "github.com/hashicorp/go-retryablehttp"
+	"golang.org/x/time/rate"
 )
 
 // setup sets up a test HTTP server along with a gitlab.Client that is
@@ -171,6 +175,106 @@ func TestCheckResponse(t *testing.T) {
 	}
 }
 
+func TestCheckResponseDetails(t *testing.T) {
+	c, err := NewClient("")
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	req, err := c.NewRequest(http.MethodPost, "test", nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	resp := &http.Response{
+		Request:    req.Request,
+		StatusCode: http.StatusBadRequest,
+		Body: ioutil.NopCloser(strings.NewReader(`{
+			"message": {
+				"name": ["can't be blank"],
+				"namespace": {"path": ["is taken", "is too short"]}
+			}
+		}`)),
+	}
+
+	var errResp *ErrorResponse
+	if !errors.As(CheckResponse(resp), &errResp) {
+		t.Fatal("Expected *ErrorResponse")
+	}
+
+	if errResp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected status code %d, got %d", http.StatusBadRequest, errResp.StatusCode)
+	}
+	want := map[string][]string{
+		"name":           {"can't be blank"},
+		"namespace.path": {"is taken", "is too short"},
+	}
+	if !reflect.DeepEqual(want, errResp.Errors) {
+		t.Errorf("Expected field errors %v, got %v", want, errResp.Errors)
+	}
+
+	resp = &http.Response{
+		Request:    req.Request,
+		StatusCode: http.StatusNotFound,
+		Body:       ioutil.NopCloser(strings.NewReader(`{"message": "404 Project Not Found"}`)),
+	}
+
+	err = fmt.Errorf("getting project: %w", CheckResponse(resp))
+	if !errors.As(err, &errResp) || errResp.ErrorMessage != "404 Project Not Found" {
+		t.Errorf("Expected error message to be parsed, got %v", err)
+	}
+	if !IsNotFound(err) || IsForbidden(err) || IsRateLimited(err) || IsUnauthorized(err) {
+		t.Errorf("Unexpected status helper results for %v", err)
+	}
+	if IsNotFound(errors.New("404 Not Found")) {
+		t.Error("Expected IsNotFound to ignore other errors")
+	}
+}
+
+func TestRequestID(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", r.Header.Get("X-Request-Id"))
+		fmt.Fprint(w, `{"id":1}`)
+	})
+	mux.HandleFunc("/api/v4/projects/2", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", r.Header.Get("X-Request-Id"))
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"404 Project Not Found"}`)
+	})
+
+	_, resp, err := client.Projects.GetProject(1, nil, WithRequestID("explicit"))
+	if err != nil {
+		t.Fatalf("Projects.GetProject returned error: %v", err)
+	}
+	if resp.RequestID != "explicit" {
+		t.Errorf("Expected request ID %q, got %q", "explicit", resp.RequestID)
+	}
+
+	if err := WithRequestIDFunc(func() string { return "generated" })(client); err != nil {
+		t.Fatalf("Failed to set request ID func: %v", err)
+	}
+
+	_, resp, err = client.Projects.GetProject(1, nil)
+	if err != nil {
+		t.Fatalf("Projects.GetProject returned error: %v", err)
+	}
+	if resp.RequestID != "generated" {
+		t.Errorf("Expected request ID %q, got %q", "generated", resp.RequestID)
+	}
+
+	_, _, err = client.Projects.GetProject(2, nil)
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.RequestID != "generated" {
+		t.Fatalf("Expected *ErrorResponse with request ID, got %v", err)
+	}
+	if !strings.HasSuffix(err.Error(), "(request ID: generated)") {
+		t.Errorf("Expected request ID in error message, got %q", err.Error())
+	}
+}
+
 func TestRequestWithContext(t *testing.T) {
 	c, err := NewClient("")
 	if err != nil {
@@ -189,6 +293,335 @@ func TestRequestWithContext(t *testing.T) {
 	}
 }
 
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := &RetryPolicy{WaitMin: time.Second, WaitMax: 5 * time.Second}
+
+	for attempt, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second} {
+		if got := p.backoff(attempt, nil); got != want {
+			t.Errorf("backoff(%d) returned %v, want %v", attempt, got, want)
+		}
+	}
+
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+	resp.Header.Set("Retry-After", "3")
+	if got := p.backoff(0, resp); got != 3*time.Second {
+		t.Errorf("backoff with Retry-After returned %v, want %v", got, 3*time.Second)
+	}
+
+	// The time requested by the server is bounded by WaitMax.
+	resp.Header.Set("Retry-After", "30")
+	if got := p.backoff(0, resp); got != 5*time.Second {
+		t.Errorf("backoff with Retry-After returned %v, want %v", got, 5*time.Second)
+	}
+
+	p.IgnoreServerWait = true
+	if got := p.backoff(0, resp); got != time.Second {
+		t.Errorf("backoff ignoring Retry-After returned %v, want %v", got, time.Second)
+	}
+
+	p.Jitter = 0.5
+	for i := 0; i < 10; i++ {
+		if got := p.backoff(2, nil); got < 3*time.Second || got > 5*time.Second {
+			t.Errorf("backoff with jitter returned %v, want between 3s and 5s", got)
+		}
+	}
+}
+
+func TestRetryPolicyBackoffWithoutWaitMax(t *testing.T) {
+	p := &RetryPolicy{WaitMin: time.Second}
+
+	prev := time.Duration(0)
+	for attempt := 0; attempt < 100; attempt++ {
+		got := p.backoff(attempt, nil)
+		if got < prev {
+			t.Fatalf("backoff(%d) returned %v, want at least %v", attempt, got, prev)
+		}
+		prev = got
+	}
+}
+
+func TestWithRetryPolicy(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	attempts := 0
+	mux.HandleFunc("/api/v4/test", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	policy := RetryPolicy{MaxRetries: 2, WaitMin: time.Millisecond, WaitMax: 2 * time.Millisecond}
+	c, err := NewClient("", WithBaseURL(server.URL), WithRetryPolicy(policy))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	req, err := c.NewRequest(http.MethodGet, "test", nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	if _, err := c.Do(req, nil); err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if attempts != 3 {
+		t.Errorf("Do made %d attempts, want 3", attempts)
+	}
+
+	if _, err := NewClient("", WithRetryPolicy(RetryPolicy{Jitter: 2})); err == nil {
+		t.Error("NewClient accepted an invalid jitter fraction")
+	}
+}
+
+func TestNewInstanceClient(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/test", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("PRIVATE-TOKEN"); got != "other" {
+			t.Errorf("Request used token %q, want %q", got, "other")
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	client.RegisterEndpoint(http.MethodGet, "test")
+
+	other, err := client.NewInstanceClient(server.URL, "other")
+	if err != nil {
+		t.Fatalf("Failed to create instance client: %v", err)
+	}
+
+	if other.client.HTTPClient != client.client.HTTPClient {
+		t.Error("Instance client does not share the HTTP client")
+	}
+	if got := other.BaseURL().String(); got != server.URL+"/api/v4/" {
+		t.Errorf("Instance client has base URL %q, want %q", got, server.URL+"/api/v4/")
+	}
+
+	req, err := other.NewRequest(http.MethodGet, "test", nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	if _, err := other.Do(req, nil); err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+
+	httpClient := &http.Client{}
+	if _, err := client.NewInstanceClient(server.URL, "", WithHTTPClient(httpClient)); err != nil {
+		t.Fatalf("Failed to create instance client: %v", err)
+	}
+	if client.client.HTTPClient == httpClient {
+		t.Error("Options of the instance client changed the original client")
+	}
+}
+
+func TestTransportOptions(t *testing.T) {
+	c, err := NewClient("",
+		WithMaxIdleConnsPerHost(64),
+		WithMaxConnsPerHost(128),
+		WithIdleConnTimeout(30*time.Second),
+		WithTLSSessionCache(32),
+		WithHTTP2(true),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	tr, ok := c.client.HTTPClient.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("Transport is %T, want *http.Transport", c.client.HTTPClient.Transport)
+	}
+	if tr.MaxIdleConnsPerHost != 64 {
+		t.Errorf("MaxIdleConnsPerHost is %d, want 64", tr.MaxIdleConnsPerHost)
+	}
+	if tr.MaxConnsPerHost != 128 {
+		t.Errorf("MaxConnsPerHost is %d, want 128", tr.MaxConnsPerHost)
+	}
+	if tr.IdleConnTimeout != 30*time.Second {
+		t.Errorf("IdleConnTimeout is %v, want 30s", tr.IdleConnTimeout)
+	}
+	if tr.TLSClientConfig == nil || tr.TLSClientConfig.ClientSessionCache == nil {
+		t.Error("TLS session cache is not configured")
+	}
+	if !tr.ForceAttemptHTTP2 {
+		t.Error("HTTP/2 is not enabled")
+	}
+
+	other, err := c.NewInstanceClient("https://gitlab.example.com", "", WithHTTP2(false))
+	if err != nil {
+		t.Fatalf("Failed to create instance client: %v", err)
+	}
+	if !tr.ForceAttemptHTTP2 {
+		t.Error("Options of the instance client changed the original transport")
+	}
+	if otr := other.client.HTTPClient.Transport.(*http.Transport); otr.ForceAttemptHTTP2 || otr.TLSNextProto == nil {
+		t.Error("HTTP/2 is not disabled for the instance client")
+	}
+
+	if _, err := NewClient("", WithHTTPClient(&http.Client{Transport: roundTripperFunc(nil)}), WithHTTP2(true)); err == nil {
+		t.Error("Expected an error for a custom transport")
+	}
+
+	// Close must be safe to call more than once.
+	c.Close()
+	c.Close()
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (fn roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return fn(req)
+}
+
+func TestResponseRateLimit(t *testing.T) {
+	header := http.Header{}
+	resp := newResponse(&http.Response{Header: header})
+	if resp.RateLimit != nil {
+		t.Errorf("RateLimit is %+v, want nil", resp.RateLimit)
+	}
+
+	header.Set("RateLimit-Limit", "600")
+	header.Set("RateLimit-Observed", "67")
+	header.Set("RateLimit-Remaining", "533")
+	header.Set("RateLimit-Reset", "1609844400")
+	resp = newResponse(&http.Response{Header: header})
+
+	want := &RateLimit{Limit: 600, Observed: 67, Remaining: 533, Reset: time.Unix(1609844400, 0)}
+	if !reflect.DeepEqual(want, resp.RateLimit) {
+		t.Errorf("RateLimit is %+v, want %+v", resp.RateLimit, want)
+	}
+}
+
+func TestWithRateLimit(t *testing.T) {
+	c, err := NewClient("", WithRateLimit(10, 5))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	l, ok := c.limiter.(*rate.Limiter)
+	if !ok {
+		t.Fatalf("Limiter is %T, want *rate.Limiter", c.limiter)
+	}
+	if l.Limit() != 10 || l.Burst() != 5 {
+		t.Errorf("Limiter allows %v requests per second with burst %d, want 10 and 5", l.Limit(), l.Burst())
+	}
+
+	if _, err := NewClient("", WithRateLimit(0, 5)); err == nil {
+		t.Error("Expected an error for an invalid rate limit")
+	}
+}
+
+func TestRequestResponseHooks(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/test", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Hook"); got != "set" {
+			t.Errorf("Request header X-Hook is %q, want set", got)
+		}
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"404 Not Found"}`)
+	})
+
+	var calls []string
+	for _, fn := range []ClientOptionFunc{
+		WithRequestHook(func(req *retryablehttp.Request) {
+			calls = append(calls, "request "+req.Method)
+			req.Header.Set("X-Hook", "set")
+		}),
+		WithResponseHook(func(resp *Response, err error) {
+			calls = append(calls, fmt.Sprintf("response %d %v", resp.StatusCode, IsNotFound(err)))
+		}),
+	} {
+		if err := fn(client); err != nil {
+			t.Fatalf("Failed to apply option: %v", err)
+		}
+	}
+	client.RegisterEndpoint(http.MethodGet, "test")
+
+	req, err := client.NewRequest(http.MethodGet, "test", nil, []RequestOptionFunc{
+		WithResponseCallback(func(resp *Response, err error) {
+			calls = append(calls, "callback")
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	if _, err := client.Do(req, nil); !IsNotFound(err) {
+		t.Fatalf("Do returned error %v, want 404", err)
+	}
+
+	want := []string{"request GET", "response 404 true", "callback"}
+	if !reflect.DeepEqual(want, calls) {
+		t.Errorf("Hooks were called as %v, want %v", calls, want)
+	}
+
+	other, err := client.NewInstanceClient(server.URL, "")
+	if err != nil {
+		t.Fatalf("Failed to create instance client: %v", err)
+	}
+	if len(other.requestHooks) != 1 || len(other.responseHooks) != 1 {
+		t.Error("Instance client does not share the hooks")
+	}
+}
+
+func TestResponseLinkValues(t *testing.T) {
+	header := http.Header{}
+	header.Set("X-Per-Page", "20")
+	header.Set("X-Page", "2")
+	header.Add("Link", `<https://gitlab.example.com/api/v4/projects?page=1&per_page=20>; rel="prev", `+
+		`<https://gitlab.example.com/api/v4/projects?page=3&per_page=20>; rel="next"`)
+	header.Add("Link", `<https://gitlab.example.com/api/v4/projects?page=1&per_page=20>; rel="first"`)
+
+	resp := newResponse(&http.Response{Header: header})
+
+	if resp.FirstLink != "https://gitlab.example.com/api/v4/projects?page=1&per_page=20" {
+		t.Errorf("FirstLink is %q", resp.FirstLink)
+	}
+	if resp.PreviousLink != "https://gitlab.example.com/api/v4/projects?page=1&per_page=20" {
+		t.Errorf("PreviousLink is %q", resp.PreviousLink)
+	}
+	if resp.NextLink != "https://gitlab.example.com/api/v4/projects?page=3&per_page=20" {
+		t.Errorf("NextLink is %q", resp.NextLink)
+	}
+	if resp.LastLink != "" {
+		t.Errorf("LastLink is %q, want it to be empty", resp.LastLink)
+	}
+
+	// The page values are derived from the links when the headers are missing.
+	if resp.NextPage != 3 {
+		t.Errorf("NextPage is %d, want 3", resp.NextPage)
+	}
+	if resp.PreviousPage != 1 {
+		t.Errorf("PreviousPage is %d, want 1", resp.PreviousPage)
+	}
+
+	if !resp.TotalCountWithheld() {
+		t.Error("TotalCountWithheld returned false, want true")
+	}
+}
+
+func TestResponseTotalCountWithheld(t *testing.T) {
+	header := http.Header{}
+	header.Set("X-Per-Page", "20")
+	header.Set("X-Total", "42")
+	header.Set("X-Total-Pages", "3")
+
+	resp := newResponse(&http.Response{Header: header})
+	if resp.TotalCountWithheld() {
+		t.Error("TotalCountWithheld returned true for a response including the totals")
+	}
+
+	resp = newResponse(&http.Response{Header: http.Header{}})
+	if resp.TotalCountWithheld() {
+		t.Error("TotalCountWithheld returned true for a non-paginated response")
+	}
+}
+
 func loadFixture(filePath string) []byte {
 	content, err := ioutil.ReadFile(filePath)
 	if err != nil {
@@ -0,0 +1,212 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+)
+
+// GraphQLService handles communication with the GraphQL API of GitLab.
+// Requests are sent through the same client as the REST API, so they share
+// its authentication, retry and rate limit handling.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/graphql/
+type GraphQLService struct {
+	client *Client
+}
+
+// GraphQLQuery represents a GraphQL query or mutation.
+type GraphQLQuery struct {
+	Query         string
+	OperationName string
+	Variables     map[string]interface{}
+}
+
+// GraphQLError represents an error returned by the GraphQL API.
+type GraphQLError struct {
+	Message   string `json:"message"`
+	Locations []struct {
+		Line   int `json:"line"`
+		Column int `json:"column"`
+	} `json:"locations"`
+	Path       []interface{}          `json:"path"`
+	Extensions map[string]interface{} `json:"extensions"`
+}
+
+func (e GraphQLError) String() string {
+	return Stringify(e)
+}
+
+// GraphQLErrors represents the errors returned by the GraphQL API. The
+// GraphQL API reports errors in the response body instead of using HTTP
+// status codes, so these are returned as an error by the GraphQL methods.
+type GraphQLErrors []*GraphQLError
+
+func (e GraphQLErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, err.Message)
+	}
+	return "graphql: " + strings.Join(msgs, "; ")
+}
+
+// graphQLRequest represents the body of a GraphQL request.
+type graphQLRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName,omitempty"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+}
+
+// graphQLResponse represents the body of a GraphQL response.
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors GraphQLErrors   `json:"errors"`
+}
+
+// graphQLPageInfo represents the page info of a GraphQL connection.
+type graphQLPageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
+
+// Do executes a GraphQL query or mutation and decodes the returned data into
+// v. If the response contains errors, a GraphQLErrors error is returned after
+// any partial data has been decoded into v.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/graphql/
+func (s *GraphQLService) Do(q GraphQLQuery, v interface{}, options ...RequestOptionFunc) (*Response, error) {
+	return s.client.doGraphQLRequest(&graphQLRequest{
+		Query:         q.Query,
+		OperationName: q.OperationName,
+		Variables:     q.Variables,
+	}, v, options)
+}
+
+// Query executes a GraphQL query with the given variables and decodes the
+// returned data into v.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/graphql/
+func (s *GraphQLService) Query(query string, variables map[string]interface{}, v interface{}, options ...RequestOptionFunc) (*Response, error) {
+	return s.Do(GraphQLQuery{Query: query, Variables: variables}, v, options...)
+}
+
+// Mutate executes a GraphQL mutation and decodes the returned data into v.
+// GitLab mutations take a single input argument, so the given input is passed
+// as the $input variable.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/graphql/
+func (s *GraphQLService) Mutate(mutation string, input interface{}, v interface{}, options ...RequestOptionFunc) (*Response, error) {
+	return s.Do(GraphQLQuery{Query: mutation, Variables: map[string]interface{}{"input": input}}, v, options...)
+}
+
+// Raw executes a GraphQL query or mutation and returns the undecoded data of
+// the response.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/graphql/
+func (s *GraphQLService) Raw(q GraphQLQuery, options ...RequestOptionFunc) (json.RawMessage, *Response, error) {
+	var data json.RawMessage
+	resp, err := s.Do(q, &data, options...)
+	return data, resp, err
+}
+
+// doGraphQL executes a GraphQL query against the GraphQL endpoint of the
+// GitLab instance and decodes the returned data into v.
+func (c *Client) doGraphQL(query string, variables map[string]interface{}, v interface{}, options []RequestOptionFunc) (*Response, error) {
+	return c.doGraphQLRequest(&graphQLRequest{Query: query, Variables: variables}, v, options)
+}
+
+// doGraphQLRequest sends a GraphQL request and decodes the returned data into
+// v. Errors in the response body are returned as GraphQLErrors.
+func (c *Client) doGraphQLRequest(gq *graphQLRequest, v interface{}, options []RequestOptionFunc) (*Response, error) {
+	u := *c.baseURL
+	u.Path = strings.TrimSuffix(u.Path, apiVersionPath) + "api/graphql"
+	u.RawPath = ""
+
+	body, err := json.Marshal(gq)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := retryablehttp.NewRequest(http.MethodPost, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fn := range options {
+		if fn == nil {
+			continue
+		}
+		if err := fn(req); err != nil {
+			return nil, err
+		}
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+
+	gr := new(graphQLResponse)
+	resp, err := c.Do(req, gr)
+	if err != nil {
+		return resp, err
+	}
+
+	if v != nil && len(gr.Data) > 0 && string(gr.Data) != "null" {
+		if err := json.Unmarshal(gr.Data, v); err != nil {
+			return resp, err
+		}
+	}
+
+	if len(gr.Errors) > 0 {
+		return resp, gr.Errors
+	}
+
+	return resp, nil
+}
+
+// GlobalID returns the GraphQL global ID of a resource, for example
+// gid://gitlab/User/42 for the user with ID 42.
+func GlobalID(resource string, id int) string {
+	return fmt.Sprintf("gid://gitlab/%s/%d", resource, id)
+}
+
+// ParseGlobalID returns the numeric ID of a GraphQL global ID, for example
+// 42 for gid://gitlab/User/42.
+func ParseGlobalID(gid string) (int, error) {
+	i := strings.LastIndex(gid, "/")
+	if !strings.HasPrefix(gid, "gid://gitlab/") || i == len(gid)-1 {
+		return 0, fmt.Errorf("invalid global ID %q", gid)
+	}
+	return strconv.Atoi(gid[i+1:])
+}
+
+// mutationError returns an error for the errors returned by a GraphQL
+// mutation, or nil if there are none.
+func mutationError(mutation string, errs []string) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s: %v", mutation, errs)
+}
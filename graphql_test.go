@@ -0,0 +1,118 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphQLQuery(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var req graphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "GetProject", req.OperationName)
+		assert.Equal(t, "group/project", req.Variables["fullPath"])
+
+		fmt.Fprint(w, `{"data": {"project": {"id": "gid://gitlab/Project/7", "name": "project"}}}`)
+	})
+
+	var data struct {
+		Project struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"project"`
+	}
+
+	_, err := client.GraphQL.Do(GraphQLQuery{
+		Query:         `query GetProject($fullPath: ID!) { project(fullPath: $fullPath) { id name } }`,
+		OperationName: "GetProject",
+		Variables:     map[string]interface{}{"fullPath": "group/project"},
+	}, &data)
+	require.NoError(t, err)
+	assert.Equal(t, "project", data.Project.Name)
+
+	id, err := ParseGlobalID(data.Project.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 7, id)
+	assert.Equal(t, data.Project.ID, GlobalID("Project", id))
+}
+
+func TestGraphQLMutate(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		var req graphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, map[string]interface{}{"projectPath": "group/project"}, req.Variables["input"])
+
+		fmt.Fprint(w, `{"data": {"workItemCreate": {"errors": []}}}`)
+	})
+
+	data, _, err := client.GraphQL.Raw(GraphQLQuery{
+		Query:     `mutation($input: WorkItemCreateInput!) { workItemCreate(input: $input) { errors } }`,
+		Variables: map[string]interface{}{"input": map[string]interface{}{"projectPath": "group/project"}},
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"workItemCreate": {"errors": []}}`, string(data))
+
+	_, err = client.GraphQL.Mutate(
+		`mutation($input: WorkItemCreateInput!) { workItemCreate(input: $input) { errors } }`,
+		map[string]interface{}{"projectPath": "group/project"},
+		nil,
+	)
+	require.NoError(t, err)
+}
+
+func TestGraphQLErrors(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+			"data": {"project": {"name": "project", "secret": null}},
+			"errors": [{"message": "denied", "path": ["project", "secret"], "extensions": {"code": "FORBIDDEN"}}]
+		}`)
+	})
+
+	var data struct {
+		Project struct {
+			Name string `json:"name"`
+		} `json:"project"`
+	}
+
+	_, err := client.GraphQL.Query(`{ project(fullPath: "group/project") { name secret } }`, nil, &data)
+	assert.EqualError(t, err, "graphql: denied")
+	assert.Equal(t, "project", data.Project.Name)
+
+	errs, ok := err.(GraphQLErrors)
+	require.True(t, ok)
+	require.Len(t, errs, 1)
+	assert.Equal(t, []interface{}{"project", "secret"}, errs[0].Path)
+	assert.Equal(t, "FORBIDDEN", errs[0].Extensions["code"])
+}
@@ -0,0 +1,137 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestListGroupAccessTokens(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/groups/1/access_tokens?page=2")
+		fmt.Fprint(w, `[{"id":1876,"user_id":2453,"name":"token 10","scopes":["api"],"active":true,"access_level":40}]`)
+	})
+
+	tokens, _, err := client.GroupAccessTokens.ListGroupAccessTokens(1, &ListGroupAccessTokensOptions{Page: 2})
+	if err != nil {
+		t.Fatalf("GroupAccessTokens.ListGroupAccessTokens returned error: %v", err)
+	}
+
+	want := []*GroupAccessToken{{ID: 1876, UserID: 2453, Name: "token 10", Scopes: []string{"api"}, Active: true, AccessLevel: MaintainerPermissions}}
+	if !reflect.DeepEqual(want, tokens) {
+		t.Errorf("GroupAccessTokens.ListGroupAccessTokens returned %+v, want %+v", tokens, want)
+	}
+}
+
+func TestGetGroupAccessToken(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/access_tokens/1876", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":1876,"user_id":2453,"name":"token 10","scopes":["api"],"active":true,"access_level":40}`)
+	})
+
+	token, _, err := client.GroupAccessTokens.GetGroupAccessToken(1, 1876)
+	if err != nil {
+		t.Fatalf("GroupAccessTokens.GetGroupAccessToken returned error: %v", err)
+	}
+
+	want := &GroupAccessToken{ID: 1876, UserID: 2453, Name: "token 10", Scopes: []string{"api"}, Active: true, AccessLevel: MaintainerPermissions}
+	if !reflect.DeepEqual(want, token) {
+		t.Errorf("GroupAccessTokens.GetGroupAccessToken returned %+v, want %+v", token, want)
+	}
+}
+
+func TestCreateGroupAccessToken(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"name":"ci","scopes":["read_api"],"access_level":30}`)
+		fmt.Fprint(w, `{"id":1877,"user_id":2454,"name":"ci","scopes":["read_api"],"active":true,"access_level":30,"token":"glpat-new"}`)
+	})
+
+	token, _, err := client.GroupAccessTokens.CreateGroupAccessToken(1, &CreateGroupAccessTokenOptions{
+		Name:        String("ci"),
+		Scopes:      []string{"read_api"},
+		AccessLevel: AccessLevel(DeveloperPermissions),
+	})
+	if err != nil {
+		t.Fatalf("GroupAccessTokens.CreateGroupAccessToken returned error: %v", err)
+	}
+
+	want := &GroupAccessToken{ID: 1877, UserID: 2454, Name: "ci", Scopes: []string{"read_api"}, Active: true, AccessLevel: DeveloperPermissions, Token: "glpat-new"}
+	if !reflect.DeepEqual(want, token) {
+		t.Errorf("GroupAccessTokens.CreateGroupAccessToken returned %+v, want %+v", token, want)
+	}
+}
+
+func TestRotateGroupAccessToken(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/access_tokens/1877/rotate", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"expires_at":"2024-06-01"}`)
+		fmt.Fprint(w, `{"id":1878,"user_id":2454,"name":"ci","scopes":["read_api"],"expires_at":"2024-06-01","active":true,"access_level":30,"token":"glpat-rotated"}`)
+	})
+
+	expiresAt := ISOTime(time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC))
+	token, _, err := client.GroupAccessTokens.RotateGroupAccessToken(1, 1877, &RotateGroupAccessTokenOptions{ExpiresAt: &expiresAt})
+	if err != nil {
+		t.Fatalf("GroupAccessTokens.RotateGroupAccessToken returned error: %v", err)
+	}
+
+	want := &GroupAccessToken{
+		ID:          1878,
+		UserID:      2454,
+		Name:        "ci",
+		Scopes:      []string{"read_api"},
+		ExpiresAt:   &expiresAt,
+		Active:      true,
+		AccessLevel: DeveloperPermissions,
+		Token:       "glpat-rotated",
+	}
+	if !reflect.DeepEqual(want, token) {
+		t.Errorf("GroupAccessTokens.RotateGroupAccessToken returned %+v, want %+v", token, want)
+	}
+}
+
+func TestRevokeGroupAccessToken(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/access_tokens/1877", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.GroupAccessTokens.RevokeGroupAccessToken(1, 1877)
+	if err != nil {
+		t.Errorf("GroupAccessTokens.RevokeGroupAccessToken returned error: %v", err)
+	}
+}
@@ -48,14 +48,14 @@ type GroupHook struct {
 // ListGroupHooks gets a list of group hooks.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/groups.html#list-group-hooks
-func (s *GroupsService) ListGroupHooks(gid interface{}) ([]*GroupHook, *Response, error) {
+func (s *GroupsService) ListGroupHooks(gid interface{}, options ...RequestOptionFunc) ([]*GroupHook, *Response, error) {
 	group, err := parseID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
 	u := fmt.Sprintf("groups/%s/hooks", pathEscape(group))
 
-	req, err := s.client.NewRequest(http.MethodGet, u, nil, nil)
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -204,3 +204,48 @@ func (s *GroupsService) DeleteGroupHook(pid interface{}, hook int, options ...Re
 
 	return s.client.Do(req, nil)
 }
+
+// ListGroupHookDeliveries gets the logged deliveries of a group hook from
+// the past 7 days.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/groups.html#list-group-hook-events
+func (s *GroupsService) ListGroupHookDeliveries(gid interface{}, hook int, opt *ListHookDeliveriesOptions, options ...RequestOptionFunc) ([]*HookDelivery, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/hooks/%d/events", pathEscape(group), hook)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var hd []*HookDelivery
+	resp, err := s.client.Do(req, &hd)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return hd, resp, err
+}
+
+// ResendGroupHookDelivery resends a logged delivery of a group hook.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/groups.html#resend-group-hook-event
+func (s *GroupsService) ResendGroupHookDelivery(gid interface{}, hook int, delivery int, options ...RequestOptionFunc) (*Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("groups/%s/hooks/%d/events/%d/resend", pathEscape(group), hook, delivery)
+
+	req, err := s.client.NewRequest(http.MethodPost, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -279,3 +279,41 @@ func TestDeleteGroupHook(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestListGroupHookDeliveries(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/hooks/2/events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"id": 3, "trigger": "issue_hooks", "response_status": "200"}]`)
+	})
+
+	deliveries, _, err := client.Groups.ListGroupHookDeliveries(1, 2, nil)
+	if err != nil {
+		t.Fatalf("Groups.ListGroupHookDeliveries returned error: %v", err)
+	}
+
+	want := []*HookDelivery{{ID: 3, Trigger: "issue_hooks", ResponseStatus: "200"}}
+	if !reflect.DeepEqual(want, deliveries) {
+		t.Errorf("Groups.ListGroupHookDeliveries returned %+v, want %+v", deliveries, want)
+	}
+	if deliveries[0].Failed() {
+		t.Errorf("expected delivery 3 to have succeeded")
+	}
+}
+
+func TestResendGroupHookDelivery(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/hooks/2/events/3/resend", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	_, err := client.Groups.ResendGroupHookDelivery(1, 2, 3)
+	if err != nil {
+		t.Errorf("Groups.ResendGroupHookDelivery returned error: %v", err)
+	}
+}
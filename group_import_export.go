@@ -20,8 +20,8 @@ import (
 	"bytes"
 	"fmt"
 	"io"
-	"mime/multipart"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -79,6 +79,27 @@ func (s *GroupImportExportService) ExportDownload(gid interface{}, options ...Re
 	return bytes.NewReader(exportDownload.Bytes()), resp, err
 }
 
+// ExportDownloadStream downloads the finished export of a group and writes it
+// to w while it is received, so large exports don't have to be kept in
+// memory.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/group_import_export.html#export-download
+func (s *GroupImportExportService) ExportDownloadStream(gid interface{}, w io.Writer, options ...RequestOptionFunc) (*Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("groups/%s/export/download", pathEscape(group))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, w)
+}
+
 // GroupImportFileOptions represents the available ImportFile() options.
 //
 // GitLab API docs:
@@ -92,17 +113,13 @@ type GroupImportFileOptions struct {
 
 // ImportFile imports a file.
 //
+// Deprecated: ImportFile only supports importing a file from disk, use
+// ImportFromReader instead.
+//
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/group_import_export.html#import-a-file
 func (s *GroupImportExportService) ImportFile(opt *GroupImportFileOptions, options ...RequestOptionFunc) (*Response, error) {
-	// First check if we got all required options.
-	if opt.Name == nil || *opt.Name == "" {
-		return nil, fmt.Errorf("Missing required option: Name")
-	}
-	if opt.Path == nil || *opt.Path == "" {
-		return nil, fmt.Errorf("Missing required option: Path")
-	}
-	if opt.File == nil || *opt.File == "" {
+	if opt == nil || opt.File == nil || *opt.File == "" {
 		return nil, fmt.Errorf("Missing required option: File")
 	}
 
@@ -112,55 +129,34 @@ func (s *GroupImportExportService) ImportFile(opt *GroupImportFileOptions, optio
 	}
 	defer f.Close()
 
-	b := &bytes.Buffer{}
-	w := multipart.NewWriter(b)
-
 	_, filename := filepath.Split(*opt.File)
-	fw, err := w.CreateFormFile("file", filename)
-	if err != nil {
-		return nil, err
-	}
 
-	_, err = io.Copy(fw, f)
-	if err != nil {
-		return nil, err
-	}
-
-	// Populate the additional fields.
-	fw, err = w.CreateFormField("name")
-	if err != nil {
-		return nil, err
-	}
-
-	_, err = fw.Write([]byte(*opt.Name))
-	if err != nil {
-		return nil, err
-	}
+	return s.ImportFromReader(opt, f, filename, options...)
+}
 
-	fw, err = w.CreateFormField("path")
-	if err != nil {
-		return nil, err
+// ImportFromReader imports a group from an export archive read from r. The
+// File field of the options is ignored, the archive is uploaded using the
+// given filename. The archive is streamed while it is uploaded, so it is
+// never buffered in memory, but the request isn't retried once the upload
+// started.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/group_import_export.html#import-a-file
+func (s *GroupImportExportService) ImportFromReader(opt *GroupImportFileOptions, r io.Reader, filename string, options ...RequestOptionFunc) (*Response, error) {
+	// First check if we got all required options.
+	if opt == nil || opt.Name == nil || *opt.Name == "" {
+		return nil, fmt.Errorf("Missing required option: Name")
 	}
-
-	_, err = fw.Write([]byte(*opt.Path))
-	if err != nil {
-		return nil, err
+	if opt.Path == nil || *opt.Path == "" {
+		return nil, fmt.Errorf("Missing required option: Path")
 	}
 
+	// Populate the additional fields.
+	fields := url.Values{}
+	fields.Set("name", *opt.Name)
+	fields.Set("path", *opt.Path)
 	if opt.ParentID != nil {
-		fw, err = w.CreateFormField("parent_id")
-		if err != nil {
-			return nil, err
-		}
-
-		_, err = fw.Write([]byte(strconv.Itoa(*opt.ParentID)))
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	if err = w.Close(); err != nil {
-		return nil, err
+		fields.Set("parent_id", strconv.Itoa(*opt.ParentID))
 	}
 
 	req, err := s.client.NewRequest(http.MethodPost, "groups/import", nil, options)
@@ -168,13 +164,9 @@ func (s *GroupImportExportService) ImportFile(opt *GroupImportFileOptions, optio
 		return nil, err
 	}
 
-	// Set the buffer as the request body.
-	if err = req.SetBody(b); err != nil {
+	if err = setMultipartBody(req, r, filename, fields); err != nil {
 		return nil, err
 	}
 
-	// Overwrite the default content type.
-	req.Header.Set("Content-Type", w.FormDataContentType())
-
 	return s.client.Do(req, nil)
 }
@@ -1,12 +1,16 @@
 package gitlab
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"net/http"
 	"os"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -90,3 +94,92 @@ func TestGroupImport(t *testing.T) {
 		t.Errorf("GroupImportExport.ImportFile returned error: %v", err)
 	}
 }
+
+func TestGroupExportDownloadStream(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/export/download",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			fmt.Fprint(w, "fake content")
+		})
+
+	var b bytes.Buffer
+	_, err := client.GroupImportExport.ExportDownloadStream(1, &b)
+	if err != nil {
+		t.Errorf("GroupImportExport.ExportDownloadStream returned error: %v", err)
+	}
+
+	if b.String() != "fake content" {
+		t.Errorf("GroupImportExport.ExportDownloadStream wrote %q, want %q", b.String(), "fake content")
+	}
+}
+
+func TestGroupImportFromReader(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/import",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodPost)
+			if err := r.ParseMultipartForm(1 << 20); err != nil {
+				t.Fatalf("Failed to parse multipart form: %v", err)
+			}
+			for field, want := range map[string]string{"name": "test", "path": "path", "parent_id": "1"} {
+				if got := r.FormValue(field); got != want {
+					t.Errorf("Form field %s is %q, want %q", field, got, want)
+				}
+			}
+
+			f, fh, err := r.FormFile("file")
+			if err != nil {
+				t.Fatalf("Failed to get form file: %v", err)
+			}
+			defer f.Close()
+			data, _ := ioutil.ReadAll(f)
+			if fh.Filename != "export.tar.gz" || string(data) != "archive" {
+				t.Errorf("Uploaded file %s with content %q", fh.Filename, data)
+			}
+
+			w.WriteHeader(http.StatusAccepted)
+			fmt.Fprint(w, `{"message": "202 Accepted"}`)
+		})
+
+	opt := &GroupImportFileOptions{
+		Name:     String("test"),
+		Path:     String("path"),
+		ParentID: Int(1),
+	}
+
+	_, err := client.GroupImportExport.ImportFromReader(opt, strings.NewReader("archive"), "export.tar.gz")
+	if err != nil {
+		t.Errorf("GroupImportExport.ImportFromReader returned error: %v", err)
+	}
+}
+
+func TestGroupImportFromReaderReadError(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/import", func(w http.ResponseWriter, r *http.Request) {
+		ioutil.ReadAll(r.Body)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	opt := &GroupImportFileOptions{
+		Name: String("test"),
+		Path: String("path"),
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.Write([]byte("arch"))
+		pw.CloseWithError(errors.New("read failed"))
+	}()
+
+	_, err := client.GroupImportExport.ImportFromReader(opt, pr, "export.tar.gz")
+	if err == nil || !strings.Contains(err.Error(), "read failed") {
+		t.Errorf("GroupImportExport.ImportFromReader returned %v, want the error of the archive reader", err)
+	}
+}
@@ -19,6 +19,7 @@ package gitlab
 import (
 	"fmt"
 	"net/http"
+	"time"
 )
 
 // GroupMembersService handles communication with the group members
@@ -164,6 +165,12 @@ type BillableGroupMember struct {
 	WebURL         string  `json:"web_url"`
 	Email          string  `json:"email"`
 	LastActivityOn ISOTime `json:"last_activity_on"`
+
+	MembershipType string     `json:"membership_type"`
+	Removable      bool       `json:"removable"`
+	CreatedAt      *time.Time `json:"created_at"`
+	IsLastOwner    bool       `json:"is_last_owner"`
+	LastLoginAt    *time.Time `json:"last_login_at"`
 }
 
 // ListBillableGroupMembersOptions represents the available ListBillableGroupMembers() options.
@@ -202,6 +209,129 @@ func (s *GroupsService) ListBillableGroupMembers(gid interface{}, opt *ListBilla
 	return bgm, resp, err
 }
 
+// BillableUserMembership represents a membership of a billable group member.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/members.html#list-memberships-for-a-billable-member-of-a-group
+type BillableUserMembership struct {
+	ID               int                           `json:"id"`
+	SourceID         int                           `json:"source_id"`
+	SourceFullName   string                        `json:"source_full_name"`
+	SourceMembersURL string                        `json:"source_members_url"`
+	CreatedAt        *time.Time                    `json:"created_at"`
+	ExpiresAt        *time.Time                    `json:"expires_at"`
+	AccessLevel      *BillableUserMembershipAccess `json:"access_level"`
+}
+
+func (m BillableUserMembership) String() string {
+	return Stringify(m)
+}
+
+// BillableUserMembershipAccess represents the access level of a membership of
+// a billable group member.
+type BillableUserMembershipAccess struct {
+	StringValue  string           `json:"string_value"`
+	IntegerValue AccessLevelValue `json:"integer_value"`
+}
+
+// ListBillableGroupMemberMembershipsOptions represents the available
+// ListBillableGroupMemberMemberships() and
+// ListBillableGroupMemberIndirectMemberships() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/members.html#list-memberships-for-a-billable-member-of-a-group
+type ListBillableGroupMemberMembershipsOptions ListOptions
+
+// ListBillableGroupMemberMemberships gets the direct memberships of a
+// billable member of a top-level group, in the group and its subgroups and
+// projects.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/members.html#list-memberships-for-a-billable-member-of-a-group
+func (s *GroupsService) ListBillableGroupMemberMemberships(gid interface{}, user int, opt *ListBillableGroupMemberMembershipsOptions, options ...RequestOptionFunc) ([]*BillableUserMembership, *Response, error) {
+	return s.listBillableGroupMemberMemberships(gid, user, "memberships", opt, options)
+}
+
+// ListBillableGroupMemberIndirectMemberships gets the memberships a billable
+// member of a top-level group has through invited groups.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/members.html#list-indirect-memberships-for-a-billable-member-of-a-group
+func (s *GroupsService) ListBillableGroupMemberIndirectMemberships(gid interface{}, user int, opt *ListBillableGroupMemberMembershipsOptions, options ...RequestOptionFunc) ([]*BillableUserMembership, *Response, error) {
+	return s.listBillableGroupMemberMemberships(gid, user, "indirect", opt, options)
+}
+
+func (s *GroupsService) listBillableGroupMemberMemberships(gid interface{}, user int, kind string, opt *ListBillableGroupMemberMembershipsOptions, options []RequestOptionFunc) ([]*BillableUserMembership, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/billable_members/%d/%s", pathEscape(group), user, kind)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var bum []*BillableUserMembership
+	resp, err := s.client.Do(req, &bum)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return bum, resp, err
+}
+
+// RemoveBillableGroupMember removes a billable member from a top-level group
+// and all its subgroups and projects, which frees their seat.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/members.html#remove-a-billable-member-from-a-group
+func (s *GroupsService) RemoveBillableGroupMember(gid interface{}, user int, options ...RequestOptionFunc) (*Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("groups/%s/billable_members/%d", pathEscape(group), user)
+
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// SetGroupMemberStateOptions represents the available SetGroupMemberState()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/members.html#change-membership-state-of-a-user-in-a-group
+type SetGroupMemberStateOptions struct {
+	State *string `url:"state,omitempty" json:"state,omitempty"`
+}
+
+// SetGroupMemberState changes the membership state of a user in a top-level
+// group and all its subgroups and projects. The state is either "awaiting",
+// which frees the seat of the user, or "active".
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/members.html#change-membership-state-of-a-user-in-a-group
+func (s *GroupsService) SetGroupMemberState(gid interface{}, user int, opt *SetGroupMemberStateOptions, options ...RequestOptionFunc) (*Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("groups/%s/members/%d/state", pathEscape(group), user)
+
+	req, err := s.client.NewRequest(http.MethodPut, u, opt, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
 // AddGroupMember adds a user to the list of group members.
 //
 // GitLab API docs:
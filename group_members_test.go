@@ -21,6 +21,7 @@ import (
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestListBillableGroupMembers(t *testing.T) {
@@ -48,3 +49,87 @@ func TestListBillableGroupMembers(t *testing.T) {
 		t.Errorf("Groups.ListBillableGroupMembers returned %+v, want %+v", billableMembers, want)
 	}
 }
+
+func TestListBillableGroupMemberMemberships(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/billable_members/2/memberships",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			testURL(t, r, "/api/v4/groups/1/billable_members/2/memberships?page=2")
+			fmt.Fprint(w, `[{"id":3,"source_id":4,"source_full_name":"Group / Project","source_members_url":"https://gitlab.example.com/group/project/-/project_members","created_at":"2021-03-31T17:28:44.812Z","expires_at":null,"access_level":{"string_value":"Developer","integer_value":30}}]`)
+		})
+
+	memberships, _, err := client.Groups.ListBillableGroupMemberMemberships(1, 2, &ListBillableGroupMemberMembershipsOptions{Page: 2})
+	if err != nil {
+		t.Fatalf("Groups.ListBillableGroupMemberMemberships returned error: %v", err)
+	}
+
+	createdAt := time.Date(2021, time.March, 31, 17, 28, 44, 812000000, time.UTC)
+	want := []*BillableUserMembership{{
+		ID:               3,
+		SourceID:         4,
+		SourceFullName:   "Group / Project",
+		SourceMembersURL: "https://gitlab.example.com/group/project/-/project_members",
+		CreatedAt:        &createdAt,
+		AccessLevel:      &BillableUserMembershipAccess{StringValue: "Developer", IntegerValue: DeveloperPermissions},
+	}}
+	if !reflect.DeepEqual(want, memberships) {
+		t.Errorf("Groups.ListBillableGroupMemberMemberships returned %+v, want %+v", memberships, want)
+	}
+}
+
+func TestListBillableGroupMemberIndirectMemberships(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/billable_members/2/indirect",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			fmt.Fprint(w, `[{"id":5,"source_id":6,"source_full_name":"Invited group"}]`)
+		})
+
+	memberships, _, err := client.Groups.ListBillableGroupMemberIndirectMemberships(1, 2, nil)
+	if err != nil {
+		t.Fatalf("Groups.ListBillableGroupMemberIndirectMemberships returned error: %v", err)
+	}
+
+	want := []*BillableUserMembership{{ID: 5, SourceID: 6, SourceFullName: "Invited group"}}
+	if !reflect.DeepEqual(want, memberships) {
+		t.Errorf("Groups.ListBillableGroupMemberIndirectMemberships returned %+v, want %+v", memberships, want)
+	}
+}
+
+func TestRemoveBillableGroupMember(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/billable_members/2",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodDelete)
+			w.WriteHeader(http.StatusNoContent)
+		})
+
+	_, err := client.Groups.RemoveBillableGroupMember(1, 2)
+	if err != nil {
+		t.Errorf("Groups.RemoveBillableGroupMember returned error: %v", err)
+	}
+}
+
+func TestSetGroupMemberState(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/members/2/state",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodPut)
+			testBody(t, r, `{"state":"awaiting"}`)
+			fmt.Fprint(w, `{"success":true}`)
+		})
+
+	_, err := client.Groups.SetGroupMemberState(1, 2, &SetGroupMemberStateOptions{State: String("awaiting")})
+	if err != nil {
+		t.Errorf("Groups.SetGroupMemberState returned error: %v", err)
+	}
+}
@@ -58,14 +58,21 @@ func (m GroupMilestone) String() string {
 // https://docs.gitlab.com/ce/api/group_milestones.html#list-group-milestones
 type ListGroupMilestonesOptions struct {
 	ListOptions
-	IIDs                    []int   `url:"iids,omitempty" json:"iids,omitempty"`
-	State                   *string `url:"state,omitempty" json:"state,omitempty"`
-	Title                   *string `url:"title,omitempty" json:"title,omitempty"`
-	Search                  *string `url:"search,omitempty" json:"search,omitempty"`
-	IncludeParentMilestones *bool   `url:"include_parent_milestones,omitempty" json:"include_parent_milestones,omitempty"`
+	IIDs                    []int      `url:"iids,omitempty" json:"iids,omitempty"`
+	State                   *string    `url:"state,omitempty" json:"state,omitempty"`
+	Title                   *string    `url:"title,omitempty" json:"title,omitempty"`
+	Search                  *string    `url:"search,omitempty" json:"search,omitempty"`
+	IncludeParentMilestones *bool      `url:"include_parent_milestones,omitempty" json:"include_parent_milestones,omitempty"`
+	IncludeAncestors        *bool      `url:"include_ancestors,omitempty" json:"include_ancestors,omitempty"`
+	IncludeDescendants      *bool      `url:"include_descendants,omitempty" json:"include_descendants,omitempty"`
+	UpdatedBefore           *time.Time `url:"updated_before,omitempty" json:"updated_before,omitempty"`
+	UpdatedAfter            *time.Time `url:"updated_after,omitempty" json:"updated_after,omitempty"`
+	ContainingDate          *ISOTime   `url:"containing_date,omitempty" json:"containing_date,omitempty"`
 }
 
-// ListGroupMilestones returns a list of group milestones.
+// ListGroupMilestones returns a list of group milestones. Set the
+// IncludeAncestors and IncludeDescendants options to roll up the milestones
+// of the whole group hierarchy.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/group_milestones.html#list-group-milestones
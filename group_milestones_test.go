@@ -0,0 +1,53 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestListGroupMilestonesHierarchy(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/5/milestones", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/groups/5/milestones?include_ancestors=true&include_descendants=true&state=active")
+		fmt.Fprint(w, `[{"id":12,"iid":3,"group_id":5,"title":"10.0"},{"id":13,"iid":1,"group_id":6,"title":"10.1"}]`)
+	})
+
+	opt := &ListGroupMilestonesOptions{
+		State:              String("active"),
+		IncludeAncestors:   Bool(true),
+		IncludeDescendants: Bool(true),
+	}
+	milestones, _, err := client.GroupMilestones.ListGroupMilestones(5, opt)
+	if err != nil {
+		t.Fatalf("GroupMilestones.ListGroupMilestones returned error: %v", err)
+	}
+
+	want := []*GroupMilestone{
+		{ID: 12, IID: 3, GroupID: 5, Title: "10.0"},
+		{ID: 13, IID: 1, GroupID: 6, Title: "10.1"},
+	}
+	if !reflect.DeepEqual(want, milestones) {
+		t.Errorf("GroupMilestones.ListGroupMilestones returned %+v, want %+v", milestones, want)
+	}
+}
@@ -0,0 +1,282 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"context"
+	"sort"
+	"sync"
+)
+
+// GroupSecurityReportOptions represents the available
+// GetGroupSecurityReport() options.
+type GroupSecurityReportOptions struct {
+	// IncludeArchived includes archived projects in the report.
+	IncludeArchived bool
+
+	// Concurrency is the number of projects retrieved concurrently. Defaults
+	// to 4.
+	Concurrency int
+}
+
+// GroupSecurityReport represents the dependencies and vulnerabilities of all
+// projects of a group and its subgroups.
+type GroupSecurityReport struct {
+	// Projects are the IDs of the projects included in the report.
+	Projects []int
+
+	// UnavailableProjects are the IDs of the projects for which the
+	// dependencies or vulnerabilities could not be retrieved because the
+	// feature is not available or not accessible.
+	UnavailableProjects []int
+
+	// Dependencies are the dependencies of all projects, deduplicated by
+	// package manager, name and version.
+	Dependencies []*GroupDependency
+
+	// Vulnerabilities are the vulnerabilities of all projects, ordered by
+	// severity.
+	Vulnerabilities []*Vulnerability
+
+	// SeverityCounts are the number of vulnerabilities by severity.
+	SeverityCounts map[string]int
+}
+
+func (r GroupSecurityReport) String() string {
+	return Stringify(r)
+}
+
+// GroupDependency represents a dependency used by one or more projects of a
+// group.
+type GroupDependency struct {
+	Name            string
+	Version         string
+	PackageManager  string
+	Licenses        []*DependencyLicense
+	Vulnerabilities []*DependencyVulnerability
+
+	// Projects are the IDs of the projects using the dependency.
+	Projects []int
+}
+
+// vulnerabilitySeverities are the vulnerability severities, most severe
+// first.
+var vulnerabilitySeverities = []string{"critical", "high", "medium", "low", "info", "unknown"}
+
+func vulnerabilitySeverityRank(severity string) int {
+	for i, s := range vulnerabilitySeverities {
+		if s == severity {
+			return i
+		}
+	}
+	return len(vulnerabilitySeverities)
+}
+
+type groupDependencyKey struct {
+	packageManager, name, version string
+}
+
+// GetGroupSecurityReport collects the dependencies and vulnerabilities of all
+// projects of a group and its subgroups into a single report. The projects are
+// retrieved concurrently; the first error stops the report. Projects for which
+// the dependency list or vulnerabilities are not available, for example
+// because of the license tier, are listed in UnavailableProjects.
+func (s *GroupsService) GetGroupSecurityReport(ctx context.Context, gid interface{}, opt *GroupSecurityReportOptions) (*GroupSecurityReport, error) {
+	o := GroupSecurityReportOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	if o.Concurrency <= 0 {
+		o.Concurrency = 4
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	r := &GroupSecurityReport{SeverityCounts: make(map[string]int)}
+
+	listOpt := &ListGroupProjectsOptions{
+		ListOptions:      ListOptions{PerPage: 100},
+		IncludeSubgroups: Bool(true),
+		Simple:           Bool(true),
+	}
+	if !o.IncludeArchived {
+		listOpt.Archived = Bool(false)
+	}
+	err := ForEachPage(func(options ...RequestOptionFunc) (*Response, error) {
+		ps, resp, err := s.ListGroupProjects(gid, listOpt, options...)
+		if err != nil {
+			return resp, err
+		}
+		for _, p := range ps {
+			r.Projects = append(r.Projects, p.ID)
+		}
+		return resp, nil
+	}, WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+
+		deps        = make(map[groupDependencyKey]*GroupDependency)
+		vulns       = make(map[int]*Vulnerability)
+		unavailable = make(map[int]bool)
+	)
+	fail := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+	}
+
+	projects := make(chan int)
+	for i := 0; i < o.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for pid := range projects {
+				ds, vs, ok, err := s.projectSecurityDetails(ctx, pid)
+				if err != nil {
+					fail(err)
+					continue
+				}
+
+				mu.Lock()
+				if !ok {
+					unavailable[pid] = true
+				}
+				for _, d := range ds {
+					key := groupDependencyKey{d.PackageManager, d.Name, d.Version}
+					gd, found := deps[key]
+					if !found {
+						gd = &GroupDependency{
+							Name:            d.Name,
+							Version:         d.Version,
+							PackageManager:  d.PackageManager,
+							Licenses:        d.Licenses,
+							Vulnerabilities: d.Vulnerabilities,
+						}
+						deps[key] = gd
+					}
+					if len(gd.Projects) == 0 || gd.Projects[len(gd.Projects)-1] != pid {
+						gd.Projects = append(gd.Projects, pid)
+					}
+				}
+				for _, v := range vs {
+					vulns[v.ID] = v
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, pid := range r.Projects {
+		select {
+		case projects <- pid:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	close(projects)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, pid := range r.Projects {
+		if unavailable[pid] {
+			r.UnavailableProjects = append(r.UnavailableProjects, pid)
+		}
+	}
+
+	for _, d := range deps {
+		sort.Ints(d.Projects)
+		r.Dependencies = append(r.Dependencies, d)
+	}
+	sort.Slice(r.Dependencies, func(i, j int) bool {
+		a, b := r.Dependencies[i], r.Dependencies[j]
+		if a.PackageManager != b.PackageManager {
+			return a.PackageManager < b.PackageManager
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Version < b.Version
+	})
+
+	for _, v := range vulns {
+		r.Vulnerabilities = append(r.Vulnerabilities, v)
+		r.SeverityCounts[v.Severity]++
+	}
+	sort.Slice(r.Vulnerabilities, func(i, j int) bool {
+		a, b := r.Vulnerabilities[i], r.Vulnerabilities[j]
+		if ra, rb := vulnerabilitySeverityRank(a.Severity), vulnerabilitySeverityRank(b.Severity); ra != rb {
+			return ra < rb
+		}
+		return a.ID < b.ID
+	})
+
+	return r, nil
+}
+
+// projectSecurityDetails retrieves all dependencies and vulnerabilities of a
+// project. It reports false when either of them is not available.
+func (s *GroupsService) projectSecurityDetails(ctx context.Context, pid int) ([]*Dependency, []*Vulnerability, bool, error) {
+	available := true
+
+	var ds []*Dependency
+	depOpt := &ListProjectDependenciesOptions{ListOptions: ListOptions{PerPage: 100}}
+	err := ForEachPage(func(options ...RequestOptionFunc) (*Response, error) {
+		page, resp, err := s.client.Dependencies.ListProjectDependencies(pid, depOpt, options...)
+		ds = append(ds, page...)
+		return resp, err
+	}, WithContext(ctx))
+	if err != nil {
+		if !IsForbidden(err) && !IsNotFound(err) {
+			return nil, nil, false, err
+		}
+		available = false
+	}
+
+	var vs []*Vulnerability
+	vulnOpt := &ListProjectVulnerabilitiesOptions{PerPage: 100}
+	err = ForEachPage(func(options ...RequestOptionFunc) (*Response, error) {
+		page, resp, err := s.client.Vulnerabilities.ListProjectVulnerabilities(pid, vulnOpt, options...)
+		vs = append(vs, page...)
+		return resp, err
+	}, WithContext(ctx))
+	if err != nil {
+		if !IsForbidden(err) && !IsNotFound(err) {
+			return nil, nil, false, err
+		}
+		available = false
+	}
+
+	return ds, vs, available, nil
+}
@@ -0,0 +1,108 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetGroupSecurityReport(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/g/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/groups/g/projects?archived=false&include_subgroups=true&per_page=100&simple=true")
+		fmt.Fprint(w, `[{"id":1},{"id":2},{"id":3}]`)
+	})
+
+	deps := map[int]string{
+		1: `[{"name":"rails","version":"5.0.1","package_manager":"bundler"},{"name":"lodash","version":"4.17.21","package_manager":"yarn"}]`,
+		2: `[{"name":"rails","version":"5.0.1","package_manager":"bundler"},{"name":"rails","version":"6.1.0","package_manager":"bundler"}]`,
+	}
+	vulns := map[int]string{
+		1: `[{"id":10,"severity":"low"},{"id":11,"severity":"critical"}]`,
+		2: `[{"id":12,"severity":"high"}]`,
+	}
+	for pid := 1; pid <= 3; pid++ {
+		pid := pid
+		mux.HandleFunc(fmt.Sprintf("/api/v4/projects/%d/dependencies", pid), func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			if pid == 3 {
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprint(w, `{"message":"403 Forbidden"}`)
+				return
+			}
+			fmt.Fprint(w, deps[pid])
+		})
+		mux.HandleFunc(fmt.Sprintf("/api/v4/projects/%d/vulnerabilities", pid), func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			if pid == 3 {
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprint(w, `{"message":"403 Forbidden"}`)
+				return
+			}
+			fmt.Fprint(w, vulns[pid])
+		})
+	}
+
+	r, err := client.Groups.GetGroupSecurityReport(context.Background(), "g", &GroupSecurityReportOptions{Concurrency: 2})
+	require.NoError(t, err)
+
+	assert.Equal(t, []int{1, 2, 3}, r.Projects)
+	assert.Equal(t, []int{3}, r.UnavailableProjects)
+
+	require.Len(t, r.Dependencies, 3)
+	assert.Equal(t, "rails", r.Dependencies[0].Name)
+	assert.Equal(t, "5.0.1", r.Dependencies[0].Version)
+	assert.Equal(t, []int{1, 2}, r.Dependencies[0].Projects)
+	assert.Equal(t, "6.1.0", r.Dependencies[1].Version)
+	assert.Equal(t, []int{2}, r.Dependencies[1].Projects)
+	assert.Equal(t, "lodash", r.Dependencies[2].Name)
+
+	var ids []int
+	for _, v := range r.Vulnerabilities {
+		ids = append(ids, v.ID)
+	}
+	assert.Equal(t, []int{11, 12, 10}, ids)
+	assert.Equal(t, map[string]int{"critical": 1, "high": 1, "low": 1}, r.SeverityCounts)
+}
+
+func TestGetGroupSecurityReportError(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/projects", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":1}]`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/dependencies", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"message":"bad request"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/vulnerabilities", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[]`)
+	})
+
+	_, err := client.Groups.GetGroupSecurityReport(context.Background(), 1, nil)
+	assert.True(t, HasStatusCode(err, http.StatusBadRequest), "unexpected error: %v", err)
+}
@@ -67,13 +67,16 @@ type Group struct {
 		GroupAccessLevel int      `json:"group_access_level"`
 		ExpiresAt        *ISOTime `json:"expires_at"`
 	} `json:"shared_with_groups"`
-	LDAPCN                         string           `json:"ldap_cn"`
-	LDAPAccess                     AccessLevelValue `json:"ldap_access"`
-	LDAPGroupLinks                 []*LDAPGroupLink `json:"ldap_group_links"`
-	SharedRunnersMinutesLimit      int              `json:"shared_runners_minutes_limit"`
-	ExtraSharedRunnersMinutesLimit int              `json:"extra_shared_runners_minutes_limit"`
-	MarkedForDeletionOn            *ISOTime         `json:"marked_for_deletion_on"`
-	CreatedAt                      *time.Time       `json:"created_at"`
+	LDAPCN                         string                    `json:"ldap_cn"`
+	LDAPAccess                     AccessLevelValue          `json:"ldap_access"`
+	LDAPGroupLinks                 []*LDAPGroupLink          `json:"ldap_group_links"`
+	SharedRunnersMinutesLimit      int                       `json:"shared_runners_minutes_limit"`
+	ExtraSharedRunnersMinutesLimit int                       `json:"extra_shared_runners_minutes_limit"`
+	MarkedForDeletionOn            *ISOTime                  `json:"marked_for_deletion_on"`
+	CreatedAt                      *time.Time                `json:"created_at"`
+	SharedRunnersSetting           SharedRunnersSettingValue `json:"shared_runners_setting"`
+	DuoFeaturesEnabled             bool                      `json:"duo_features_enabled"`
+	LockDuoFeaturesEnabled         bool                      `json:"lock_duo_features_enabled"`
 }
 
 // LDAPGroupLink represents a GitLab LDAP group link.
@@ -86,21 +89,44 @@ type LDAPGroupLink struct {
 	Provider    string           `json:"provider"`
 }
 
+// GroupOrderByValue represents a column groups can be ordered by.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/groups.html#list-groups
+type GroupOrderByValue string
+
+// These constants represent all valid columns to order groups by.
+const (
+	GroupOrderByID         GroupOrderByValue = "id"
+	GroupOrderByName       GroupOrderByValue = "name"
+	GroupOrderByPath       GroupOrderByValue = "path"
+	GroupOrderBySimilarity GroupOrderByValue = "similarity"
+)
+
+// GroupOrderBy is a helper routine that allocates a new GroupOrderByValue
+// to store v and returns a pointer to it.
+func GroupOrderBy(v GroupOrderByValue) *GroupOrderByValue {
+	p := new(GroupOrderByValue)
+	*p = v
+	return p
+}
+
 // ListGroupsOptions represents the available ListGroups() options.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/groups.html#list-project-groups
 type ListGroupsOptions struct {
 	ListOptions
-	AllAvailable         *bool             `url:"all_available,omitempty" json:"all_available,omitempty"`
-	MinAccessLevel       *AccessLevelValue `url:"min_access_level,omitempty" json:"min_access_level,omitempty"`
-	OrderBy              *string           `url:"order_by,omitempty" json:"order_by,omitempty"`
-	Owned                *bool             `url:"owned,omitempty" json:"owned,omitempty"`
-	Search               *string           `url:"search,omitempty" json:"search,omitempty"`
-	SkipGroups           []int             `url:"skip_groups,omitempty" json:"skip_groups,omitempty"`
-	Sort                 *string           `url:"sort,omitempty" json:"sort,omitempty"`
-	Statistics           *bool             `url:"statistics,omitempty" json:"statistics,omitempty"`
-	TopLevelOnly         *bool             `url:"top_level_only,omitempty" json:"top_level_only,omitempty"`
-	WithCustomAttributes *bool             `url:"with_custom_attributes,omitempty" json:"with_custom_attributes,omitempty"`
+	AllAvailable         *bool              `url:"all_available,omitempty" json:"all_available,omitempty"`
+	MinAccessLevel       *AccessLevelValue  `url:"min_access_level,omitempty" json:"min_access_level,omitempty"`
+	OrderBy              *GroupOrderByValue `url:"order_by,omitempty" json:"order_by,omitempty"`
+	Owned                *bool              `url:"owned,omitempty" json:"owned,omitempty"`
+	Search               *string            `url:"search,omitempty" json:"search,omitempty"`
+	SkipGroups           []int              `url:"skip_groups,omitempty" json:"skip_groups,omitempty"`
+	Sort                 *SortValue         `url:"sort,omitempty" json:"sort,omitempty"`
+	Statistics           *bool              `url:"statistics,omitempty" json:"statistics,omitempty"`
+	TopLevelOnly         *bool              `url:"top_level_only,omitempty" json:"top_level_only,omitempty"`
+	WithCustomAttributes *bool              `url:"with_custom_attributes,omitempty" json:"with_custom_attributes,omitempty"`
+	MarkedForDeletionOn  *ISOTime           `url:"marked_for_deletion_on,omitempty" json:"marked_for_deletion_on,omitempty"`
 }
 
 // ListGroups gets a list of groups (as user: my groups, as admin: all groups).
@@ -122,6 +148,46 @@ func (s *GroupsService) ListGroups(opt *ListGroupsOptions, options ...RequestOpt
 	return g, resp, err
 }
 
+// ListGroupsPendingDeletion walks all groups accessible by the authenticated
+// user and returns the groups that are marked for deletion, but not yet
+// deleted. The ListGroups() options can be used to further filter the groups.
+//
+// Unless a MarkedForDeletionOn date is given, GitLab does not support
+// filtering on groups marked for deletion, so this filters the groups
+// client-side. As a result all pages are fetched and no pagination
+// information is returned.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/groups.html#list-project-groups
+func (s *GroupsService) ListGroupsPendingDeletion(opt *ListGroupsOptions, options ...RequestOptionFunc) ([]*Group, error) {
+	o := ListGroupsOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	if o.PerPage == 0 {
+		o.PerPage = 100
+	}
+
+	var pending []*Group
+	for {
+		gs, resp, err := s.ListGroups(&o, options...)
+		if err != nil {
+			return nil, err
+		}
+		for _, g := range gs {
+			if g.MarkedForDeletionOn != nil {
+				pending = append(pending, g)
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		o.Page = resp.NextPage
+	}
+
+	return pending, nil
+}
+
 // GetGroup gets all details of a group.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/groups.html#details-of-a-group
@@ -168,6 +234,8 @@ type CreateGroupOptions struct {
 	ParentID                       *int                        `url:"parent_id,omitempty" json:"parent_id,omitempty"`
 	SharedRunnersMinutesLimit      *int                        `url:"shared_runners_minutes_limit,omitempty" json:"shared_runners_minutes_limit,omitempty"`
 	ExtraSharedRunnersMinutesLimit *int                        `url:"extra_shared_runners_minutes_limit,omitempty" json:"extra_shared_runners_minutes_limit,omitempty"`
+	DuoFeaturesEnabled             *bool                       `url:"duo_features_enabled,omitempty" json:"duo_features_enabled,omitempty"`
+	LockDuoFeaturesEnabled         *bool                       `url:"lock_duo_features_enabled,omitempty" json:"lock_duo_features_enabled,omitempty"`
 }
 
 // CreateGroup creates a new project group. Available only for users who can
@@ -219,11 +287,34 @@ func (s *GroupsService) TransferGroup(gid interface{}, pid interface{}, options
 	return g, resp, err
 }
 
-// UpdateGroupOptions represents the set of available options to update a Group;
-// as of today these are exactly the same available when creating a new Group.
+// UpdateGroupOptions represents the set of available options to update a
+// Group. These are the options available when creating a new Group, plus the
+// settings that can only be changed on an existing Group.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/groups.html#update-group
-type UpdateGroupOptions CreateGroupOptions
+type UpdateGroupOptions struct {
+	Name                           *string                     `url:"name,omitempty" json:"name,omitempty"`
+	Path                           *string                     `url:"path,omitempty" json:"path,omitempty"`
+	Description                    *string                     `url:"description,omitempty" json:"description,omitempty"`
+	MembershipLock                 *bool                       `url:"membership_lock,omitempty" json:"membership_lock,omitempty"`
+	Visibility                     *VisibilityValue            `url:"visibility,omitempty" json:"visibility,omitempty"`
+	ShareWithGroupLock             *bool                       `url:"share_with_group_lock,omitempty" json:"share_with_group_lock,omitempty"`
+	RequireTwoFactorAuth           *bool                       `url:"require_two_factor_authentication,omitempty" json:"require_two_factor_authentication,omitempty"`
+	TwoFactorGracePeriod           *int                        `url:"two_factor_grace_period,omitempty" json:"two_factor_grace_period,omitempty"`
+	ProjectCreationLevel           *ProjectCreationLevelValue  `url:"project_creation_level,omitempty" json:"project_creation_level,omitempty"`
+	AutoDevopsEnabled              *bool                       `url:"auto_devops_enabled,omitempty" json:"auto_devops_enabled,omitempty"`
+	SubGroupCreationLevel          *SubGroupCreationLevelValue `url:"subgroup_creation_level,omitempty" json:"subgroup_creation_level,omitempty"`
+	EmailsDisabled                 *bool                       `url:"emails_disabled,omitempty" json:"emails_disabled,omitempty"`
+	MentionsDisabled               *bool                       `url:"mentions_disabled,omitempty" json:"mentions_disabled,omitempty"`
+	LFSEnabled                     *bool                       `url:"lfs_enabled,omitempty" json:"lfs_enabled,omitempty"`
+	RequestAccessEnabled           *bool                       `url:"request_access_enabled,omitempty" json:"request_access_enabled,omitempty"`
+	ParentID                       *int                        `url:"parent_id,omitempty" json:"parent_id,omitempty"`
+	SharedRunnersMinutesLimit      *int                        `url:"shared_runners_minutes_limit,omitempty" json:"shared_runners_minutes_limit,omitempty"`
+	ExtraSharedRunnersMinutesLimit *int                        `url:"extra_shared_runners_minutes_limit,omitempty" json:"extra_shared_runners_minutes_limit,omitempty"`
+	SharedRunnersSetting           *SharedRunnersSettingValue  `url:"shared_runners_setting,omitempty" json:"shared_runners_setting,omitempty"`
+	DuoFeaturesEnabled             *bool                       `url:"duo_features_enabled,omitempty" json:"duo_features_enabled,omitempty"`
+	LockDuoFeaturesEnabled         *bool                       `url:"lock_duo_features_enabled,omitempty" json:"lock_duo_features_enabled,omitempty"`
+}
 
 // UpdateGroup updates an existing group; only available to group owners and
 // administrators.
@@ -250,17 +341,30 @@ func (s *GroupsService) UpdateGroup(gid interface{}, opt *UpdateGroupOptions, op
 	return g, resp, err
 }
 
+// DeleteGroupOptions represents the available DeleteGroup() options.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/groups.html#remove-group
+type DeleteGroupOptions struct {
+	FullPath          *string `url:"full_path,omitempty" json:"full_path,omitempty"`
+	PermanentlyRemove *bool   `url:"permanently_remove,omitempty" json:"permanently_remove,omitempty"`
+}
+
 // DeleteGroup removes group with all projects inside.
 //
-// GitLab API docs: https://docs.gitlab.com/ce/api/groups.html#remove-group
-func (s *GroupsService) DeleteGroup(gid interface{}, options ...RequestOptionFunc) (*Response, error) {
+// When delayed deletion is enabled, the group is only marked for deletion and
+// removed after the configured period. A group that is already marked for
+// deletion can be removed immediately by setting PermanentlyRemove, together
+// with FullPath as a confirmation of which group is removed.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/groups.html#remove-group
+func (s *GroupsService) DeleteGroup(gid interface{}, opt *DeleteGroupOptions, options ...RequestOptionFunc) (*Response, error) {
 	group, err := parseID(gid)
 	if err != nil {
 		return nil, err
 	}
 	u := fmt.Sprintf("groups/%s", pathEscape(group))
 
-	req, err := s.client.NewRequest(http.MethodDelete, u, nil, options)
+	req, err := s.client.NewRequest(http.MethodDelete, u, opt, options)
 	if err != nil {
 		return nil, err
 	}
@@ -322,21 +426,23 @@ func (s *GroupsService) SearchGroup(query string, options ...RequestOptionFunc)
 // https://docs.gitlab.com/ce/api/groups.html#list-a-group-39-s-projects
 type ListGroupProjectsOptions struct {
 	ListOptions
-	Archived                 *bool             `url:"archived,omitempty" json:"archived,omitempty"`
-	Visibility               *VisibilityValue  `url:"visibility,omitempty" json:"visibility,omitempty"`
-	OrderBy                  *string           `url:"order_by,omitempty" json:"order_by,omitempty"`
-	Sort                     *string           `url:"sort,omitempty" json:"sort,omitempty"`
-	Search                   *string           `url:"search,omitempty" json:"search,omitempty"`
-	Simple                   *bool             `url:"simple,omitempty" json:"simple,omitempty"`
-	Owned                    *bool             `url:"owned,omitempty" json:"owned,omitempty"`
-	Starred                  *bool             `url:"starred,omitempty" json:"starred,omitempty"`
-	WithIssuesEnabled        *bool             `url:"with_issues_enabled,omitempty" json:"with_issues_enabled,omitempty"`
-	WithMergeRequestsEnabled *bool             `url:"with_merge_requests_enabled,omitempty" json:"with_merge_requests_enabled,omitempty"`
-	WithShared               *bool             `url:"with_shared,omitempty" json:"with_shared,omitempty"`
-	IncludeSubgroups         *bool             `url:"include_subgroups,omitempty" json:"include_subgroups,omitempty"`
-	MinAccessLevel           *AccessLevelValue `url:"min_access_level,omitempty" json:"min_access_level,omitempty"`
-	WithCustomAttributes     *bool             `url:"with_custom_attributes,omitempty" json:"with_custom_attributes,omitempty"`
-	WithSecurityReports      *bool             `url:"with_security_reports,omitempty" json:"with_security_reports,omitempty"`
+	Archived                 *bool                `url:"archived,omitempty" json:"archived,omitempty"`
+	Visibility               *VisibilityValue     `url:"visibility,omitempty" json:"visibility,omitempty"`
+	OrderBy                  *ProjectOrderByValue `url:"order_by,omitempty" json:"order_by,omitempty"`
+	Sort                     *SortValue           `url:"sort,omitempty" json:"sort,omitempty"`
+	Search                   *string              `url:"search,omitempty" json:"search,omitempty"`
+	Simple                   *bool                `url:"simple,omitempty" json:"simple,omitempty"`
+	Owned                    *bool                `url:"owned,omitempty" json:"owned,omitempty"`
+	Starred                  *bool                `url:"starred,omitempty" json:"starred,omitempty"`
+	WithIssuesEnabled        *bool                `url:"with_issues_enabled,omitempty" json:"with_issues_enabled,omitempty"`
+	WithMergeRequestsEnabled *bool                `url:"with_merge_requests_enabled,omitempty" json:"with_merge_requests_enabled,omitempty"`
+	WithShared               *bool                `url:"with_shared,omitempty" json:"with_shared,omitempty"`
+	IncludeSubgroups         *bool                `url:"include_subgroups,omitempty" json:"include_subgroups,omitempty"`
+	MinAccessLevel           *AccessLevelValue    `url:"min_access_level,omitempty" json:"min_access_level,omitempty"`
+	WithCustomAttributes     *bool                `url:"with_custom_attributes,omitempty" json:"with_custom_attributes,omitempty"`
+	WithSecurityReports      *bool                `url:"with_security_reports,omitempty" json:"with_security_reports,omitempty"`
+	Active                   *bool                `url:"active,omitempty" json:"active,omitempty"`
+	MarkedForDeletionOn      *ISOTime             `url:"marked_for_deletion_on,omitempty" json:"marked_for_deletion_on,omitempty"`
 }
 
 // ListGroupProjects get a list of group projects
@@ -364,6 +470,45 @@ func (s *GroupsService) ListGroupProjects(gid interface{}, opt *ListGroupProject
 	return p, resp, err
 }
 
+// ListGroupProjectsPendingDeletion walks all projects of a group and returns
+// the projects that are marked for deletion, but not yet deleted. The
+// ListGroupProjects() options can be used to further filter the projects,
+// for example IncludeSubgroups to include the projects of all subgroups.
+//
+// Like ListProjectsPendingDeletion, this filters the inactive projects
+// client-side, so all pages are fetched and no pagination information is
+// returned.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/groups.html#list-a-group-39-s-projects
+func (s *GroupsService) ListGroupProjectsPendingDeletion(gid interface{}, opt *ListGroupProjectsOptions, options ...RequestOptionFunc) ([]*Project, error) {
+	o := ListGroupProjectsOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	if o.MarkedForDeletionOn == nil {
+		o.Active = Bool(false)
+	}
+	if o.PerPage == 0 {
+		o.PerPage = 100
+	}
+
+	var pending []*Project
+	for {
+		ps, resp, err := s.ListGroupProjects(gid, &o, options...)
+		if err != nil {
+			return nil, err
+		}
+		pending = appendPendingDeletion(pending, ps)
+		if resp.NextPage == 0 {
+			break
+		}
+		o.Page = resp.NextPage
+	}
+
+	return pending, nil
+}
+
 // ListSubgroupsOptions represents the available ListSubgroups() options.
 //
 // GitLab API docs:
@@ -565,6 +710,126 @@ func (s *GroupsService) DeleteGroupLDAPLinkForProvider(gid interface{}, provider
 	return s.client.Do(req, nil)
 }
 
+// SAMLGroupLink represents a GitLab SAML group link.
+//
+// The SAML SSO settings of a group, such as enforcing SSO for web and Git
+// activity, and the group's verified domains are not exposed by the API and
+// can only be changed in the group settings.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/groups.html#saml-group-links
+type SAMLGroupLink struct {
+	Name        string           `json:"name"`
+	AccessLevel AccessLevelValue `json:"access_level"`
+}
+
+// ListGroupSAMLLinks lists the group's SAML links. Available only for users
+// who can edit groups.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/groups.html#list-saml-group-links
+func (s *GroupsService) ListGroupSAMLLinks(gid interface{}, options ...RequestOptionFunc) ([]*SAMLGroupLink, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/saml_group_links", pathEscape(group))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var gl []*SAMLGroupLink
+	resp, err := s.client.Do(req, &gl)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return gl, resp, err
+}
+
+// GetGroupSAMLLink gets a single SAML link of a group. Available only for
+// users who can edit groups.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/groups.html#get-saml-group-link
+func (s *GroupsService) GetGroupSAMLLink(gid interface{}, samlGroupName string, options ...RequestOptionFunc) (*SAMLGroupLink, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/saml_group_links/%s", pathEscape(group), pathEscape(samlGroupName))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gl := new(SAMLGroupLink)
+	resp, err := s.client.Do(req, gl)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return gl, resp, err
+}
+
+// AddGroupSAMLLinkOptions represents the available AddGroupSAMLLink() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/groups.html#add-saml-group-link
+type AddGroupSAMLLinkOptions struct {
+	SAMLGroupName *string           `url:"saml_group_name,omitempty" json:"saml_group_name,omitempty"`
+	AccessLevel   *AccessLevelValue `url:"access_level,omitempty" json:"access_level,omitempty"`
+}
+
+// AddGroupSAMLLink creates a new group SAML link. Available only for users who
+// can edit groups.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/groups.html#add-saml-group-link
+func (s *GroupsService) AddGroupSAMLLink(gid interface{}, opt *AddGroupSAMLLinkOptions, options ...RequestOptionFunc) (*SAMLGroupLink, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/saml_group_links", pathEscape(group))
+
+	req, err := s.client.NewRequest(http.MethodPost, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gl := new(SAMLGroupLink)
+	resp, err := s.client.Do(req, gl)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return gl, resp, err
+}
+
+// DeleteGroupSAMLLink deletes a group SAML link. Available only for users who
+// can edit groups.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/groups.html#delete-saml-group-link
+func (s *GroupsService) DeleteGroupSAMLLink(gid interface{}, samlGroupName string, options ...RequestOptionFunc) (*Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("groups/%s/saml_group_links/%s", pathEscape(group), pathEscape(samlGroupName))
+
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
 // ShareGroupWithGroupOptions represents the available ShareGroupWithGroup() options.
 //
 // GitLab API docs:
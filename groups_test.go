@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestListGroups(t *testing.T) {
@@ -106,7 +107,7 @@ func TestDeleteGroup(t *testing.T) {
 			w.WriteHeader(http.StatusAccepted)
 		})
 
-	resp, err := client.Groups.DeleteGroup(1)
+	resp, err := client.Groups.DeleteGroup(1, nil)
 	if err != nil {
 		t.Errorf("Groups.DeleteGroup returned error: %v", err)
 	}
@@ -160,6 +161,33 @@ func TestUpdateGroup(t *testing.T) {
 	}
 }
 
+func TestUpdateGroupSharedRunnersSetting(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodPut)
+			testBody(t, r, `{"auto_devops_enabled":false,"shared_runners_setting":"disabled_with_override"}`)
+			fmt.Fprint(w, `{"id": 1, "shared_runners_setting": "disabled_with_override"}`)
+		})
+
+	opt := &UpdateGroupOptions{
+		AutoDevopsEnabled:    Bool(false),
+		SharedRunnersSetting: SharedRunnersSetting(DisabledWithOverrideSharedRunnersSettingValue),
+	}
+
+	group, _, err := client.Groups.UpdateGroup(1, opt)
+	if err != nil {
+		t.Errorf("Groups.UpdateGroup returned error: %v", err)
+	}
+
+	want := &Group{ID: 1, SharedRunnersSetting: DisabledWithOverrideSharedRunnersSettingValue}
+	if !reflect.DeepEqual(want, group) {
+		t.Errorf("Groups.UpdatedGroup returned %+v, want %+v", group, want)
+	}
+}
+
 func TestListGroupProjects(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
@@ -318,6 +346,27 @@ func TestAddGroupLDAPLinkFilter(t *testing.T) {
 	}
 }
 
+func TestDeleteGroupPermanently(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testURL(t, r, "/api/v4/groups/1?full_path=group%2Fgroup&permanently_remove=true")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	opt := &DeleteGroupOptions{
+		FullPath:          String("group/group"),
+		PermanentlyRemove: Bool(true),
+	}
+
+	_, err := client.Groups.DeleteGroup(1, opt)
+	if err != nil {
+		t.Errorf("Groups.DeleteGroup returned error: %v", err)
+	}
+}
+
 func TestRestoreGroup(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
@@ -376,3 +425,161 @@ func TestUnshareGroupFromGroup(t *testing.T) {
 		t.Errorf("Groups.UnshareGroupFromGroup returned status code %d", r.StatusCode)
 	}
 }
+
+func TestListGroupSAMLLinks(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+	mux.HandleFunc("/api/v4/groups/1/saml_group_links",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			fmt.Fprint(w, `[{"name":"saml-group-1","access_level":10},{"name":"saml-group-2","access_level":40}]`)
+		})
+
+	links, _, err := client.Groups.ListGroupSAMLLinks(1)
+	if err != nil {
+		t.Errorf("Groups.ListGroupSAMLLinks returned error: %v", err)
+	}
+
+	want := []*SAMLGroupLink{
+		{Name: "saml-group-1", AccessLevel: GuestPermissions},
+		{Name: "saml-group-2", AccessLevel: MaintainerPermissions},
+	}
+	if !reflect.DeepEqual(want, links) {
+		t.Errorf("Groups.ListGroupSAMLLinks returned %+v, want %+v", links, want)
+	}
+}
+
+func TestAddGroupSAMLLink(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+	mux.HandleFunc("/api/v4/groups/1/saml_group_links",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodPost)
+			testBody(t, r, `{"saml_group_name":"saml-group-1","access_level":30}`)
+			fmt.Fprint(w, `{"name":"saml-group-1","access_level":30}`)
+		})
+
+	link, _, err := client.Groups.AddGroupSAMLLink(1, &AddGroupSAMLLinkOptions{
+		SAMLGroupName: String("saml-group-1"),
+		AccessLevel:   AccessLevel(DeveloperPermissions),
+	})
+	if err != nil {
+		t.Errorf("Groups.AddGroupSAMLLink returned error: %v", err)
+	}
+
+	want := &SAMLGroupLink{Name: "saml-group-1", AccessLevel: DeveloperPermissions}
+	if !reflect.DeepEqual(want, link) {
+		t.Errorf("Groups.AddGroupSAMLLink returned %+v, want %+v", link, want)
+	}
+}
+
+func TestGetAndDeleteGroupSAMLLink(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+	mux.HandleFunc("/api/v4/groups/1/saml_group_links/saml-group-1",
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				fmt.Fprint(w, `{"name":"saml-group-1","access_level":30}`)
+			case http.MethodDelete:
+				w.WriteHeader(204)
+			default:
+				t.Errorf("unexpected request method %s", r.Method)
+			}
+		})
+
+	link, _, err := client.Groups.GetGroupSAMLLink(1, "saml-group-1")
+	if err != nil {
+		t.Errorf("Groups.GetGroupSAMLLink returned error: %v", err)
+	}
+
+	want := &SAMLGroupLink{Name: "saml-group-1", AccessLevel: DeveloperPermissions}
+	if !reflect.DeepEqual(want, link) {
+		t.Errorf("Groups.GetGroupSAMLLink returned %+v, want %+v", link, want)
+	}
+
+	r, err := client.Groups.DeleteGroupSAMLLink(1, "saml-group-1")
+	if err != nil {
+		t.Errorf("Groups.DeleteGroupSAMLLink returned error: %v", err)
+	}
+	if r.StatusCode != 204 {
+		t.Errorf("Groups.DeleteGroupSAMLLink returned status code %d", r.StatusCode)
+	}
+}
+
+func TestListGroupsMarkedForDeletion(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+	mux.HandleFunc("/api/v4/groups",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			testURL(t, r, "/api/v4/groups?marked_for_deletion_on=2021-01-02")
+			fmt.Fprint(w, `[{"id":1,"marked_for_deletion_on":"2021-01-02"}]`)
+		})
+
+	markedOn := ISOTime(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC))
+	groups, _, err := client.Groups.ListGroups(&ListGroupsOptions{MarkedForDeletionOn: &markedOn})
+	if err != nil {
+		t.Errorf("Groups.ListGroups returned error: %v", err)
+	}
+
+	want := []*Group{{ID: 1, MarkedForDeletionOn: &markedOn}}
+	if !reflect.DeepEqual(want, groups) {
+		t.Errorf("Groups.ListGroups returned %+v, want %+v", groups, want)
+	}
+}
+
+func TestListGroupsPendingDeletion(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.Query().Get("page") {
+		case "":
+			testURL(t, r, "/api/v4/groups?all_available=true&per_page=100")
+			w.Header().Set("X-Next-Page", "2")
+			fmt.Fprint(w, `[{"id":1},{"id":2,"marked_for_deletion_on":"2021-01-02"}]`)
+		case "2":
+			testURL(t, r, "/api/v4/groups?all_available=true&page=2&per_page=100")
+			fmt.Fprint(w, `[{"id":3}]`)
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+
+	opt := &ListGroupsOptions{AllAvailable: Bool(true)}
+	groups, err := client.Groups.ListGroupsPendingDeletion(opt)
+	if err != nil {
+		t.Fatalf("Groups.ListGroupsPendingDeletion returned error: %v", err)
+	}
+
+	markedOn := ISOTime(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC))
+	want := []*Group{{ID: 2, MarkedForDeletionOn: &markedOn}}
+	if !reflect.DeepEqual(want, groups) {
+		t.Errorf("Groups.ListGroupsPendingDeletion returned %+v, want %+v", groups, want)
+	}
+}
+
+func TestListGroupProjectsPendingDeletion(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/groups/1/projects?active=false&include_subgroups=true&per_page=100")
+		fmt.Fprint(w, `[{"id":1,"archived":true},{"id":2,"marked_for_deletion_on":"2021-01-02"}]`)
+	})
+
+	opt := &ListGroupProjectsOptions{IncludeSubgroups: Bool(true)}
+	projects, err := client.Groups.ListGroupProjectsPendingDeletion(1, opt)
+	if err != nil {
+		t.Fatalf("Groups.ListGroupProjectsPendingDeletion returned error: %v", err)
+	}
+
+	markedOn := ISOTime(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC))
+	want := []*Project{{ID: 2, MarkedForDeletionOn: &markedOn}}
+	if !reflect.DeepEqual(want, projects) {
+		t.Errorf("Groups.ListGroupProjectsPendingDeletion returned %+v, want %+v", projects, want)
+	}
+}
@@ -0,0 +1,133 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"context"
+	"time"
+)
+
+// APICall describes a single call of the GitLab API, as passed to a
+// CallTracer or CallMetrics.
+type APICall struct {
+	// Method is the HTTP method of the call.
+	Method string
+
+	// Endpoint is the endpoint label of the call, like
+	// "GET projects/:id/issues", see EndpointFromContext.
+	Endpoint string
+
+	// Start is the time the call started, including waiting for the rate
+	// limiter.
+	Start time.Time
+}
+
+// APICallResult describes the result of an API call.
+type APICallResult struct {
+	// StatusCode is the HTTP status code of the response, or 0 when no
+	// response was received.
+	StatusCode int
+
+	// RequestID is the ID GitLab assigned to the request.
+	RequestID string
+
+	// RateLimitRemaining is the number of requests left in the current rate
+	// limit period, or -1 when GitLab didn't return rate limit headers.
+	RateLimitRemaining int
+
+	// Duration is the duration of the call, including retries.
+	Duration time.Duration
+
+	// Err is the error returned by the call, if any.
+	Err error
+}
+
+// CallTracer traces API calls. It can be used to integrate tracing systems
+// like OpenTelemetry without the client depending on them. For example, an
+// OpenTelemetry tracer can be adapted like this:
+//
+//	type otelTracer struct{ tracer trace.Tracer }
+//
+//	func (t otelTracer) StartCall(ctx context.Context, call *gitlab.APICall) (context.Context, gitlab.CallSpan) {
+//		ctx, span := t.tracer.Start(ctx, call.Endpoint, trace.WithSpanKind(trace.SpanKindClient),
+//			trace.WithAttributes(attribute.String("http.request.method", call.Method)))
+//		return ctx, otelSpan{span}
+//	}
+//
+//	type otelSpan struct{ span trace.Span }
+//
+//	func (s otelSpan) End(r *gitlab.APICallResult) {
+//		s.span.SetAttributes(attribute.Int("http.response.status_code", r.StatusCode))
+//		if r.Err != nil {
+//			s.span.RecordError(r.Err)
+//			s.span.SetStatus(codes.Error, r.Err.Error())
+//		}
+//		s.span.End()
+//	}
+type CallTracer interface {
+	// StartCall is called when a call starts. The returned context is used
+	// for the request, so a tracing HTTP transport can create child spans.
+	StartCall(ctx context.Context, call *APICall) (context.Context, CallSpan)
+}
+
+// CallSpan represents a traced API call.
+type CallSpan interface {
+	// End is called once the call finished.
+	End(result *APICallResult)
+}
+
+// CallMetrics records metrics of API calls, like the number of calls and
+// their duration by endpoint and status code.
+type CallMetrics interface {
+	RecordCall(call *APICall, result *APICallResult)
+}
+
+// WithCallTracer sets the tracer used to trace every API call.
+func WithCallTracer(tracer CallTracer) ClientOptionFunc {
+	return func(c *Client) error {
+		c.tracer = tracer
+		return nil
+	}
+}
+
+// WithCallMetrics sets the recorder used to record metrics of every API
+// call.
+func WithCallMetrics(metrics CallMetrics) ClientOptionFunc {
+	return func(c *Client) error {
+		c.metrics = metrics
+		return nil
+	}
+}
+
+// newAPICallResult returns the result of a call that started at start.
+func newAPICallResult(start time.Time, resp *Response, err error) *APICallResult {
+	result := &APICallResult{
+		RateLimitRemaining: -1,
+		Duration:           time.Since(start),
+		Err:                err,
+	}
+	if resp != nil {
+		if resp.Response != nil {
+			result.StatusCode = resp.StatusCode
+		}
+		result.RequestID = resp.RequestID
+		if resp.RateLimit != nil {
+			result.RateLimitRemaining = resp.RateLimit.Remaining
+		}
+	}
+	return result
+}
@@ -0,0 +1,100 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type spanKey struct{}
+
+type testTracer struct {
+	calls   []*APICall
+	results []*APICallResult
+}
+
+func (t *testTracer) StartCall(ctx context.Context, call *APICall) (context.Context, CallSpan) {
+	t.calls = append(t.calls, call)
+	return context.WithValue(ctx, spanKey{}, call.Endpoint), testSpan{t}
+}
+
+type testSpan struct{ t *testTracer }
+
+func (s testSpan) End(result *APICallResult) {
+	s.t.results = append(s.t.results, result)
+}
+
+type testMetrics struct {
+	calls map[string]int
+}
+
+func (m *testMetrics) RecordCall(call *APICall, result *APICallResult) {
+	m.calls[fmt.Sprintf("%s %d", call.Endpoint, result.StatusCode)]++
+}
+
+func TestCallInstrumentation(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "abc")
+		w.Header().Set("RateLimit-Limit", "600")
+		w.Header().Set("RateLimit-Remaining", "599")
+		fmt.Fprint(w, `{"id":1}`)
+	})
+	mux.HandleFunc("/api/v4/projects/2", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"404 Project Not Found"}`)
+	})
+
+	tracer := &testTracer{}
+	metrics := &testMetrics{calls: make(map[string]int)}
+	require.NoError(t, WithCallTracer(tracer)(client))
+	require.NoError(t, WithCallMetrics(metrics)(client))
+
+	var spanEndpoint interface{}
+	require.NoError(t, WithRequestHook(func(req *retryablehttp.Request) {
+		spanEndpoint = req.Context().Value(spanKey{})
+	})(client))
+
+	_, _, err := client.Projects.GetProject(1, nil)
+	require.NoError(t, err)
+	_, _, err = client.Projects.GetProject(2, nil)
+	require.True(t, IsNotFound(err))
+
+	require.Len(t, tracer.calls, 2)
+	assert.Equal(t, http.MethodGet, tracer.calls[0].Method)
+	assert.Equal(t, "projects/:id", tracer.calls[0].Endpoint)
+	assert.Equal(t, "projects/:id", spanEndpoint)
+
+	require.Len(t, tracer.results, 2)
+	assert.Equal(t, http.StatusOK, tracer.results[0].StatusCode)
+	assert.Equal(t, "abc", tracer.results[0].RequestID)
+	assert.Equal(t, 599, tracer.results[0].RateLimitRemaining)
+	assert.NoError(t, tracer.results[0].Err)
+	assert.Equal(t, -1, tracer.results[1].RateLimitRemaining)
+	assert.True(t, IsNotFound(tracer.results[1].Err))
+
+	assert.Equal(t, map[string]int{"projects/:id 200": 1, "projects/:id 404": 1}, metrics.calls)
+}
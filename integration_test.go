@@ -0,0 +1,266 @@
+//go:build integration
+// +build integration
+
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+)
+
+// The integration tests run against a live GitLab instance. They are only
+// built with the integration build tag:
+//
+//	go test -tags integration -run Integration -v .
+//
+// By default a gitlab/gitlab-ce container is started with Docker, which
+// takes several minutes. To use an existing instance instead, set
+// GITLAB_INTEGRATION_URL and GITLAB_INTEGRATION_TOKEN to its URL and the
+// personal access token of an administrator. GITLAB_INTEGRATION_IMAGE
+// selects the image of the container.
+const (
+	defaultIntegrationImage   = "gitlab/gitlab-ce:latest"
+	integrationStartupTimeout = 20 * time.Minute
+)
+
+// integrationClient is the client used by all integration tests.
+var integrationClient *Client
+
+func TestMain(m *testing.M) {
+	baseURL := os.Getenv("GITLAB_INTEGRATION_URL")
+	token := os.Getenv("GITLAB_INTEGRATION_TOKEN")
+
+	var container string
+	if baseURL == "" {
+		var err error
+		container, baseURL, token, err = startGitLabContainer()
+		if err != nil {
+			if container != "" {
+				stopGitLabContainer(container)
+			}
+			log.Fatalf("Failed to start GitLab: %v", err)
+		}
+	}
+
+	var err error
+	integrationClient, err = NewClient(token, WithBaseURL(baseURL))
+	if err != nil {
+		log.Fatalf("Failed to create client: %v", err)
+	}
+
+	code := m.Run()
+
+	if container != "" {
+		stopGitLabContainer(container)
+	}
+	os.Exit(code)
+}
+
+// startGitLabContainer starts a GitLab container, waits until it is ready
+// and provisions a personal access token for the root user.
+func startGitLabContainer() (container, baseURL, token string, err error) {
+	image := os.Getenv("GITLAB_INTEGRATION_IMAGE")
+	if image == "" {
+		image = defaultIntegrationImage
+	}
+
+	out, err := exec.Command("docker", "run", "--detach",
+		"--publish", "127.0.0.1::80",
+		"--shm-size", "256m",
+		"--env", "GITLAB_ROOT_PASSWORD="+randomHex(16),
+		image,
+	).Output()
+	if err != nil {
+		return "", "", "", fmt.Errorf("docker run: %w", err)
+	}
+	container = strings.TrimSpace(string(out))
+
+	out, err = exec.Command("docker", "port", container, "80/tcp").Output()
+	if err != nil {
+		return container, "", "", fmt.Errorf("docker port: %w", err)
+	}
+	// Docker may list both the IPv4 and IPv6 binding.
+	addr := strings.TrimSpace(strings.SplitN(string(out), "\n", 2)[0])
+	baseURL = "http://" + addr
+
+	log.Printf("Waiting for GitLab container %.12s on %s", container, baseURL)
+	if err := waitForGitLab(baseURL, integrationStartupTimeout); err != nil {
+		return container, "", "", err
+	}
+
+	// There is no API to create the first token, so create it in Rails.
+	token = "glpat-" + randomHex(10)
+	script := fmt.Sprintf(`token = User.find_by_username('root').personal_access_tokens.create(`+
+		`scopes: ['api', 'sudo'], name: 'go-gitlab-integration', expires_at: 1.day.from_now); `+
+		`token.set_token('%s'); token.save!`, token)
+	if out, err := exec.Command("docker", "exec", container, "gitlab-rails", "runner", script).CombinedOutput(); err != nil {
+		return container, "", "", fmt.Errorf("creating token: %w: %s", err, out)
+	}
+
+	return container, baseURL, token, nil
+}
+
+// waitForGitLab waits until the sign in page of baseURL can be loaded.
+func waitForGitLab(baseURL string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		resp, err := http.Get(baseURL + "/users/sign_in")
+		if err == nil {
+			resp.Body.Close()
+			if resp.StatusCode == http.StatusOK {
+				return nil
+			}
+		}
+		time.Sleep(10 * time.Second)
+	}
+	return fmt.Errorf("GitLab not ready after %s", timeout)
+}
+
+func stopGitLabContainer(container string) {
+	if out, err := exec.Command("docker", "rm", "--force", "--volumes", container).CombinedOutput(); err != nil {
+		log.Printf("Failed to remove GitLab container %.12s: %v: %s", container, err, out)
+	}
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
+
+// createIntegrationProject creates a project initialized with a README.
+// The caller must delete it with deleteIntegrationProject.
+func createIntegrationProject(t *testing.T) *Project {
+	t.Helper()
+
+	project, _, err := integrationClient.Projects.CreateProject(&CreateProjectOptions{
+		Name:                 String("go-gitlab-" + randomHex(4)),
+		InitializeWithReadme: Bool(true),
+	})
+	if err != nil {
+		t.Fatalf("CreateProject returned error: %v", err)
+	}
+
+	return project
+}
+
+func deleteIntegrationProject(t *testing.T, pid interface{}) {
+	if _, err := integrationClient.Projects.DeleteProject(pid, nil); err != nil && !IsNotFound(err) {
+		t.Errorf("DeleteProject returned error: %v", err)
+	}
+}
+
+func TestIntegrationVersion(t *testing.T) {
+	version, _, err := integrationClient.Version.GetVersion()
+	if err != nil {
+		t.Fatalf("GetVersion returned error: %v", err)
+	}
+	if version.Version == "" {
+		t.Error("GetVersion returned an empty version")
+	}
+	t.Logf("Running against GitLab %s (%s)", version.Version, version.Revision)
+}
+
+func TestIntegrationProject(t *testing.T) {
+	project := createIntegrationProject(t)
+	defer deleteIntegrationProject(t, project.ID)
+
+	got, _, err := integrationClient.Projects.GetProject(project.ID, nil)
+	if err != nil {
+		t.Fatalf("GetProject returned error: %v", err)
+	}
+	if got.PathWithNamespace != project.PathWithNamespace {
+		t.Errorf("GetProject returned %q, want %q", got.PathWithNamespace, project.PathWithNamespace)
+	}
+
+	branches, _, err := integrationClient.Branches.ListBranches(project.ID, nil)
+	if err != nil {
+		t.Fatalf("ListBranches returned error: %v", err)
+	}
+	if len(branches) != 1 || branches[0].Name != project.DefaultBranch {
+		t.Errorf("ListBranches returned %v, want only %q", branches, project.DefaultBranch)
+	}
+}
+
+func TestIntegrationProjectExportImport(t *testing.T) {
+	project := createIntegrationProject(t)
+	defer deleteIntegrationProject(t, project.ID)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	// Export the project and import the archive as a new project within the
+	// same instance.
+	var archive bytes.Buffer
+	_, err := integrationClient.ProjectImportExport.ExportAndDownload(ctx, project.ID, &archive, &ExportAndDownloadOptions{
+		PollInterval: 2 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("ExportAndDownload returned error: %v", err)
+	}
+
+	path := project.Path + "-imported"
+	is, _, err := integrationClient.ProjectImportExport.ImportFromReader(&ImportFileOptions{
+		Path: String(path),
+	}, &archive, "project.tar.gz", WithContext(ctx))
+	if err != nil {
+		t.Fatalf("ImportFromReader returned error: %v", err)
+	}
+	defer deleteIntegrationProject(t, is.ID)
+
+	for !is.ImportStatus.IsTerminal() {
+		select {
+		case <-ctx.Done():
+			t.Fatalf("Import did not finish: %v", ctx.Err())
+		case <-time.After(2 * time.Second):
+		}
+		if is, _, err = integrationClient.ProjectImportExport.ImportStatus(is.ID, WithContext(ctx)); err != nil {
+			t.Fatalf("ImportStatus returned error: %v", err)
+		}
+	}
+	if is.ImportStatus != ImportFinished {
+		t.Fatalf("Import status is %q, want %q: %s", is.ImportStatus, ImportFinished, is.ImportError)
+	}
+
+	imported, _, err := integrationClient.Projects.GetProject(is.ID, nil)
+	if err != nil {
+		t.Fatalf("GetProject returned error: %v", err)
+	}
+	if imported.Path != path {
+		t.Errorf("Imported project has path %q, want %q", imported.Path, path)
+	}
+
+	if _, _, err := integrationClient.RepositoryFiles.GetFile(imported.ID, "README.md", &GetFileOptions{
+		Ref: String(imported.DefaultBranch),
+	}); err != nil {
+		t.Errorf("GetFile returned error for the imported README: %v", err)
+	}
+}
@@ -18,6 +18,7 @@ package gitlab
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -85,40 +86,41 @@ type IssueLinks struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/issues.html
 type Issue struct {
-	ID                   int              `json:"id"`
-	IID                  int              `json:"iid"`
-	ExternalID           string           `json:"external_id"`
-	State                string           `json:"state"`
-	Description          string           `json:"description"`
-	Author               *IssueAuthor     `json:"author"`
-	Milestone            *Milestone       `json:"milestone"`
-	ProjectID            int              `json:"project_id"`
-	Assignees            []*IssueAssignee `json:"assignees"`
-	Assignee             *IssueAssignee   `json:"assignee"`
-	UpdatedAt            *time.Time       `json:"updated_at"`
-	ClosedAt             *time.Time       `json:"closed_at"`
-	ClosedBy             *IssueCloser     `json:"closed_by"`
-	Title                string           `json:"title"`
-	CreatedAt            *time.Time       `json:"created_at"`
-	MovedToID            int              `json:"moved_to_id"`
-	Labels               Labels           `json:"labels"`
-	LabelDetails         []*LabelDetails  `json:"label_details"`
-	Upvotes              int              `json:"upvotes"`
-	Downvotes            int              `json:"downvotes"`
-	DueDate              *ISOTime         `json:"due_date"`
-	WebURL               string           `json:"web_url"`
-	References           *IssueReferences `json:"references"`
-	TimeStats            *TimeStats       `json:"time_stats"`
-	Confidential         bool             `json:"confidential"`
-	Weight               int              `json:"weight"`
-	DiscussionLocked     bool             `json:"discussion_locked"`
-	Subscribed           bool             `json:"subscribed"`
-	UserNotesCount       int              `json:"user_notes_count"`
-	Links                *IssueLinks      `json:"_links"`
-	IssueLinkID          int              `json:"issue_link_id"`
-	MergeRequestCount    int              `json:"merge_requests_count"`
-	EpicIssueID          int              `json:"epic_issue_id"`
-	Epic                 *Epic            `json:"epic"`
+	ID                   int               `json:"id"`
+	IID                  int               `json:"iid"`
+	ExternalID           string            `json:"external_id"`
+	State                string            `json:"state"`
+	Description          string            `json:"description"`
+	Author               *IssueAuthor      `json:"author"`
+	Milestone            *Milestone        `json:"milestone"`
+	ProjectID            int               `json:"project_id"`
+	Assignees            []*IssueAssignee  `json:"assignees"`
+	Assignee             *IssueAssignee    `json:"assignee"`
+	UpdatedAt            *time.Time        `json:"updated_at"`
+	ClosedAt             *time.Time        `json:"closed_at"`
+	ClosedBy             *IssueCloser      `json:"closed_by"`
+	Title                string            `json:"title"`
+	CreatedAt            *time.Time        `json:"created_at"`
+	MovedToID            int               `json:"moved_to_id"`
+	Labels               Labels            `json:"labels"`
+	LabelDetails         []*LabelDetails   `json:"label_details"`
+	Upvotes              int               `json:"upvotes"`
+	Downvotes            int               `json:"downvotes"`
+	DueDate              *ISOTime          `json:"due_date"`
+	WebURL               string            `json:"web_url"`
+	References           *IssueReferences  `json:"references"`
+	TimeStats            *TimeStats        `json:"time_stats"`
+	Confidential         bool              `json:"confidential"`
+	Weight               int               `json:"weight"`
+	HealthStatus         HealthStatusValue `json:"health_status"`
+	DiscussionLocked     bool              `json:"discussion_locked"`
+	Subscribed           bool              `json:"subscribed"`
+	UserNotesCount       int               `json:"user_notes_count"`
+	Links                *IssueLinks       `json:"_links"`
+	IssueLinkID          int               `json:"issue_link_id"`
+	MergeRequestCount    int               `json:"merge_requests_count"`
+	EpicIssueID          int               `json:"epic_issue_id"`
+	Epic                 *Epic             `json:"epic"`
 	TaskCompletionStatus struct {
 		Count          int `json:"count"`
 		CompletedCount int `json:"completed_count"`
@@ -203,35 +205,67 @@ type LabelDetails struct {
 	TextColor       string `json:"text_color"`
 }
 
+// IssueOrderByValue represents a column issues can be ordered by.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/issues.html#list-issues
+type IssueOrderByValue string
+
+// These constants represent all valid columns to order issues by.
+const (
+	IssueOrderByCreatedAt        IssueOrderByValue = "created_at"
+	IssueOrderByUpdatedAt        IssueOrderByValue = "updated_at"
+	IssueOrderByPriority         IssueOrderByValue = "priority"
+	IssueOrderByDueDate          IssueOrderByValue = "due_date"
+	IssueOrderByRelativePosition IssueOrderByValue = "relative_position"
+	IssueOrderByLabelPriority    IssueOrderByValue = "label_priority"
+	IssueOrderByMilestoneDue     IssueOrderByValue = "milestone_due"
+	IssueOrderByPopularity       IssueOrderByValue = "popularity"
+	IssueOrderByWeight           IssueOrderByValue = "weight"
+	IssueOrderByTitle            IssueOrderByValue = "title"
+)
+
+// IssueOrderBy is a helper routine that allocates a new IssueOrderByValue
+// to store v and returns a pointer to it.
+func IssueOrderBy(v IssueOrderByValue) *IssueOrderByValue {
+	p := new(IssueOrderByValue)
+	*p = v
+	return p
+}
+
 // ListIssuesOptions represents the available ListIssues() options.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/issues.html#list-issues
 type ListIssuesOptions struct {
 	ListOptions
-	State              *string    `url:"state,omitempty" json:"state,omitempty"`
-	Labels             Labels     `url:"labels,comma,omitempty" json:"labels,omitempty"`
-	NotLabels          Labels     `url:"not[labels],comma,omitempty" json:"not[labels],omitempty"`
-	WithLabelDetails   *bool      `url:"with_labels_details,omitempty" json:"with_labels_details,omitempty"`
-	Milestone          *string    `url:"milestone,omitempty" json:"milestone,omitempty"`
-	NotMilestone       *string    `url:"not[milestone],omitempty" json:"not[milestone],omitempty"`
-	Scope              *string    `url:"scope,omitempty" json:"scope,omitempty"`
-	AuthorID           *int       `url:"author_id,omitempty" json:"author_id,omitempty"`
-	NotAuthorID        []int      `url:"not[author_id],omitempty" json:"not[author_id],omitempty"`
-	AssigneeID         *int       `url:"assignee_id,omitempty" json:"assignee_id,omitempty"`
-	NotAssigneeID      []int      `url:"not[assignee_id],omitempty" json:"not[assignee_id],omitempty"`
-	AssigneeUsername   *string    `url:"assignee_username,omitempty" json:"assignee_username,omitempty"`
-	MyReactionEmoji    *string    `url:"my_reaction_emoji,omitempty" json:"my_reaction_emoji,omitempty"`
-	NotMyReactionEmoji []string   `url:"not[my_reaction_emoji],omitempty" json:"not[my_reaction_emoji],omitempty"`
-	IIDs               []int      `url:"iids[],omitempty" json:"iids,omitempty"`
-	In                 *string    `url:"in,omitempty" json:"in,omitempty"`
-	OrderBy            *string    `url:"order_by,omitempty" json:"order_by,omitempty"`
-	Sort               *string    `url:"sort,omitempty" json:"sort,omitempty"`
-	Search             *string    `url:"search,omitempty" json:"search,omitempty"`
-	CreatedAfter       *time.Time `url:"created_after,omitempty" json:"created_after,omitempty"`
-	CreatedBefore      *time.Time `url:"created_before,omitempty" json:"created_before,omitempty"`
-	UpdatedAfter       *time.Time `url:"updated_after,omitempty" json:"updated_after,omitempty"`
-	UpdatedBefore      *time.Time `url:"updated_before,omitempty" json:"updated_before,omitempty"`
-	Confidential       *bool      `url:"confidential,omitempty" json:"confidential,omitempty"`
+	State              *string            `url:"state,omitempty" json:"state,omitempty"`
+	Labels             Labels             `url:"labels,comma,omitempty" json:"labels,omitempty"`
+	NotLabels          Labels             `url:"not[labels],comma,omitempty" json:"not[labels],omitempty"`
+	WithLabelDetails   *bool              `url:"with_labels_details,omitempty" json:"with_labels_details,omitempty"`
+	Milestone          *string            `url:"milestone,omitempty" json:"milestone,omitempty"`
+	NotMilestone       *string            `url:"not[milestone],omitempty" json:"not[milestone],omitempty"`
+	MilestoneID        *MilestoneIDValue  `url:"milestone_id,omitempty" json:"milestone_id,omitempty"`
+	Scope              *string            `url:"scope,omitempty" json:"scope,omitempty"`
+	AuthorID           *int               `url:"author_id,omitempty" json:"author_id,omitempty"`
+	NotAuthorID        []int              `url:"not[author_id],omitempty" json:"not[author_id],omitempty"`
+	AssigneeID         *int               `url:"assignee_id,omitempty" json:"assignee_id,omitempty"`
+	NotAssigneeID      []int              `url:"not[assignee_id],omitempty" json:"not[assignee_id],omitempty"`
+	AssigneeUsername   *string            `url:"assignee_username,omitempty" json:"assignee_username,omitempty"`
+	MyReactionEmoji    *string            `url:"my_reaction_emoji,omitempty" json:"my_reaction_emoji,omitempty"`
+	NotMyReactionEmoji []string           `url:"not[my_reaction_emoji],omitempty" json:"not[my_reaction_emoji],omitempty"`
+	IIDs               []int              `url:"iids[],omitempty" json:"iids,omitempty"`
+	In                 *string            `url:"in,omitempty" json:"in,omitempty"`
+	OrderBy            *IssueOrderByValue `url:"order_by,omitempty" json:"order_by,omitempty"`
+	Sort               *SortValue         `url:"sort,omitempty" json:"sort,omitempty"`
+	Search             *string            `url:"search,omitempty" json:"search,omitempty"`
+	CreatedAfter       *time.Time         `url:"created_after,omitempty" json:"created_after,omitempty"`
+	CreatedBefore      *time.Time         `url:"created_before,omitempty" json:"created_before,omitempty"`
+	UpdatedAfter       *time.Time         `url:"updated_after,omitempty" json:"updated_after,omitempty"`
+	UpdatedBefore      *time.Time         `url:"updated_before,omitempty" json:"updated_before,omitempty"`
+	Confidential       *bool              `url:"confidential,omitempty" json:"confidential,omitempty"`
+	Weight             *int               `url:"weight,omitempty" json:"weight,omitempty"`
+	NotWeight          *int               `url:"not[weight],omitempty" json:"not[weight],omitempty"`
+	HealthStatus       *HealthStatusValue `url:"health_status,omitempty" json:"health_status,omitempty"`
 }
 
 // ListIssues gets all issues created by authenticated user. This function
@@ -258,30 +292,34 @@ func (s *IssuesService) ListIssues(opt *ListIssuesOptions, options ...RequestOpt
 // GitLab API docs: https://docs.gitlab.com/ce/api/issues.html#list-group-issues
 type ListGroupIssuesOptions struct {
 	ListOptions
-	State              *string    `url:"state,omitempty" json:"state,omitempty"`
-	Labels             Labels     `url:"labels,comma,omitempty" json:"labels,omitempty"`
-	NotLabels          Labels     `url:"not[labels],comma,omitempty" json:"not[labels],omitempty"`
-	WithLabelDetails   *bool      `url:"with_labels_details,omitempty" json:"with_labels_details,omitempty"`
-	IIDs               []int      `url:"iids[],omitempty" json:"iids,omitempty"`
-	Milestone          *string    `url:"milestone,omitempty" json:"milestone,omitempty"`
-	NotMilestone       *string    `url:"not[milestone],omitempty" json:"not[milestone],omitempty"`
-	Scope              *string    `url:"scope,omitempty" json:"scope,omitempty"`
-	AuthorID           *int       `url:"author_id,omitempty" json:"author_id,omitempty"`
-	NotAuthorID        []int      `url:"not[author_id],omitempty" json:"not[author_id],omitempty"`
-	AuthorUsername     *string    `url:"author_username,omitempty" json:"author_username,omitempty"`
-	AssigneeID         *int       `url:"assignee_id,omitempty" json:"assignee_id,omitempty"`
-	NotAssigneeID      []int      `url:"not[assignee_id],omitempty" json:"not[assignee_id],omitempty"`
-	AssigneeUsername   *string    `url:"assignee_username,omitempty" json:"assignee_username,omitempty"`
-	MyReactionEmoji    *string    `url:"my_reaction_emoji,omitempty" json:"my_reaction_emoji,omitempty"`
-	NotMyReactionEmoji []string   `url:"not[my_reaction_emoji],omitempty" json:"not[my_reaction_emoji],omitempty"`
-	OrderBy            *string    `url:"order_by,omitempty" json:"order_by,omitempty"`
-	Sort               *string    `url:"sort,omitempty" json:"sort,omitempty"`
-	Search             *string    `url:"search,omitempty" json:"search,omitempty"`
-	In                 *string    `url:"in,omitempty" json:"in,omitempty"`
-	CreatedAfter       *time.Time `url:"created_after,omitempty" json:"created_after,omitempty"`
-	CreatedBefore      *time.Time `url:"created_before,omitempty" json:"created_before,omitempty"`
-	UpdatedAfter       *time.Time `url:"updated_after,omitempty" json:"updated_after,omitempty"`
-	UpdatedBefore      *time.Time `url:"updated_before,omitempty" json:"updated_before,omitempty"`
+	State              *string            `url:"state,omitempty" json:"state,omitempty"`
+	Labels             Labels             `url:"labels,comma,omitempty" json:"labels,omitempty"`
+	NotLabels          Labels             `url:"not[labels],comma,omitempty" json:"not[labels],omitempty"`
+	WithLabelDetails   *bool              `url:"with_labels_details,omitempty" json:"with_labels_details,omitempty"`
+	IIDs               []int              `url:"iids[],omitempty" json:"iids,omitempty"`
+	Milestone          *string            `url:"milestone,omitempty" json:"milestone,omitempty"`
+	NotMilestone       *string            `url:"not[milestone],omitempty" json:"not[milestone],omitempty"`
+	MilestoneID        *MilestoneIDValue  `url:"milestone_id,omitempty" json:"milestone_id,omitempty"`
+	Scope              *string            `url:"scope,omitempty" json:"scope,omitempty"`
+	AuthorID           *int               `url:"author_id,omitempty" json:"author_id,omitempty"`
+	NotAuthorID        []int              `url:"not[author_id],omitempty" json:"not[author_id],omitempty"`
+	AuthorUsername     *string            `url:"author_username,omitempty" json:"author_username,omitempty"`
+	AssigneeID         *int               `url:"assignee_id,omitempty" json:"assignee_id,omitempty"`
+	NotAssigneeID      []int              `url:"not[assignee_id],omitempty" json:"not[assignee_id],omitempty"`
+	AssigneeUsername   *string            `url:"assignee_username,omitempty" json:"assignee_username,omitempty"`
+	MyReactionEmoji    *string            `url:"my_reaction_emoji,omitempty" json:"my_reaction_emoji,omitempty"`
+	NotMyReactionEmoji []string           `url:"not[my_reaction_emoji],omitempty" json:"not[my_reaction_emoji],omitempty"`
+	OrderBy            *IssueOrderByValue `url:"order_by,omitempty" json:"order_by,omitempty"`
+	Sort               *SortValue         `url:"sort,omitempty" json:"sort,omitempty"`
+	Search             *string            `url:"search,omitempty" json:"search,omitempty"`
+	In                 *string            `url:"in,omitempty" json:"in,omitempty"`
+	CreatedAfter       *time.Time         `url:"created_after,omitempty" json:"created_after,omitempty"`
+	CreatedBefore      *time.Time         `url:"created_before,omitempty" json:"created_before,omitempty"`
+	UpdatedAfter       *time.Time         `url:"updated_after,omitempty" json:"updated_after,omitempty"`
+	UpdatedBefore      *time.Time         `url:"updated_before,omitempty" json:"updated_before,omitempty"`
+	Weight             *int               `url:"weight,omitempty" json:"weight,omitempty"`
+	NotWeight          *int               `url:"not[weight],omitempty" json:"not[weight],omitempty"`
+	HealthStatus       *HealthStatusValue `url:"health_status,omitempty" json:"health_status,omitempty"`
 }
 
 // ListGroupIssues gets a list of group issues. This function accepts
@@ -314,31 +352,35 @@ func (s *IssuesService) ListGroupIssues(pid interface{}, opt *ListGroupIssuesOpt
 // GitLab API docs: https://docs.gitlab.com/ce/api/issues.html#list-project-issues
 type ListProjectIssuesOptions struct {
 	ListOptions
-	IIDs               []int      `url:"iids[],omitempty" json:"iids,omitempty"`
-	State              *string    `url:"state,omitempty" json:"state,omitempty"`
-	Labels             Labels     `url:"labels,comma,omitempty" json:"labels,omitempty"`
-	NotLabels          Labels     `url:"not[labels],comma,omitempty" json:"not[labels],omitempty"`
-	WithLabelDetails   *bool      `url:"with_labels_details,omitempty" json:"with_labels_details,omitempty"`
-	Milestone          *string    `url:"milestone,omitempty" json:"milestone,omitempty"`
-	NotMilestone       []string   `url:"not[milestone],omitempty" json:"not[milestone],omitempty"`
-	Scope              *string    `url:"scope,omitempty" json:"scope,omitempty"`
-	AuthorID           *int       `url:"author_id,omitempty" json:"author_id,omitempty"`
-	NotAuthorID        []int      `url:"not[author_id],omitempty" json:"not[author_id],omitempty"`
-	AssigneeID         *int       `url:"assignee_id,omitempty" json:"assignee_id,omitempty"`
-	NotAssigneeID      []int      `url:"not[assignee_id],omitempty" json:"not[assignee_id],omitempty"`
-	AssigneeUsername   *string    `url:"assignee_username,omitempty" json:"assignee_username,omitempty"`
-	MyReactionEmoji    *string    `url:"my_reaction_emoji,omitempty" json:"my_reaction_emoji,omitempty"`
-	NotMyReactionEmoji []string   `url:"not[my_reaction_emoji],omitempty" json:"not[my_reaction_emoji],omitempty"`
-	OrderBy            *string    `url:"order_by,omitempty" json:"order_by,omitempty"`
-	Sort               *string    `url:"sort,omitempty" json:"sort,omitempty"`
-	Search             *string    `url:"search,omitempty" json:"search,omitempty"`
-	In                 *string    `url:"in,omitempty" json:"in,omitempty"`
-	CreatedAfter       *time.Time `url:"created_after,omitempty" json:"created_after,omitempty"`
-	CreatedBefore      *time.Time `url:"created_before,omitempty" json:"created_before,omitempty"`
-	DueDate            *string    `url:"due_date,omitempty" json:"due_date,omitempty"`
-	UpdatedAfter       *time.Time `url:"updated_after,omitempty" json:"updated_after,omitempty"`
-	UpdatedBefore      *time.Time `url:"updated_before,omitempty" json:"updated_before,omitempty"`
-	Confidential       *bool      `url:"confidential,omitempty" json:"confidential,omitempty"`
+	IIDs               []int              `url:"iids[],omitempty" json:"iids,omitempty"`
+	State              *string            `url:"state,omitempty" json:"state,omitempty"`
+	Labels             Labels             `url:"labels,comma,omitempty" json:"labels,omitempty"`
+	NotLabels          Labels             `url:"not[labels],comma,omitempty" json:"not[labels],omitempty"`
+	WithLabelDetails   *bool              `url:"with_labels_details,omitempty" json:"with_labels_details,omitempty"`
+	Milestone          *string            `url:"milestone,omitempty" json:"milestone,omitempty"`
+	NotMilestone       []string           `url:"not[milestone],omitempty" json:"not[milestone],omitempty"`
+	MilestoneID        *MilestoneIDValue  `url:"milestone_id,omitempty" json:"milestone_id,omitempty"`
+	Scope              *string            `url:"scope,omitempty" json:"scope,omitempty"`
+	AuthorID           *int               `url:"author_id,omitempty" json:"author_id,omitempty"`
+	NotAuthorID        []int              `url:"not[author_id],omitempty" json:"not[author_id],omitempty"`
+	AssigneeID         *int               `url:"assignee_id,omitempty" json:"assignee_id,omitempty"`
+	NotAssigneeID      []int              `url:"not[assignee_id],omitempty" json:"not[assignee_id],omitempty"`
+	AssigneeUsername   *string            `url:"assignee_username,omitempty" json:"assignee_username,omitempty"`
+	MyReactionEmoji    *string            `url:"my_reaction_emoji,omitempty" json:"my_reaction_emoji,omitempty"`
+	NotMyReactionEmoji []string           `url:"not[my_reaction_emoji],omitempty" json:"not[my_reaction_emoji],omitempty"`
+	OrderBy            *IssueOrderByValue `url:"order_by,omitempty" json:"order_by,omitempty"`
+	Sort               *SortValue         `url:"sort,omitempty" json:"sort,omitempty"`
+	Search             *string            `url:"search,omitempty" json:"search,omitempty"`
+	In                 *string            `url:"in,omitempty" json:"in,omitempty"`
+	CreatedAfter       *time.Time         `url:"created_after,omitempty" json:"created_after,omitempty"`
+	CreatedBefore      *time.Time         `url:"created_before,omitempty" json:"created_before,omitempty"`
+	DueDate            *string            `url:"due_date,omitempty" json:"due_date,omitempty"`
+	UpdatedAfter       *time.Time         `url:"updated_after,omitempty" json:"updated_after,omitempty"`
+	UpdatedBefore      *time.Time         `url:"updated_before,omitempty" json:"updated_before,omitempty"`
+	Confidential       *bool              `url:"confidential,omitempty" json:"confidential,omitempty"`
+	Weight             *int               `url:"weight,omitempty" json:"weight,omitempty"`
+	NotWeight          *int               `url:"not[weight],omitempty" json:"not[weight],omitempty"`
+	HealthStatus       *HealthStatusValue `url:"health_status,omitempty" json:"health_status,omitempty"`
 }
 
 // ListProjectIssues gets a list of project issues. This function accepts
@@ -394,18 +436,19 @@ func (s *IssuesService) GetIssue(pid interface{}, issue int, options ...RequestO
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/issues.html#new-issues
 type CreateIssueOptions struct {
-	IID                                *int       `url:"iid,omitempty" json:"iid,omitempty"`
-	Title                              *string    `url:"title,omitempty" json:"title,omitempty"`
-	Description                        *string    `url:"description,omitempty" json:"description,omitempty"`
-	Confidential                       *bool      `url:"confidential,omitempty" json:"confidential,omitempty"`
-	AssigneeIDs                        []int      `url:"assignee_ids,omitempty" json:"assignee_ids,omitempty"`
-	MilestoneID                        *int       `url:"milestone_id,omitempty" json:"milestone_id,omitempty"`
-	Labels                             Labels     `url:"labels,comma,omitempty" json:"labels,omitempty"`
-	CreatedAt                          *time.Time `url:"created_at,omitempty" json:"created_at,omitempty"`
-	DueDate                            *ISOTime   `url:"due_date,omitempty" json:"due_date,omitempty"`
-	MergeRequestToResolveDiscussionsOf *int       `url:"merge_request_to_resolve_discussions_of,omitempty" json:"merge_request_to_resolve_discussions_of,omitempty"`
-	DiscussionToResolve                *string    `url:"discussion_to_resolve,omitempty" json:"discussion_to_resolve,omitempty"`
-	Weight                             *int       `url:"weight,omitempty" json:"weight,omitempty"`
+	IID                                *int               `url:"iid,omitempty" json:"iid,omitempty"`
+	Title                              *string            `url:"title,omitempty" json:"title,omitempty"`
+	Description                        *string            `url:"description,omitempty" json:"description,omitempty"`
+	Confidential                       *bool              `url:"confidential,omitempty" json:"confidential,omitempty"`
+	AssigneeIDs                        []int              `url:"assignee_ids,omitempty" json:"assignee_ids,omitempty"`
+	MilestoneID                        *int               `url:"milestone_id,omitempty" json:"milestone_id,omitempty"`
+	Labels                             Labels             `url:"labels,comma,omitempty" json:"labels,omitempty"`
+	CreatedAt                          *time.Time         `url:"created_at,omitempty" json:"created_at,omitempty"`
+	DueDate                            *ISOTime           `url:"due_date,omitempty" json:"due_date,omitempty"`
+	MergeRequestToResolveDiscussionsOf *int               `url:"merge_request_to_resolve_discussions_of,omitempty" json:"merge_request_to_resolve_discussions_of,omitempty"`
+	DiscussionToResolve                *string            `url:"discussion_to_resolve,omitempty" json:"discussion_to_resolve,omitempty"`
+	Weight                             *int               `url:"weight,omitempty" json:"weight,omitempty"`
+	HealthStatus                       *HealthStatusValue `url:"health_status,omitempty" json:"health_status,omitempty"`
 }
 
 // CreateIssue creates a new project issue.
@@ -436,19 +479,20 @@ func (s *IssuesService) CreateIssue(pid interface{}, opt *CreateIssueOptions, op
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#edit-issue
 type UpdateIssueOptions struct {
-	Title            *string    `url:"title,omitempty" json:"title,omitempty"`
-	Description      *string    `url:"description,omitempty" json:"description,omitempty"`
-	Confidential     *bool      `url:"confidential,omitempty" json:"confidential,omitempty"`
-	AssigneeIDs      []int      `url:"assignee_ids,omitempty" json:"assignee_ids,omitempty"`
-	MilestoneID      *int       `url:"milestone_id,omitempty" json:"milestone_id,omitempty"`
-	Labels           Labels     `url:"labels,comma,omitempty" json:"labels,omitempty"`
-	AddLabels        Labels     `url:"add_labels,comma,omitempty" json:"add_labels,omitempty"`
-	RemoveLabels     Labels     `url:"remove_labels,comma,omitempty" json:"remove_labels,omitempty"`
-	StateEvent       *string    `url:"state_event,omitempty" json:"state_event,omitempty"`
-	UpdatedAt        *time.Time `url:"updated_at,omitempty" json:"updated_at,omitempty"`
-	DueDate          *ISOTime   `url:"due_date,omitempty" json:"due_date,omitempty"`
-	Weight           *int       `url:"weight,omitempty" json:"weight,omitempty"`
-	DiscussionLocked *bool      `url:"discussion_locked,omitempty" json:"discussion_locked,omitempty"`
+	Title            *string            `url:"title,omitempty" json:"title,omitempty"`
+	Description      *string            `url:"description,omitempty" json:"description,omitempty"`
+	Confidential     *bool              `url:"confidential,omitempty" json:"confidential,omitempty"`
+	AssigneeIDs      []int              `url:"assignee_ids,omitempty" json:"assignee_ids,omitempty"`
+	MilestoneID      *int               `url:"milestone_id,omitempty" json:"milestone_id,omitempty"`
+	Labels           Labels             `url:"labels,comma,omitempty" json:"labels,omitempty"`
+	AddLabels        Labels             `url:"add_labels,comma,omitempty" json:"add_labels,omitempty"`
+	RemoveLabels     Labels             `url:"remove_labels,comma,omitempty" json:"remove_labels,omitempty"`
+	StateEvent       *string            `url:"state_event,omitempty" json:"state_event,omitempty"`
+	UpdatedAt        *time.Time         `url:"updated_at,omitempty" json:"updated_at,omitempty"`
+	DueDate          *ISOTime           `url:"due_date,omitempty" json:"due_date,omitempty"`
+	Weight           *int               `url:"weight,omitempty" json:"weight,omitempty"`
+	DiscussionLocked *bool              `url:"discussion_locked,omitempty" json:"discussion_locked,omitempty"`
+	HealthStatus     *HealthStatusValue `url:"health_status,omitempty" json:"health_status,omitempty"`
 }
 
 // UpdateIssue updates an existing project issue. This function is also used
@@ -580,6 +624,37 @@ func (s *IssuesService) UnsubscribeFromIssue(pid interface{}, issue int, options
 	return i, resp, err
 }
 
+// UnsubscribeFromIssues unsubscribes the authenticated user from all issues
+// matching the given list options, to stop notifications about them in one
+// go. The matching issues are returned together with the result of the
+// unsubscribe call for every issue, in the same order. The response of issues
+// the user wasn't subscribed to has status code 304.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/issues.html#unsubscribe-from-an-issue
+func (s *IssuesService) UnsubscribeFromIssues(ctx context.Context, opt *ListIssuesOptions, bopt *BulkOptions) ([]*Issue, []*BulkResult, error) {
+	var issues []*Issue
+	err := ForEachPage(func(options ...RequestOptionFunc) (*Response, error) {
+		is, resp, err := s.ListIssues(opt, options...)
+		issues = append(issues, is...)
+		return resp, err
+	}, WithContext(ctx))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ops := make([]BulkOperation, len(issues))
+	for i, issue := range issues {
+		issue := issue
+		ops[i] = func(options ...RequestOptionFunc) (*Response, error) {
+			_, resp, err := s.UnsubscribeFromIssue(issue.ProjectID, issue.IID, options...)
+			return ignoreNotModified(resp, err)
+		}
+	}
+
+	return issues, s.client.ExecuteBulk(ctx, ops, bopt), nil
+}
+
 // ListMergeRequestsClosingIssueOptions represents the available
 // ListMergeRequestsClosingIssue() options.
 //
@@ -597,7 +672,7 @@ func (s *IssuesService) ListMergeRequestsClosingIssue(pid interface{}, issue int
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("/projects/%s/issues/%d/closed_by", pathEscape(project), issue)
+	u := fmt.Sprintf("projects/%s/issues/%d/closed_by", pathEscape(project), issue)
 
 	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
 	if err != nil {
@@ -630,7 +705,7 @@ func (s *IssuesService) ListMergeRequestsRelatedToIssue(pid interface{}, issue i
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("/projects/%s/issues/%d/related_merge_requests",
+	u := fmt.Sprintf("projects/%s/issues/%d/related_merge_requests",
 		pathEscape(project),
 		issue,
 	)
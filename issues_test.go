@@ -17,6 +17,7 @@
 package gitlab
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
@@ -120,6 +121,27 @@ func TestMoveIssue(t *testing.T) {
 	}
 }
 
+func TestListIssuesMilestoneWildcard(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/projects/1/issues?milestone_id=Upcoming")
+		fmt.Fprint(w, `[{"id":1,"milestone":{"id":12,"title":"10.0"}}]`)
+	})
+
+	opt := &ListProjectIssuesOptions{MilestoneID: MilestoneID(MilestoneIDUpcoming)}
+	issues, _, err := client.Issues.ListProjectIssues(1, opt)
+	if err != nil {
+		t.Fatalf("Issues.ListProjectIssues returned error: %v", err)
+	}
+
+	if len(issues) != 1 || issues[0].Milestone == nil || issues[0].Milestone.ID != 12 {
+		t.Errorf("Issues.ListProjectIssues returned %+v", issues)
+	}
+}
+
 func TestListIssues(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
@@ -355,6 +377,47 @@ func TestListProjectIssues(t *testing.T) {
 	}
 }
 
+func TestListProjectIssuesByHealthStatusAndWeight(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/projects/1/issues?health_status=at_risk&not%5Bweight%5D=0")
+		fmt.Fprint(w, `[{"id":1, "weight": 3, "health_status": "at_risk"}]`)
+	})
+
+	opt := &ListProjectIssuesOptions{
+		HealthStatus: HealthStatus(HealthStatusAtRisk),
+		NotWeight:    Int(0),
+	}
+	issues, _, err := client.Issues.ListProjectIssues(1, opt)
+	if err != nil {
+		t.Fatalf("Issues.ListProjectIssues returned error: %v", err)
+	}
+
+	want := []*Issue{{ID: 1, Weight: 3, HealthStatus: HealthStatusAtRisk}}
+	assert.Equal(t, want, issues)
+}
+
+func TestListIssueWeightEvents(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/issues/2/resource_weight_events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"id":142, "resource_type": "Issue", "resource_id": 253, "issue_id": 253, "weight": 3}]`)
+	})
+
+	events, _, err := client.ResourceWeightEvents.ListIssueWeightEvents(1, 2, nil)
+	if err != nil {
+		t.Fatalf("ResourceWeightEvents.ListIssueWeightEvents returned error: %v", err)
+	}
+
+	want := []*WeightEvent{{ID: 142, ResourceType: "Issue", ResourceID: 253, IssueID: 253, Weight: 3}}
+	assert.Equal(t, want, events)
+}
+
 func TestListGroupIssues(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
@@ -481,6 +544,39 @@ func TestSubscribeToIssue(t *testing.T) {
 	}
 }
 
+func TestUnsubscribeFromIssues(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/issues?labels=stale&scope=all")
+		fmt.Fprint(w, `[{"id":1,"iid":5,"project_id":1},{"id":2,"iid":6,"project_id":1}]`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/issues/5/unsubscribe", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"id":1,"iid":5,"project_id":1,"subscribed":false}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/issues/6/unsubscribe", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		w.WriteHeader(http.StatusNotModified)
+	})
+
+	opt := &ListIssuesOptions{Labels: Labels{"stale"}, Scope: String("all")}
+	issues, results, err := client.Issues.UnsubscribeFromIssues(context.Background(), opt, nil)
+	if err != nil {
+		t.Fatalf("Issues.UnsubscribeFromIssues returned error: %v", err)
+	}
+
+	assert.Len(t, issues, 2)
+	assert.Len(t, results, 2)
+	for _, r := range results {
+		assert.NoError(t, r.Err)
+	}
+	assert.Equal(t, http.StatusOK, results[0].Response.StatusCode)
+	assert.Equal(t, http.StatusNotModified, results[1].Response.StatusCode)
+}
+
 func TestUnsubscribeFromIssue(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
@@ -562,6 +658,50 @@ func TestListMergeRequestsRelatedToIssue(t *testing.T) {
 	}
 }
 
+func TestIssueMergeRequestListsUseCleanPaths(t *testing.T) {
+	mux, server, _ := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/issues/5/closed_by", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/projects/1/issues/5/closed_by")
+		fmt.Fprint(w, `[{"id":1}]`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/issues/5/related_merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/projects/1/issues/5/related_merge_requests")
+		fmt.Fprint(w, `[{"id":2}]`)
+	})
+
+	// The mux redirects unclean paths, such as paths containing a double
+	// slash, so refuse redirects to make sure the requested path is clean.
+	httpClient := &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	client, err := NewClient("", WithBaseURL(server.URL), WithHTTPClient(httpClient), WithoutRetries())
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	mrs, _, err := client.Issues.ListMergeRequestsClosingIssue(1, 5, nil)
+	if err != nil {
+		t.Fatalf("Issues.ListMergeRequestsClosingIssue returned error: %v", err)
+	}
+	if want := []*MergeRequest{{ID: 1}}; !reflect.DeepEqual(want, mrs) {
+		t.Errorf("Issues.ListMergeRequestsClosingIssue returned %+v, want %+v", mrs, want)
+	}
+
+	mrs, _, err = client.Issues.ListMergeRequestsRelatedToIssue(1, 5, nil)
+	if err != nil {
+		t.Fatalf("Issues.ListMergeRequestsRelatedToIssue returned error: %v", err)
+	}
+	if want := []*MergeRequest{{ID: 2}}; !reflect.DeepEqual(want, mrs) {
+		t.Errorf("Issues.ListMergeRequestsRelatedToIssue returned %+v, want %+v", mrs, want)
+	}
+}
+
 func TestSetTimeEstimate(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
@@ -0,0 +1,104 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// ErrJobTokenEndpointNotAllowed is returned when a client created using
+// NewJobClient calls an endpoint that does not accept CI/CD job tokens.
+var ErrJobTokenEndpointNotAllowed = errors.New("endpoint does not accept CI/CD job tokens")
+
+// jobTokenEndpoint represents an endpoint that accepts CI/CD job tokens. In
+// the path, "*" matches a single segment and a trailing "**" matches any
+// number of segments, including none.
+type jobTokenEndpoint struct {
+	methods []string
+	path    string
+}
+
+var (
+	jobTokenRead      = []string{http.MethodGet}
+	jobTokenReadWrite = []string{http.MethodGet, http.MethodPost, http.MethodPut}
+	jobTokenAll       = []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}
+)
+
+// jobTokenEndpoints lists the endpoints that accept CI/CD job tokens.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/ci/jobs/ci_job_token.html#job-token-access
+var jobTokenEndpoints = []*jobTokenEndpoint{
+	{jobTokenRead, "job"},
+	{jobTokenRead, "job/allowed_agents"},
+	{jobTokenRead, "projects/*"},
+	{jobTokenRead, "projects/*/jobs/*/artifacts/**"},
+	{jobTokenRead, "projects/*/jobs/artifacts/**"},
+	{jobTokenAll, "projects/*/packages/**"},
+	{jobTokenReadWrite, "projects/*/releases/**"},
+	{jobTokenReadWrite, "projects/*/deployments/**"},
+	{jobTokenReadWrite, "projects/*/environments/**"},
+	{jobTokenAll, "projects/*/terraform/state/**"},
+	{jobTokenRead, "projects/*/secure_files/**"},
+	{jobTokenAll, "projects/*/registry/repositories/**"},
+	{[]string{http.MethodPost}, "projects/*/trigger/pipeline"},
+	{jobTokenAll, "groups/*/-/packages/**"},
+}
+
+// match reports whether the given method and path segments match the
+// endpoint.
+func (e *jobTokenEndpoint) match(method string, segments []string) bool {
+	allowed := false
+	for _, m := range e.methods {
+		if m == method {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return false
+	}
+
+	pattern := strings.Split(e.path, "/")
+	for i, p := range pattern {
+		if p == "**" {
+			return true
+		}
+		if i >= len(segments) || (p != "*" && p != segments[i]) {
+			return false
+		}
+	}
+	return len(pattern) == len(segments)
+}
+
+// jobTokenAllowed reports whether a request with the given method for the
+// given path, relative to the base URL, accepts CI/CD job tokens.
+func jobTokenAllowed(method, path string) bool {
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	for _, e := range jobTokenEndpoints {
+		if e.match(method, segments) {
+			return true
+		}
+	}
+	return false
+}
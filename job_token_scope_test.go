@@ -0,0 +1,118 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetProjectJobTokenAccessSettings(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/job_token_scope", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"inbound_enabled": true, "outbound_enabled": false}`)
+	})
+
+	settings, _, err := client.JobTokenScope.GetProjectJobTokenAccessSettings(1)
+	require.NoError(t, err)
+	assert.Equal(t, &JobTokenAccessSettings{InboundEnabled: true}, settings)
+}
+
+func TestPatchProjectJobTokenAccessSettings(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/job_token_scope", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPatch)
+		testURL(t, r, "/api/v4/projects/1/job_token_scope?enabled=true")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.JobTokenScope.PatchProjectJobTokenAccessSettings(1, &PatchProjectJobTokenAccessSettingsOptions{Enabled: Bool(true)})
+	require.NoError(t, err)
+}
+
+func TestJobTokenInboundAllowList(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/job_token_scope/allowlist", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `[{"id": 2, "name": "other"}]`)
+		case http.MethodPost:
+			testBody(t, r, `{"target_project_id":2}`)
+			fmt.Fprint(w, `{"source_project_id": 1, "target_project_id": 2}`)
+		default:
+			t.Errorf("unexpected request method %s", r.Method)
+		}
+	})
+	mux.HandleFunc("/api/v4/projects/1/job_token_scope/allowlist/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	projects, _, err := client.JobTokenScope.GetProjectJobTokenInboundAllowList(1, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []*Project{{ID: 2, Name: "other"}}, projects)
+
+	item, _, err := client.JobTokenScope.AddProjectToJobScopeAllowList(1, &AddProjectToJobScopeAllowListOptions{TargetProjectID: Int(2)})
+	require.NoError(t, err)
+	assert.Equal(t, &JobTokenInboundAllowItem{SourceProjectID: 1, TargetProjectID: 2}, item)
+
+	_, err = client.JobTokenScope.RemoveProjectFromJobScopeAllowList(1, 2)
+	require.NoError(t, err)
+}
+
+func TestJobTokenAllowlistGroups(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/job_token_scope/groups_allowlist", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `[{"id": 3, "name": "group"}]`)
+		case http.MethodPost:
+			testBody(t, r, `{"target_group_id":3}`)
+			fmt.Fprint(w, `{"source_project_id": 1, "target_group_id": 3}`)
+		default:
+			t.Errorf("unexpected request method %s", r.Method)
+		}
+	})
+	mux.HandleFunc("/api/v4/projects/1/job_token_scope/groups_allowlist/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	groups, _, err := client.JobTokenScope.GetJobTokenAllowlistGroups(1, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []*Group{{ID: 3, Name: "group"}}, groups)
+
+	item, _, err := client.JobTokenScope.AddGroupToJobTokenAllowlist(1, &AddGroupToJobTokenAllowlistOptions{TargetGroupID: Int(3)})
+	require.NoError(t, err)
+	assert.Equal(t, &JobTokenInboundAllowItem{SourceProjectID: 1, TargetGroupID: 3}, item)
+
+	_, err = client.JobTokenScope.RemoveGroupFromJobTokenAllowlist(1, 3)
+	require.NoError(t, err)
+}
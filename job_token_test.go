@@ -0,0 +1,98 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewJobClient(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v4/projects/1/releases", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		assert.Equal(t, "job-token", r.Header.Get("JOB-TOKEN"))
+		assert.Empty(t, r.Header.Get("PRIVATE-TOKEN"))
+		fmt.Fprint(w, `[{"tag_name":"v1.0"}]`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/issues", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Request for an endpoint that does not accept job tokens was sent")
+	})
+
+	client, err := NewJobClient("job-token", WithBaseURL(server.URL))
+	require.NoError(t, err)
+
+	releases, _, err := client.Releases.ListReleases(1, nil)
+	require.NoError(t, err)
+	require.Len(t, releases, 1)
+	assert.Equal(t, "v1.0", releases[0].TagName)
+
+	_, _, err = client.Issues.ListProjectIssues(1, nil)
+	assert.True(t, errors.Is(err, ErrJobTokenEndpointNotAllowed), "unexpected error: %v", err)
+}
+
+func TestNewJobClientWithoutRestrictions(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v4/projects/1/issues", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "job-token", r.Header.Get("JOB-TOKEN"))
+		fmt.Fprint(w, `[]`)
+	})
+
+	client, err := NewJobClient("job-token", WithBaseURL(server.URL), WithoutJobTokenRestrictions())
+	require.NoError(t, err)
+
+	_, _, err = client.Issues.ListProjectIssues(1, nil)
+	require.NoError(t, err)
+}
+
+func TestJobTokenAllowed(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		want   bool
+	}{
+		{http.MethodGet, "job", true},
+		{http.MethodGet, "projects/1", true},
+		{http.MethodDelete, "projects/1", false},
+		{http.MethodGet, "projects/1/jobs/2/artifacts", true},
+		{http.MethodGet, "projects/1/jobs/2/artifacts/dist/app.tar", true},
+		{http.MethodPut, "projects/1/packages/generic/app/1.0/app.tar", true},
+		{http.MethodPost, "projects/1/releases", true},
+		{http.MethodGet, "projects/group%2Fproject/releases/v1.0", true},
+		{http.MethodDelete, "projects/1/releases/v1.0", false},
+		{http.MethodGet, "projects/1/merge_requests", false},
+		{http.MethodGet, "user", false},
+	}
+
+	for _, tt := range tests {
+		if got := jobTokenAllowed(tt.method, tt.path); got != tt.want {
+			t.Errorf("jobTokenAllowed(%s, %s) = %v, want %v", tt.method, tt.path, got, tt.want)
+		}
+	}
+}
@@ -18,8 +18,12 @@ package gitlab
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"os"
 	"time"
 )
 
@@ -186,6 +190,61 @@ func (s *JobsService) ListPipelineBridges(pid interface{}, pipelineID int, opts
 	return bridges, resp, err
 }
 
+// GetJobTokensJobOptions represents the available GetJobTokensJob() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/jobs.html#get-job-tokens-job
+type GetJobTokensJobOptions struct {
+	JobToken *string `url:"job_token,omitempty" json:"job_token,omitempty"`
+}
+
+// GetJobTokensJob retrieves the job that generated the given job token.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/jobs.html#get-job-tokens-job
+func (s *JobsService) GetJobTokensJob(opt *GetJobTokensJobOptions, options ...RequestOptionFunc) (*Job, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "job", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	job := new(Job)
+	resp, err := s.client.Do(req, job)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return job, resp, err
+}
+
+// GetCurrentJob retrieves the job that is currently running, when called from
+// within a GitLab CI/CD job, so in-job tooling can find out its own pipeline
+// and job without relying on the other predefined CI/CD variables. A client
+// created using NewJobClient is identified by its own token. Any other client
+// sends the CI_JOB_TOKEN environment variable in the JOB-TOKEN header instead
+// of its own credentials.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/jobs.html#get-job-tokens-job
+func (s *JobsService) GetCurrentJob(options ...RequestOptionFunc) (*Job, *Response, error) {
+	if s.client.authType == jobToken {
+		return s.GetJobTokensJob(nil, options...)
+	}
+
+	token := os.Getenv("CI_JOB_TOKEN")
+	if token == "" {
+		return nil, nil, errors.New("CI_JOB_TOKEN is not set, not running in a GitLab CI/CD job")
+	}
+
+	jc, err := s.client.NewInstanceClient(s.client.baseURL.String(), token)
+	if err != nil {
+		return nil, nil, err
+	}
+	jc.authType = jobToken
+
+	return jc.Jobs.GetJobTokensJob(nil, options...)
+}
+
 // GetJob gets a single job of a project.
 //
 // GitLab API docs:
@@ -305,6 +364,76 @@ func (s *JobsService) DownloadSingleArtifactsFile(pid interface{}, jobID int, ar
 	return bytes.NewReader(artifactBuf.Bytes()), resp, err
 }
 
+// StreamSingleArtifactsFile streams a single file from the artifacts of the
+// given job to the provided io.Writer, without downloading the full archive.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/job_artifacts.html#download-a-single-artifact-file-by-job-id
+func (s *JobsService) StreamSingleArtifactsFile(pid interface{}, jobID int, artifactPath string, w io.Writer, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf(
+		"projects/%s/jobs/%d/artifacts/%s",
+		pathEscape(project),
+		jobID,
+		artifactPath,
+	)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, w)
+}
+
+// DownloadSingleArtifactsFileByTagOrBranch downloads a single file from the
+// artifacts of the latest successful pipeline for the given reference name
+// and job. Only a single file is going to be extracted from the archive and
+// streamed to a client.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/job_artifacts.html#download-a-single-artifact-file-from-specific-tag-or-branch
+func (s *JobsService) DownloadSingleArtifactsFileByTagOrBranch(pid interface{}, refName string, artifactPath string, opt *DownloadArtifactsFileOptions, options ...RequestOptionFunc) (*bytes.Reader, *Response, error) {
+	artifactBuf := new(bytes.Buffer)
+	resp, err := s.StreamSingleArtifactsFileByTagOrBranch(pid, refName, artifactPath, artifactBuf, opt, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return bytes.NewReader(artifactBuf.Bytes()), resp, err
+}
+
+// StreamSingleArtifactsFileByTagOrBranch streams a single file from the
+// artifacts of the latest successful pipeline for the given reference name
+// and job to the provided io.Writer.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/job_artifacts.html#download-a-single-artifact-file-from-specific-tag-or-branch
+func (s *JobsService) StreamSingleArtifactsFileByTagOrBranch(pid interface{}, refName string, artifactPath string, w io.Writer, opt *DownloadArtifactsFileOptions, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf(
+		"projects/%s/jobs/artifacts/%s/raw/%s",
+		pathEscape(project),
+		url.PathEscape(refName),
+		artifactPath,
+	)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, w)
+}
+
 // GetTraceFile gets a trace of a specific job of a project
 //
 // GitLab API docs:
@@ -17,8 +17,11 @@
 package gitlab
 
 import (
+	"bytes"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"os"
 	"reflect"
 	"testing"
 
@@ -179,3 +182,79 @@ func TestJobsService_ListProjectJobs(t *testing.T) {
 		}}
 	assert.Equal(t, want, jobs)
 }
+
+func TestDownloadSingleArtifactsFileByTagOrBranch(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/artifacts/feature/foo/raw/reports/gl-sast-report.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/projects/1/jobs/artifacts/feature%2Ffoo/raw/reports/gl-sast-report.json?job=sast")
+		fmt.Fprint(w, `{"version":"14.0.0"}`)
+	})
+
+	opt := &DownloadArtifactsFileOptions{Job: String("sast")}
+	reader, _, err := client.Jobs.DownloadSingleArtifactsFileByTagOrBranch(1, "feature/foo", "reports/gl-sast-report.json", opt)
+	assert.NoError(t, err)
+
+	data, err := ioutil.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"version":"14.0.0"}`, string(data))
+}
+
+func TestStreamSingleArtifactsFile(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/5/artifacts/coverage/cobertura.xml", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `<coverage/>`)
+	})
+
+	var buf bytes.Buffer
+	_, err := client.Jobs.StreamSingleArtifactsFile(1, 5, "coverage/cobertura.xml", &buf)
+	assert.NoError(t, err)
+	assert.Equal(t, `<coverage/>`, buf.String())
+}
+
+func TestGetCurrentJob(t *testing.T) {
+	mux, server, _ := setup(t)
+	defer teardown(server)
+
+	client, err := NewClient("private-token", WithBaseURL(server.URL))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	mux.HandleFunc("/api/v4/job", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/job")
+		assert.Equal(t, "secret", r.Header.Get("JOB-TOKEN"))
+		assert.Empty(t, r.Header.Get("PRIVATE-TOKEN"))
+		fmt.Fprint(w, `{"id":8,"name":"test","pipeline":{"id":6,"project_id":1,"ref":"main"}}`)
+	})
+
+	defer os.Unsetenv("CI_JOB_TOKEN")
+	os.Setenv("CI_JOB_TOKEN", "secret")
+
+	job, _, err := client.Jobs.GetCurrentJob()
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, 8, job.ID)
+	assert.Equal(t, 6, job.Pipeline.ID)
+
+	jobClient, err := NewJobClient("secret", WithBaseURL(server.URL))
+	if !assert.NoError(t, err) {
+		return
+	}
+	job, _, err = jobClient.Jobs.GetCurrentJob()
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, 8, job.ID)
+
+	os.Unsetenv("CI_JOB_TOKEN")
+	_, _, err = client.Jobs.GetCurrentJob()
+	assert.Error(t, err)
+}
@@ -0,0 +1,130 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+)
+
+// ResponseBodyTooLargeError is returned when a response body exceeds the
+// size configured with WithMaxResponseBodySize.
+type ResponseBodyTooLargeError struct {
+	Limit int64
+}
+
+func (e *ResponseBodyTooLargeError) Error() string {
+	return fmt.Sprintf("response body exceeds the limit of %d bytes", e.Limit)
+}
+
+// DecompressionRatioError is returned when a compressed download exceeds the
+// decompression ratio configured with WithMaxDecompressionRatio.
+type DecompressionRatioError struct {
+	Limit float64
+}
+
+func (e *DecompressionRatioError) Error() string {
+	return fmt.Sprintf("decompressed data exceeds the ratio limit of %v", e.Limit)
+}
+
+// limitedBody is a response body that fails once more than the limit is read.
+type limitedBody struct {
+	r         io.ReadCloser
+	remaining int64
+	limit     int64
+	err       error
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if b.err != nil {
+		return 0, b.err
+	}
+	if int64(len(p)) > b.remaining+1 {
+		p = p[:b.remaining+1]
+	}
+	n, err := b.r.Read(p)
+	if int64(n) > b.remaining {
+		b.err = &ResponseBodyTooLargeError{Limit: b.limit}
+		return int(b.remaining), b.err
+	}
+	b.remaining -= int64(n)
+	return n, err
+}
+
+func (b *limitedBody) Close() error {
+	return b.r.Close()
+}
+
+// minDecompressionRatioCheck is the amount of decompressed data below which
+// the decompression ratio is not checked, as small inputs can have high
+// ratios without being harmful.
+const minDecompressionRatioCheck = 1 << 20
+
+// newGzipReader returns a gzip reader for r that enforces the decompression
+// ratio configured on the client.
+func (c *Client) newGzipReader(r io.Reader) (io.ReadCloser, error) {
+	if c.maxDecompressionRatio <= 0 {
+		return gzip.NewReader(r)
+	}
+
+	cr := &countingReader{r: r}
+	gz, err := gzip.NewReader(cr)
+	if err != nil {
+		return nil, err
+	}
+	return &ratioLimitedReader{gz: gz, compressed: cr, limit: c.maxDecompressionRatio}, nil
+}
+
+// countingReader counts the bytes read from r.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	return n, err
+}
+
+// ratioLimitedReader fails once the ratio between the decompressed and the
+// compressed data exceeds the limit.
+type ratioLimitedReader struct {
+	gz         *gzip.Reader
+	compressed *countingReader
+	limit      float64
+	n          int64
+	err        error
+}
+
+func (r *ratioLimitedReader) Read(p []byte) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+	n, err := r.gz.Read(p)
+	r.n += int64(n)
+	if r.n > minDecompressionRatioCheck && float64(r.n) > r.limit*float64(r.compressed.n) {
+		r.err = &DecompressionRatioError{Limit: r.limit}
+		return 0, r.err
+	}
+	return n, err
+}
+
+func (r *ratioLimitedReader) Close() error {
+	return r.gz.Close()
+}
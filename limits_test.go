@@ -0,0 +1,103 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithMaxResponseBodySize(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	require.NoError(t, WithMaxResponseBodySize(64)(client))
+
+	large := `{"id":1,"description":"` + strings.Repeat("x", 128) + `"}`
+	mux.HandleFunc("/api/v4/projects/1/export", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, large)
+	})
+	mux.HandleFunc("/api/v4/projects/2/export", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, large)
+	})
+	mux.HandleFunc("/api/v4/projects/3/export", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":3}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/export/download", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, large)
+	})
+
+	var tooLarge *ResponseBodyTooLargeError
+
+	_, _, err := client.ProjectImportExport.ExportStatus(1)
+	require.True(t, errors.As(err, &tooLarge), "got %v", err)
+	assert.Equal(t, int64(64), tooLarge.Limit)
+
+	_, _, err = client.ProjectImportExport.ExportStatus(2)
+	assert.True(t, errors.As(err, &tooLarge), "got %v", err)
+
+	es, _, err := client.ProjectImportExport.ExportStatus(3)
+	require.NoError(t, err)
+	assert.Equal(t, 3, es.ID)
+
+	var b bytes.Buffer
+	_, err = client.ProjectImportExport.ExportDownloadStream(1, &b)
+	require.NoError(t, err)
+	assert.Equal(t, large, b.String())
+
+	assert.Error(t, WithMaxResponseBodySize(0)(client))
+}
+
+func TestWithMaxDecompressionRatio(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	require.NoError(t, WithMaxDecompressionRatio(50)(client))
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	fmt.Fprint(gz, strings.Repeat("{\"iid\":1}\n", 1<<20))
+	require.NoError(t, gz.Close())
+
+	mux.HandleFunc("/api/v4/projects/1/export_relations/download", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(buf.Bytes())
+	})
+
+	records := 0
+	opt := &DownloadRelationExportOptions{Relation: String("issues")}
+	_, err := client.ProjectRelationsExport.StreamRelationExport(1, opt, func(json.RawMessage) error {
+		records++
+		return nil
+	})
+
+	var ratioErr *DecompressionRatioError
+	require.True(t, errors.As(err, &ratioErr), "got %v", err)
+	assert.Equal(t, 50.0, ratioErr.Limit)
+	assert.True(t, records < 1<<20)
+
+	assert.Error(t, WithMaxDecompressionRatio(1)(client))
+}
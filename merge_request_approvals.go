@@ -109,6 +109,82 @@ func (s MergeRequestApprovalRule) String() string {
 	return Stringify(s)
 }
 
+// ApprovalsLeft returns the number of approvals still required to satisfy the
+// rule.
+func (s *MergeRequestApprovalRule) ApprovalsLeft() int {
+	if left := s.ApprovalsRequired - len(s.ApprovedBy); left > 0 {
+		return left
+	}
+	return 0
+}
+
+// IsEligibleApprover reports whether the user with the given ID can approve
+// the merge request on behalf of the rule and did not already do so.
+func (s *MergeRequestApprovalRule) IsEligibleApprover(uid int) bool {
+	for _, u := range s.ApprovedBy {
+		if u != nil && u.ID == uid {
+			return false
+		}
+	}
+	for _, u := range s.EligibleApprovers {
+		if u != nil && u.ID == uid {
+			return true
+		}
+	}
+	return false
+}
+
+// ApprovalsLeft returns the total number of approvals still required to
+// satisfy all rules of the approval state. Note that a single approval can
+// count towards multiple rules, so this is an upper bound.
+func (s *MergeRequestApprovalState) ApprovalsLeft() int {
+	left := 0
+	for _, r := range s.Rules {
+		if r != nil && !r.Approved {
+			left += r.ApprovalsLeft()
+		}
+	}
+	return left
+}
+
+// Approved reports whether all rules of the approval state are approved.
+func (s *MergeRequestApprovalState) Approved() bool {
+	for _, r := range s.Rules {
+		if r != nil && !r.Approved && r.ApprovalsLeft() > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// PendingRules returns the rules that still require approvals.
+func (s *MergeRequestApprovalState) PendingRules() []*MergeRequestApprovalRule {
+	var pending []*MergeRequestApprovalRule
+	for _, r := range s.Rules {
+		if r != nil && !r.Approved && r.ApprovalsLeft() > 0 {
+			pending = append(pending, r)
+		}
+	}
+	return pending
+}
+
+// EligibleApprovers returns the users that can still give an approval
+// counting towards at least one of the pending rules, without duplicates.
+func (s *MergeRequestApprovalState) EligibleApprovers() []*BasicUser {
+	var users []*BasicUser
+	seen := make(map[int]bool)
+	for _, r := range s.PendingRules() {
+		for _, u := range r.EligibleApprovers {
+			if u == nil || seen[u.ID] || !r.IsEligibleApprover(u.ID) {
+				continue
+			}
+			seen[u.ID] = true
+			users = append(users, u)
+		}
+	}
+	return users
+}
+
 // MergeRequestApproverUser  represents GitLab project level merge request approver user.
 //
 // GitLab API docs:
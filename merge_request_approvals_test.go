@@ -431,3 +431,53 @@ func TestCreateApprovalRules(t *testing.T) {
 		t.Errorf("MergeRequestApprovals.CreateApprovalRule returned %+v, want %+v", rule, want)
 	}
 }
+
+func TestMergeRequestApprovalStateHelpers(t *testing.T) {
+	alice := &BasicUser{ID: 1, Username: "alice"}
+	bob := &BasicUser{ID: 2, Username: "bob"}
+	carol := &BasicUser{ID: 3, Username: "carol"}
+
+	state := &MergeRequestApprovalState{
+		Rules: []*MergeRequestApprovalRule{
+			{
+				ID:                1,
+				Name:              "backend",
+				ApprovalsRequired: 2,
+				EligibleApprovers: []*BasicUser{alice, bob},
+				ApprovedBy:        []*BasicUser{alice},
+			},
+			{
+				ID:                2,
+				Name:              "security",
+				ApprovalsRequired: 1,
+				EligibleApprovers: []*BasicUser{bob, carol},
+			},
+			{
+				ID:                3,
+				Name:              "docs",
+				ApprovalsRequired: 1,
+				EligibleApprovers: []*BasicUser{carol},
+				ApprovedBy:        []*BasicUser{carol},
+				Approved:          true,
+			},
+		},
+	}
+
+	if got := state.ApprovalsLeft(); got != 2 {
+		t.Errorf("ApprovalsLeft returned %d, want 2", got)
+	}
+	if state.Approved() {
+		t.Error("Approved returned true, want false")
+	}
+	if got := len(state.PendingRules()); got != 2 {
+		t.Errorf("PendingRules returned %d rules, want 2", got)
+	}
+	if state.Rules[0].IsEligibleApprover(alice.ID) {
+		t.Error("IsEligibleApprover returned true for a user that already approved")
+	}
+
+	want := []*BasicUser{bob, carol}
+	if got := state.EligibleApprovers(); !reflect.DeepEqual(got, want) {
+		t.Errorf("EligibleApprovers returned %+v, want %+v", got, want)
+	}
+}
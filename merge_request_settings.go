@@ -0,0 +1,162 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"context"
+	"strconv"
+)
+
+// MergeRequestSettingsPolicy represents the merge request settings enforced
+// on the projects of a group. Settings that are nil are not enforced.
+type MergeRequestSettingsPolicy struct {
+	SquashOption                              *SquashOptionValue
+	RemoveSourceBranchAfterMerge              *bool
+	OnlyAllowMergeIfPipelineSucceeds          *bool
+	OnlyAllowMergeIfAllDiscussionsAreResolved *bool
+}
+
+// CheckGroupMergeRequestSettingsOptions represents the available
+// CheckGroupMergeRequestSettings() options.
+type CheckGroupMergeRequestSettingsOptions struct {
+	// IncludeArchived includes archived projects, which can't be edited
+	// until they are unarchived.
+	IncludeArchived bool
+}
+
+// MergeRequestSettingsDrift represents a project whose merge request
+// settings differ from a MergeRequestSettingsPolicy.
+type MergeRequestSettingsDrift struct {
+	ProjectID         int
+	PathWithNamespace string
+
+	// Changes are the settings that differ from the policy.
+	Changes []*MergeRequestSettingChange
+
+	// Edit holds the options that make the project comply with the policy,
+	// as used by ApplyMergeRequestSettings.
+	Edit *EditProjectOptions
+}
+
+func (d MergeRequestSettingsDrift) String() string {
+	return Stringify(d)
+}
+
+// MergeRequestSettingChange represents a single setting that differs from a
+// MergeRequestSettingsPolicy. Setting is the name of the project attribute
+// in the GitLab API, for example "squash_option".
+type MergeRequestSettingChange struct {
+	Setting string
+	Current string
+	Wanted  string
+}
+
+// CheckGroupMergeRequestSettings compares the merge request settings of all
+// projects of a group and its subgroups with the given policy, and returns
+// the projects that don't comply with it. Projects of other namespaces that
+// are only shared with the group are not included. Nothing is changed, so the drift
+// can be reviewed before applying it with ApplyMergeRequestSettings.
+func (s *GroupsService) CheckGroupMergeRequestSettings(ctx context.Context, gid interface{}, policy *MergeRequestSettingsPolicy, opt *CheckGroupMergeRequestSettingsOptions) ([]*MergeRequestSettingsDrift, error) {
+	listOpt := &ListGroupProjectsOptions{
+		ListOptions:      ListOptions{PerPage: 100},
+		IncludeSubgroups: Bool(true),
+		WithShared:       Bool(false),
+	}
+	if opt == nil || !opt.IncludeArchived {
+		listOpt.Archived = Bool(false)
+	}
+
+	var drift []*MergeRequestSettingsDrift
+	err := ForEachPage(func(options ...RequestOptionFunc) (*Response, error) {
+		ps, resp, err := s.ListGroupProjects(gid, listOpt, options...)
+		if err != nil {
+			return resp, err
+		}
+		for _, p := range ps {
+			if d := policy.drift(p); d != nil {
+				drift = append(drift, d)
+			}
+		}
+		return resp, nil
+	}, WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return drift, nil
+}
+
+// drift returns the drift of p from the policy, or nil if p complies.
+func (policy *MergeRequestSettingsPolicy) drift(p *Project) *MergeRequestSettingsDrift {
+	d := &MergeRequestSettingsDrift{
+		ProjectID:         p.ID,
+		PathWithNamespace: p.PathWithNamespace,
+		Edit:              &EditProjectOptions{},
+	}
+
+	if v := policy.SquashOption; v != nil && p.SquashOption != *v {
+		d.Changes = append(d.Changes, &MergeRequestSettingChange{
+			Setting: "squash_option",
+			Current: string(p.SquashOption),
+			Wanted:  string(*v),
+		})
+		d.Edit.SquashOption = SquashOption(*v)
+	}
+
+	boolSettings := []struct {
+		setting string
+		wanted  *bool
+		current bool
+		edit    **bool
+	}{
+		{"remove_source_branch_after_merge", policy.RemoveSourceBranchAfterMerge, p.RemoveSourceBranchAfterMerge, &d.Edit.RemoveSourceBranchAfterMerge},
+		{"only_allow_merge_if_pipeline_succeeds", policy.OnlyAllowMergeIfPipelineSucceeds, p.OnlyAllowMergeIfPipelineSucceeds, &d.Edit.OnlyAllowMergeIfPipelineSucceeds},
+		{"only_allow_merge_if_all_discussions_are_resolved", policy.OnlyAllowMergeIfAllDiscussionsAreResolved, p.OnlyAllowMergeIfAllDiscussionsAreResolved, &d.Edit.OnlyAllowMergeIfAllDiscussionsAreResolved},
+	}
+	for _, b := range boolSettings {
+		if b.wanted == nil || b.current == *b.wanted {
+			continue
+		}
+		d.Changes = append(d.Changes, &MergeRequestSettingChange{
+			Setting: b.setting,
+			Current: strconv.FormatBool(b.current),
+			Wanted:  strconv.FormatBool(*b.wanted),
+		})
+		*b.edit = Bool(*b.wanted)
+	}
+
+	if len(d.Changes) == 0 {
+		return nil
+	}
+	return d
+}
+
+// ApplyMergeRequestSettings edits the projects of the given drift, as
+// returned by CheckGroupMergeRequestSettings, so they comply with the policy.
+// Only the drifted settings are changed. The projects are edited using
+// ExecuteBulk, so the results are in the same order as the drift.
+func (s *ProjectsService) ApplyMergeRequestSettings(ctx context.Context, drift []*MergeRequestSettingsDrift, opt *BulkOptions) []*BulkResult {
+	ops := make([]BulkOperation, len(drift))
+	for i, d := range drift {
+		d := d
+		ops[i] = func(options ...RequestOptionFunc) (*Response, error) {
+			_, resp, err := s.EditProject(d.ProjectID, d.Edit, options...)
+			return resp, err
+		}
+	}
+	return s.client.ExecuteBulk(ctx, ops, opt)
+}
@@ -0,0 +1,66 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckAndApplyMergeRequestSettings(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/groups/1/projects?archived=false&include_subgroups=true&per_page=100&with_shared=false")
+		fmt.Fprint(w, `[
+			{"id":1,"path_with_namespace":"g/ok","squash_option":"default_on","only_allow_merge_if_pipeline_succeeds":true,"remove_source_branch_after_merge":false},
+			{"id":2,"path_with_namespace":"g/sub/drifted","squash_option":"never","only_allow_merge_if_pipeline_succeeds":false,"remove_source_branch_after_merge":true}
+		]`)
+	})
+
+	policy := &MergeRequestSettingsPolicy{
+		SquashOption:                     SquashOption(SquashOptionDefaultOn),
+		OnlyAllowMergeIfPipelineSucceeds: Bool(true),
+	}
+	drift, err := client.Groups.CheckGroupMergeRequestSettings(context.Background(), 1, policy, nil)
+	require.NoError(t, err)
+
+	require.Len(t, drift, 1)
+	assert.Equal(t, 2, drift[0].ProjectID)
+	assert.Equal(t, "g/sub/drifted", drift[0].PathWithNamespace)
+	assert.Equal(t, []*MergeRequestSettingChange{
+		{Setting: "squash_option", Current: "never", Wanted: "default_on"},
+		{Setting: "only_allow_merge_if_pipeline_succeeds", Current: "false", Wanted: "true"},
+	}, drift[0].Changes)
+
+	mux.HandleFunc("/api/v4/projects/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"only_allow_merge_if_pipeline_succeeds":true,"squash_option":"default_on"}`)
+		fmt.Fprint(w, `{"id":2}`)
+	})
+
+	results := client.Projects.ApplyMergeRequestSettings(context.Background(), drift, nil)
+	require.Len(t, results, 1)
+	assert.NoError(t, results[0].Err)
+}
@@ -17,8 +17,12 @@
 package gitlab
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -35,45 +39,46 @@ type MergeRequestsService struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/merge_requests.html
 type MergeRequest struct {
-	ID                        int          `json:"id"`
-	IID                       int          `json:"iid"`
-	TargetBranch              string       `json:"target_branch"`
-	SourceBranch              string       `json:"source_branch"`
-	ProjectID                 int          `json:"project_id"`
-	Title                     string       `json:"title"`
-	State                     string       `json:"state"`
-	CreatedAt                 *time.Time   `json:"created_at"`
-	UpdatedAt                 *time.Time   `json:"updated_at"`
-	Upvotes                   int          `json:"upvotes"`
-	Downvotes                 int          `json:"downvotes"`
-	Author                    *BasicUser   `json:"author"`
-	Assignee                  *BasicUser   `json:"assignee"`
-	Assignees                 []*BasicUser `json:"assignees"`
-	Reviewers                 []*BasicUser `json:"reviewers"`
-	SourceProjectID           int          `json:"source_project_id"`
-	TargetProjectID           int          `json:"target_project_id"`
-	Labels                    Labels       `json:"labels"`
-	Description               string       `json:"description"`
-	WorkInProgress            bool         `json:"work_in_progress"`
-	Milestone                 *Milestone   `json:"milestone"`
-	MergeWhenPipelineSucceeds bool         `json:"merge_when_pipeline_succeeds"`
-	MergeStatus               string       `json:"merge_status"`
-	MergeError                string       `json:"merge_error"`
-	MergedBy                  *BasicUser   `json:"merged_by"`
-	MergedAt                  *time.Time   `json:"merged_at"`
-	ClosedBy                  *BasicUser   `json:"closed_by"`
-	ClosedAt                  *time.Time   `json:"closed_at"`
-	Subscribed                bool         `json:"subscribed"`
-	SHA                       string       `json:"sha"`
-	MergeCommitSHA            string       `json:"merge_commit_sha"`
-	SquashCommitSHA           string       `json:"squash_commit_sha"`
-	UserNotesCount            int          `json:"user_notes_count"`
-	ChangesCount              string       `json:"changes_count"`
-	ShouldRemoveSourceBranch  bool         `json:"should_remove_source_branch"`
-	ForceRemoveSourceBranch   bool         `json:"force_remove_source_branch"`
-	AllowCollaboration        bool         `json:"allow_collaboration"`
-	WebURL                    string       `json:"web_url"`
-	DiscussionLocked          bool         `json:"discussion_locked"`
+	ID                        int                      `json:"id"`
+	IID                       int                      `json:"iid"`
+	TargetBranch              string                   `json:"target_branch"`
+	SourceBranch              string                   `json:"source_branch"`
+	ProjectID                 int                      `json:"project_id"`
+	Title                     string                   `json:"title"`
+	State                     string                   `json:"state"`
+	CreatedAt                 *time.Time               `json:"created_at"`
+	UpdatedAt                 *time.Time               `json:"updated_at"`
+	Upvotes                   int                      `json:"upvotes"`
+	Downvotes                 int                      `json:"downvotes"`
+	Author                    *BasicUser               `json:"author"`
+	Assignee                  *BasicUser               `json:"assignee"`
+	Assignees                 []*BasicUser             `json:"assignees"`
+	Reviewers                 []*BasicUser             `json:"reviewers"`
+	SourceProjectID           int                      `json:"source_project_id"`
+	TargetProjectID           int                      `json:"target_project_id"`
+	Labels                    Labels                   `json:"labels"`
+	Description               string                   `json:"description"`
+	WorkInProgress            bool                     `json:"work_in_progress"`
+	Milestone                 *Milestone               `json:"milestone"`
+	MergeWhenPipelineSucceeds bool                     `json:"merge_when_pipeline_succeeds"`
+	MergeStatus               string                   `json:"merge_status"`
+	DetailedMergeStatus       DetailedMergeStatusValue `json:"detailed_merge_status"`
+	MergeError                string                   `json:"merge_error"`
+	MergedBy                  *BasicUser               `json:"merged_by"`
+	MergedAt                  *time.Time               `json:"merged_at"`
+	ClosedBy                  *BasicUser               `json:"closed_by"`
+	ClosedAt                  *time.Time               `json:"closed_at"`
+	Subscribed                bool                     `json:"subscribed"`
+	SHA                       string                   `json:"sha"`
+	MergeCommitSHA            string                   `json:"merge_commit_sha"`
+	SquashCommitSHA           string                   `json:"squash_commit_sha"`
+	UserNotesCount            int                      `json:"user_notes_count"`
+	ChangesCount              string                   `json:"changes_count"`
+	ShouldRemoveSourceBranch  bool                     `json:"should_remove_source_branch"`
+	ForceRemoveSourceBranch   bool                     `json:"force_remove_source_branch"`
+	AllowCollaboration        bool                     `json:"allow_collaboration"`
+	WebURL                    string                   `json:"web_url"`
+	DiscussionLocked          bool                     `json:"discussion_locked"`
 	Changes                   []struct {
 		OldPath     string `json:"old_path"`
 		NewPath     string `json:"new_path"`
@@ -134,6 +139,28 @@ func (m MergeRequestDiffVersion) String() string {
 	return Stringify(m)
 }
 
+// MergeRequestOrderByValue represents a column merge requests can be ordered by.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/merge_requests.html#list-merge-requests
+type MergeRequestOrderByValue string
+
+// These constants represent all valid columns to order merge requests by.
+const (
+	MergeRequestOrderByCreatedAt MergeRequestOrderByValue = "created_at"
+	MergeRequestOrderByUpdatedAt MergeRequestOrderByValue = "updated_at"
+	MergeRequestOrderByMergedAt  MergeRequestOrderByValue = "merged_at"
+	MergeRequestOrderByTitle     MergeRequestOrderByValue = "title"
+)
+
+// MergeRequestOrderBy is a helper routine that allocates a new MergeRequestOrderByValue
+// to store v and returns a pointer to it.
+func MergeRequestOrderBy(v MergeRequestOrderByValue) *MergeRequestOrderByValue {
+	p := new(MergeRequestOrderByValue)
+	*p = v
+	return p
+}
+
 // ListMergeRequestsOptions represents the available ListMergeRequests()
 // options.
 //
@@ -141,30 +168,30 @@ func (m MergeRequestDiffVersion) String() string {
 // https://docs.gitlab.com/ce/api/merge_requests.html#list-merge-requests
 type ListMergeRequestsOptions struct {
 	ListOptions
-	State                  *string    `url:"state,omitempty" json:"state,omitempty"`
-	OrderBy                *string    `url:"order_by,omitempty" json:"order_by,omitempty"`
-	Sort                   *string    `url:"sort,omitempty" json:"sort,omitempty"`
-	Milestone              *string    `url:"milestone,omitempty" json:"milestone,omitempty"`
-	View                   *string    `url:"view,omitempty" json:"view,omitempty"`
-	Labels                 Labels     `url:"labels,comma,omitempty" json:"labels,omitempty"`
-	NotLabels              Labels     `url:"not[labels],comma,omitempty" json:"not[labels],omitempty"`
-	WithLabelsDetails      *bool      `url:"with_labels_details,omitempty" json:"with_labels_details,omitempty"`
-	WithMergeStatusRecheck *bool      `url:"with_merge_status_recheck,omitempty" json:"with_merge_status_recheck,omitempty"`
-	CreatedAfter           *time.Time `url:"created_after,omitempty" json:"created_after,omitempty"`
-	CreatedBefore          *time.Time `url:"created_before,omitempty" json:"created_before,omitempty"`
-	UpdatedAfter           *time.Time `url:"updated_after,omitempty" json:"updated_after,omitempty"`
-	UpdatedBefore          *time.Time `url:"updated_before,omitempty" json:"updated_before,omitempty"`
-	Scope                  *string    `url:"scope,omitempty" json:"scope,omitempty"`
-	AuthorID               *int       `url:"author_id,omitempty" json:"author_id,omitempty"`
-	AssigneeID             *int       `url:"assignee_id,omitempty" json:"assignee_id,omitempty"`
-	ReviewerID             *int       `url:"reviewer_id,omitempty" json:"reviewer_id,omitempty"`
-	ReviewerUsername       *string    `url:"reviewer_username,omitempty" json:"reviewer_username,omitempty"`
-	MyReactionEmoji        *string    `url:"my_reaction_emoji,omitempty" json:"my_reaction_emoji,omitempty"`
-	SourceBranch           *string    `url:"source_branch,omitempty" json:"source_branch,omitempty"`
-	TargetBranch           *string    `url:"target_branch,omitempty" json:"target_branch,omitempty"`
-	Search                 *string    `url:"search,omitempty" json:"search,omitempty"`
-	In                     *string    `url:"in,omitempty" json:"in,omitempty"`
-	WIP                    *string    `url:"wip,omitempty" json:"wip,omitempty"`
+	State                  *string                   `url:"state,omitempty" json:"state,omitempty"`
+	OrderBy                *MergeRequestOrderByValue `url:"order_by,omitempty" json:"order_by,omitempty"`
+	Sort                   *SortValue                `url:"sort,omitempty" json:"sort,omitempty"`
+	Milestone              *string                   `url:"milestone,omitempty" json:"milestone,omitempty"`
+	View                   *string                   `url:"view,omitempty" json:"view,omitempty"`
+	Labels                 Labels                    `url:"labels,comma,omitempty" json:"labels,omitempty"`
+	NotLabels              Labels                    `url:"not[labels],comma,omitempty" json:"not[labels],omitempty"`
+	WithLabelsDetails      *bool                     `url:"with_labels_details,omitempty" json:"with_labels_details,omitempty"`
+	WithMergeStatusRecheck *bool                     `url:"with_merge_status_recheck,omitempty" json:"with_merge_status_recheck,omitempty"`
+	CreatedAfter           *time.Time                `url:"created_after,omitempty" json:"created_after,omitempty"`
+	CreatedBefore          *time.Time                `url:"created_before,omitempty" json:"created_before,omitempty"`
+	UpdatedAfter           *time.Time                `url:"updated_after,omitempty" json:"updated_after,omitempty"`
+	UpdatedBefore          *time.Time                `url:"updated_before,omitempty" json:"updated_before,omitempty"`
+	Scope                  *string                   `url:"scope,omitempty" json:"scope,omitempty"`
+	AuthorID               *int                      `url:"author_id,omitempty" json:"author_id,omitempty"`
+	AssigneeID             *int                      `url:"assignee_id,omitempty" json:"assignee_id,omitempty"`
+	ReviewerID             *int                      `url:"reviewer_id,omitempty" json:"reviewer_id,omitempty"`
+	ReviewerUsername       *string                   `url:"reviewer_username,omitempty" json:"reviewer_username,omitempty"`
+	MyReactionEmoji        *string                   `url:"my_reaction_emoji,omitempty" json:"my_reaction_emoji,omitempty"`
+	SourceBranch           *string                   `url:"source_branch,omitempty" json:"source_branch,omitempty"`
+	TargetBranch           *string                   `url:"target_branch,omitempty" json:"target_branch,omitempty"`
+	Search                 *string                   `url:"search,omitempty" json:"search,omitempty"`
+	In                     *string                   `url:"in,omitempty" json:"in,omitempty"`
+	WIP                    *string                   `url:"wip,omitempty" json:"wip,omitempty"`
 }
 
 // ListMergeRequests gets all merge requests. The state parameter can be used
@@ -196,30 +223,30 @@ func (s *MergeRequestsService) ListMergeRequests(opt *ListMergeRequestsOptions,
 // https://docs.gitlab.com/ce/api/merge_requests.html#list-group-merge-requests
 type ListGroupMergeRequestsOptions struct {
 	ListOptions
-	State                  *string    `url:"state,omitempty" json:"state,omitempty"`
-	OrderBy                *string    `url:"order_by,omitempty" json:"order_by,omitempty"`
-	Sort                   *string    `url:"sort,omitempty" json:"sort,omitempty"`
-	Milestone              *string    `url:"milestone,omitempty" json:"milestone,omitempty"`
-	View                   *string    `url:"view,omitempty" json:"view,omitempty"`
-	Labels                 Labels     `url:"labels,comma,omitempty" json:"labels,omitempty"`
-	NotLabels              Labels     `url:"not[labels],comma,omitempty" json:"not[labels],omitempty"`
-	WithLabelsDetails      *bool      `url:"with_labels_details,omitempty" json:"with_labels_details,omitempty"`
-	WithMergeStatusRecheck *bool      `url:"with_merge_status_recheck,omitempty" json:"with_merge_status_recheck,omitempty"`
-	CreatedAfter           *time.Time `url:"created_after,omitempty" json:"created_after,omitempty"`
-	CreatedBefore          *time.Time `url:"created_before,omitempty" json:"created_before,omitempty"`
-	UpdatedAfter           *time.Time `url:"updated_after,omitempty" json:"updated_after,omitempty"`
-	UpdatedBefore          *time.Time `url:"updated_before,omitempty" json:"updated_before,omitempty"`
-	Scope                  *string    `url:"scope,omitempty" json:"scope,omitempty"`
-	AuthorID               *int       `url:"author_id,omitempty" json:"author_id,omitempty"`
-	AssigneeID             *int       `url:"assignee_id,omitempty" json:"assignee_id,omitempty"`
-	ReviewerID             *int       `url:"reviewer_id,omitempty" json:"reviewer_id,omitempty"`
-	ReviewerUsername       *string    `url:"reviewer_username,omitempty" json:"reviewer_username,omitempty"`
-	MyReactionEmoji        *string    `url:"my_reaction_emoji,omitempty" json:"my_reaction_emoji,omitempty"`
-	SourceBranch           *string    `url:"source_branch,omitempty" json:"source_branch,omitempty"`
-	TargetBranch           *string    `url:"target_branch,omitempty" json:"target_branch,omitempty"`
-	Search                 *string    `url:"search,omitempty" json:"search,omitempty"`
-	In                     *string    `url:"in,omitempty" json:"in,omitempty"`
-	WIP                    *string    `url:"wip,omitempty" json:"wip,omitempty"`
+	State                  *string                   `url:"state,omitempty" json:"state,omitempty"`
+	OrderBy                *MergeRequestOrderByValue `url:"order_by,omitempty" json:"order_by,omitempty"`
+	Sort                   *SortValue                `url:"sort,omitempty" json:"sort,omitempty"`
+	Milestone              *string                   `url:"milestone,omitempty" json:"milestone,omitempty"`
+	View                   *string                   `url:"view,omitempty" json:"view,omitempty"`
+	Labels                 Labels                    `url:"labels,comma,omitempty" json:"labels,omitempty"`
+	NotLabels              Labels                    `url:"not[labels],comma,omitempty" json:"not[labels],omitempty"`
+	WithLabelsDetails      *bool                     `url:"with_labels_details,omitempty" json:"with_labels_details,omitempty"`
+	WithMergeStatusRecheck *bool                     `url:"with_merge_status_recheck,omitempty" json:"with_merge_status_recheck,omitempty"`
+	CreatedAfter           *time.Time                `url:"created_after,omitempty" json:"created_after,omitempty"`
+	CreatedBefore          *time.Time                `url:"created_before,omitempty" json:"created_before,omitempty"`
+	UpdatedAfter           *time.Time                `url:"updated_after,omitempty" json:"updated_after,omitempty"`
+	UpdatedBefore          *time.Time                `url:"updated_before,omitempty" json:"updated_before,omitempty"`
+	Scope                  *string                   `url:"scope,omitempty" json:"scope,omitempty"`
+	AuthorID               *int                      `url:"author_id,omitempty" json:"author_id,omitempty"`
+	AssigneeID             *int                      `url:"assignee_id,omitempty" json:"assignee_id,omitempty"`
+	ReviewerID             *int                      `url:"reviewer_id,omitempty" json:"reviewer_id,omitempty"`
+	ReviewerUsername       *string                   `url:"reviewer_username,omitempty" json:"reviewer_username,omitempty"`
+	MyReactionEmoji        *string                   `url:"my_reaction_emoji,omitempty" json:"my_reaction_emoji,omitempty"`
+	SourceBranch           *string                   `url:"source_branch,omitempty" json:"source_branch,omitempty"`
+	TargetBranch           *string                   `url:"target_branch,omitempty" json:"target_branch,omitempty"`
+	Search                 *string                   `url:"search,omitempty" json:"search,omitempty"`
+	In                     *string                   `url:"in,omitempty" json:"in,omitempty"`
+	WIP                    *string                   `url:"wip,omitempty" json:"wip,omitempty"`
 }
 
 // ListGroupMergeRequests gets all merge requests for this group.
@@ -254,30 +281,30 @@ func (s *MergeRequestsService) ListGroupMergeRequests(gid interface{}, opt *List
 // https://docs.gitlab.com/ce/api/merge_requests.html#list-project-merge-requests
 type ListProjectMergeRequestsOptions struct {
 	ListOptions
-	IIDs                   []int      `url:"iids[],omitempty" json:"iids,omitempty"`
-	State                  *string    `url:"state,omitempty" json:"state,omitempty"`
-	OrderBy                *string    `url:"order_by,omitempty" json:"order_by,omitempty"`
-	Sort                   *string    `url:"sort,omitempty" json:"sort,omitempty"`
-	Milestone              *string    `url:"milestone,omitempty" json:"milestone,omitempty"`
-	View                   *string    `url:"view,omitempty" json:"view,omitempty"`
-	Labels                 Labels     `url:"labels,comma,omitempty" json:"labels,omitempty"`
-	NotLabels              Labels     `url:"not[labels],comma,omitempty" json:"not[labels],omitempty"`
-	WithLabelsDetails      *bool      `url:"with_labels_details,omitempty" json:"with_labels_details,omitempty"`
-	WithMergeStatusRecheck *bool      `url:"with_merge_status_recheck,omitempty" json:"with_merge_status_recheck,omitempty"`
-	CreatedAfter           *time.Time `url:"created_after,omitempty" json:"created_after,omitempty"`
-	CreatedBefore          *time.Time `url:"created_before,omitempty" json:"created_before,omitempty"`
-	UpdatedAfter           *time.Time `url:"updated_after,omitempty" json:"updated_after,omitempty"`
-	UpdatedBefore          *time.Time `url:"updated_before,omitempty" json:"updated_before,omitempty"`
-	Scope                  *string    `url:"scope,omitempty" json:"scope,omitempty"`
-	AuthorID               *int       `url:"author_id,omitempty" json:"author_id,omitempty"`
-	AssigneeID             *int       `url:"assignee_id,omitempty" json:"assignee_id,omitempty"`
-	ReviewerID             *int       `url:"reviewer_id,omitempty" json:"reviewer_id,omitempty"`
-	ReviewerUsername       *string    `url:"reviewer_username,omitempty" json:"reviewer_username,omitempty"`
-	MyReactionEmoji        *string    `url:"my_reaction_emoji,omitempty" json:"my_reaction_emoji,omitempty"`
-	SourceBranch           *string    `url:"source_branch,omitempty" json:"source_branch,omitempty"`
-	TargetBranch           *string    `url:"target_branch,omitempty" json:"target_branch,omitempty"`
-	Search                 *string    `url:"search,omitempty" json:"search,omitempty"`
-	WIP                    *string    `url:"wip,omitempty" json:"wip,omitempty"`
+	IIDs                   []int                     `url:"iids[],omitempty" json:"iids,omitempty"`
+	State                  *string                   `url:"state,omitempty" json:"state,omitempty"`
+	OrderBy                *MergeRequestOrderByValue `url:"order_by,omitempty" json:"order_by,omitempty"`
+	Sort                   *SortValue                `url:"sort,omitempty" json:"sort,omitempty"`
+	Milestone              *string                   `url:"milestone,omitempty" json:"milestone,omitempty"`
+	View                   *string                   `url:"view,omitempty" json:"view,omitempty"`
+	Labels                 Labels                    `url:"labels,comma,omitempty" json:"labels,omitempty"`
+	NotLabels              Labels                    `url:"not[labels],comma,omitempty" json:"not[labels],omitempty"`
+	WithLabelsDetails      *bool                     `url:"with_labels_details,omitempty" json:"with_labels_details,omitempty"`
+	WithMergeStatusRecheck *bool                     `url:"with_merge_status_recheck,omitempty" json:"with_merge_status_recheck,omitempty"`
+	CreatedAfter           *time.Time                `url:"created_after,omitempty" json:"created_after,omitempty"`
+	CreatedBefore          *time.Time                `url:"created_before,omitempty" json:"created_before,omitempty"`
+	UpdatedAfter           *time.Time                `url:"updated_after,omitempty" json:"updated_after,omitempty"`
+	UpdatedBefore          *time.Time                `url:"updated_before,omitempty" json:"updated_before,omitempty"`
+	Scope                  *string                   `url:"scope,omitempty" json:"scope,omitempty"`
+	AuthorID               *int                      `url:"author_id,omitempty" json:"author_id,omitempty"`
+	AssigneeID             *int                      `url:"assignee_id,omitempty" json:"assignee_id,omitempty"`
+	ReviewerID             *int                      `url:"reviewer_id,omitempty" json:"reviewer_id,omitempty"`
+	ReviewerUsername       *string                   `url:"reviewer_username,omitempty" json:"reviewer_username,omitempty"`
+	MyReactionEmoji        *string                   `url:"my_reaction_emoji,omitempty" json:"my_reaction_emoji,omitempty"`
+	SourceBranch           *string                   `url:"source_branch,omitempty" json:"source_branch,omitempty"`
+	TargetBranch           *string                   `url:"target_branch,omitempty" json:"target_branch,omitempty"`
+	Search                 *string                   `url:"search,omitempty" json:"search,omitempty"`
+	WIP                    *string                   `url:"wip,omitempty" json:"wip,omitempty"`
 }
 
 // ListProjectMergeRequests gets all merge requests for this project.
@@ -341,6 +368,89 @@ func (s *MergeRequestsService) GetMergeRequest(pid interface{}, mergeRequest int
 	return m, resp, err
 }
 
+// MergeRequestMergeRef represents the merge ref of a merge request, which
+// points to the result of merging the source branch into the target branch.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#merge-to-default-merge-ref-path
+type MergeRequestMergeRef struct {
+	CommitID string `json:"commit_id"`
+}
+
+func (m MergeRequestMergeRef) String() string {
+	return Stringify(m)
+}
+
+// GetMergeRequestMergeRef merges the changes between the source and target
+// branch of a merge request into its merge ref (refs/merge-requests/:iid/merge)
+// and returns the resulting commit. This fails with a 400 Bad Request if the
+// merge request has conflicts, so it can be used to validate a merge without
+// changing the target branch.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#merge-to-default-merge-ref-path
+func (s *MergeRequestsService) GetMergeRequestMergeRef(pid interface{}, mergeRequest int, options ...RequestOptionFunc) (*MergeRequestMergeRef, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/merge_requests/%d/merge_ref", pathEscape(project), mergeRequest)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m := new(MergeRequestMergeRef)
+	resp, err := s.client.Do(req, m)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return m, resp, err
+}
+
+// MergeRequestMergeStatus represents the fields of a merge request that
+// describe whether it can be merged.
+type MergeRequestMergeStatus struct {
+	DetailedMergeStatus         DetailedMergeStatusValue
+	HasConflicts                bool
+	BlockingDiscussionsResolved bool
+	RebaseInProgress            bool
+	DivergedCommitsCount        int
+}
+
+func (m MergeRequestMergeStatus) String() string {
+	return Stringify(m)
+}
+
+// GetMergeRequestMergeStatus gets the merge status of a merge request,
+// including whether it has conflicts and how far its source branch is behind
+// the target branch. If GitLab is still checking the merge status, the
+// DetailedMergeStatus is pending and the status should be requested again.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#merge-status
+func (s *MergeRequestsService) GetMergeRequestMergeStatus(pid interface{}, mergeRequest int, options ...RequestOptionFunc) (*MergeRequestMergeStatus, *Response, error) {
+	opt := &GetMergeRequestsOptions{
+		IncludeDivergedCommitsCount: Bool(true),
+		IncludeRebaseInProgress:     Bool(true),
+	}
+
+	m, resp, err := s.GetMergeRequest(pid, mergeRequest, opt, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &MergeRequestMergeStatus{
+		DetailedMergeStatus:         m.DetailedMergeStatus,
+		HasConflicts:                m.HasConflicts,
+		BlockingDiscussionsResolved: m.BlockingDiscussionsResolved,
+		RebaseInProgress:            m.RebaseInProgress,
+		DivergedCommitsCount:        m.DivergedCommitsCount,
+	}, resp, nil
+}
+
 // GetMergeRequestApprovals gets information about a merge requests approvals
 //
 // GitLab API docs:
@@ -458,6 +568,142 @@ func (s *MergeRequestsService) GetMergeRequestParticipants(pid interface{}, merg
 	return ps, resp, err
 }
 
+// MergeRequestReviewer represents a GitLab merge request reviewer.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#get-single-mr-reviewers
+type MergeRequestReviewer struct {
+	User      *BasicUser `json:"user"`
+	State     string     `json:"state"`
+	CreatedAt *time.Time `json:"created_at"`
+}
+
+// GetMergeRequestReviewers gets a list of merge request reviewers, including
+// the review state of each reviewer.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#get-single-mr-reviewers
+func (s *MergeRequestsService) GetMergeRequestReviewers(pid interface{}, mergeRequest int, options ...RequestOptionFunc) ([]*MergeRequestReviewer, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/merge_requests/%d/reviewers", pathEscape(project), mergeRequest)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rs []*MergeRequestReviewer
+	resp, err := s.client.Do(req, &rs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return rs, resp, err
+}
+
+// ReviewerEventActionValue represents the action of a reviewer event.
+type ReviewerEventActionValue string
+
+// These constants represent all valid reviewer event actions.
+const (
+	// ReviewerEventRequested means a review was requested from the users.
+	ReviewerEventRequested ReviewerEventActionValue = "requested"
+
+	// ReviewerEventRemoved means the review request for the users was removed.
+	ReviewerEventRemoved ReviewerEventActionValue = "removed"
+
+	// ReviewerEventUnparsed means a system note mentions a review, but could
+	// not be parsed, so the reviewer history is incomplete.
+	ReviewerEventUnparsed ReviewerEventActionValue = "unparsed"
+)
+
+// MergeRequestReviewerEvent represents a change of the reviewers of a merge
+// request, reconstructed from the system notes of the merge request. Body
+// holds the text of the system note the event was reconstructed from.
+type MergeRequestReviewerEvent struct {
+	NoteID    int
+	Action    ReviewerEventActionValue
+	Usernames []string
+	Author    string
+	Body      string
+	CreatedAt *time.Time
+}
+
+var (
+	reviewerClauseRE   = regexp.MustCompile(`(requested review from|removed review request for) ((?:@[\w.\-]+(?:,? and |, )?)+)`)
+	reviewerUsernameRE = regexp.MustCompile(`@([\w.\-]+)`)
+)
+
+// ListMergeRequestReviewerEvents reconstructs the reviewer assignment
+// history of a merge request, oldest first. Each event has either the
+// ReviewerEventRequested or the ReviewerEventRemoved action.
+//
+// GitLab does not provide resource events for reviewer changes, so this is a
+// best-effort reconstruction that parses the text of the system notes. System
+// notes that mention a review but are not recognized, for example because
+// their wording changed in a newer GitLab version, are returned as events
+// with the ReviewerEventUnparsed action and without usernames, so callers can
+// detect that the history is incomplete.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/notes.html#list-all-merge-request-notes
+func (s *MergeRequestsService) ListMergeRequestReviewerEvents(pid interface{}, mergeRequest int, options ...RequestOptionFunc) ([]*MergeRequestReviewerEvent, error) {
+	opt := &ListMergeRequestNotesOptions{
+		ListOptions: ListOptions{PerPage: 100},
+		OrderBy:     NoteOrderBy(NoteOrderByCreatedAt),
+		Sort:        Sort(SortAsc),
+	}
+
+	var events []*MergeRequestReviewerEvent
+	for {
+		notes, resp, err := s.client.Notes.ListMergeRequestNotes(pid, mergeRequest, opt, options...)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range notes {
+			if !n.System {
+				continue
+			}
+			matches := reviewerClauseRE.FindAllStringSubmatch(n.Body, -1)
+			if len(matches) == 0 && strings.Contains(strings.ToLower(n.Body), "review") {
+				events = append(events, &MergeRequestReviewerEvent{
+					NoteID:    n.ID,
+					Action:    ReviewerEventUnparsed,
+					Author:    n.Author.Username,
+					Body:      n.Body,
+					CreatedAt: n.CreatedAt,
+				})
+				continue
+			}
+			for _, m := range matches {
+				e := &MergeRequestReviewerEvent{
+					NoteID:    n.ID,
+					Action:    ReviewerEventRequested,
+					Author:    n.Author.Username,
+					Body:      n.Body,
+					CreatedAt: n.CreatedAt,
+				}
+				if m[1] == "removed review request for" {
+					e.Action = ReviewerEventRemoved
+				}
+				for _, u := range reviewerUsernameRE.FindAllStringSubmatch(m[2], -1) {
+					e.Usernames = append(e.Usernames, u[1])
+				}
+				events = append(events, e)
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	return events, nil
+}
+
 // ListMergeRequestPipelines gets all pipelines for the provided merge request.
 //
 // GitLab API docs:
@@ -508,6 +754,140 @@ func (s *MergeRequestsService) CreateMergeRequestPipeline(pid interface{}, merge
 	return p, resp, err
 }
 
+// CodeQualityDegradation represents a single code quality finding reported
+// by the code quality job of a merge request pipeline.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#codequalityreportscomparerreportdegradation
+type CodeQualityDegradation struct {
+	Description string                   `json:"description"`
+	Fingerprint string                   `json:"fingerprint"`
+	Severity    CodeQualitySeverityValue `json:"severity"`
+	FilePath    string                   `json:"filePath"`
+	Line        int                      `json:"line"`
+	WebURL      string                   `json:"webUrl"`
+	EngineName  string                   `json:"engineName"`
+}
+
+func (d CodeQualityDegradation) String() string {
+	return Stringify(d)
+}
+
+// CodeQualityReportsComparer represents the comparison of the code quality
+// reports of the head and base pipeline of a merge request. The findings are
+// only set once Status is CodeQualityReportParsed.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#codequalityreportscomparer
+type CodeQualityReportsComparer struct {
+	Status         CodeQualityReportGenerationStatusValue
+	ReportStatus   CodeQualityReportStatusValue
+	NewErrors      []*CodeQualityDegradation
+	ResolvedErrors []*CodeQualityDegradation
+	ExistingErrors []*CodeQualityDegradation
+	Summary        struct {
+		Total    int `json:"total"`
+		Resolved int `json:"resolved"`
+		Errored  int `json:"errored"`
+	}
+}
+
+func (c CodeQualityReportsComparer) String() string {
+	return Stringify(c)
+}
+
+const codeQualityDegradationFields = `
+            description
+            fingerprint
+            severity
+            filePath
+            line
+            webUrl
+            engineName`
+
+// GetMergeRequestCodeQualityReports gets the comparison of the code quality
+// reports between the head and base pipeline of a merge request. The
+// comparison is only exposed by the GraphQL API, so the project is identified
+// by its full path.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mergerequestcodequalityreportscomparer
+func (s *MergeRequestsService) GetMergeRequestCodeQualityReports(projectPath string, mergeRequest int, options ...RequestOptionFunc) (*CodeQualityReportsComparer, *Response, error) {
+	query := `
+query($fullPath: ID!, $iid: String!) {
+  project(fullPath: $fullPath) {
+    mergeRequest(iid: $iid) {
+      codequalityReportsComparer {
+        status
+        report {
+          status
+          newErrors {` + codeQualityDegradationFields + `
+          }
+          resolvedErrors {` + codeQualityDegradationFields + `
+          }
+          existingErrors {` + codeQualityDegradationFields + `
+          }
+          summary {
+            total
+            resolved
+            errored
+          }
+        }
+      }
+    }
+  }
+}`
+
+	var data struct {
+		Project *struct {
+			MergeRequest *struct {
+				Comparer *struct {
+					Status CodeQualityReportGenerationStatusValue `json:"status"`
+					Report *struct {
+						Status         CodeQualityReportStatusValue `json:"status"`
+						NewErrors      []*CodeQualityDegradation    `json:"newErrors"`
+						ResolvedErrors []*CodeQualityDegradation    `json:"resolvedErrors"`
+						ExistingErrors []*CodeQualityDegradation    `json:"existingErrors"`
+						Summary        struct {
+							Total    int `json:"total"`
+							Resolved int `json:"resolved"`
+							Errored  int `json:"errored"`
+						} `json:"summary"`
+					} `json:"report"`
+				} `json:"codequalityReportsComparer"`
+			} `json:"mergeRequest"`
+		} `json:"project"`
+	}
+	variables := map[string]interface{}{
+		"fullPath": projectPath,
+		"iid":      strconv.Itoa(mergeRequest),
+	}
+	resp, err := s.client.doGraphQL(query, variables, &data, options)
+	if err != nil {
+		return nil, resp, err
+	}
+	if data.Project == nil {
+		return nil, resp, fmt.Errorf("project %q not found", projectPath)
+	}
+	if data.Project.MergeRequest == nil {
+		return nil, resp, fmt.Errorf("merge request %d not found", mergeRequest)
+	}
+
+	c := new(CodeQualityReportsComparer)
+	if comparer := data.Project.MergeRequest.Comparer; comparer != nil {
+		c.Status = comparer.Status
+		if r := comparer.Report; r != nil {
+			c.ReportStatus = r.Status
+			c.NewErrors = r.NewErrors
+			c.ResolvedErrors = r.ResolvedErrors
+			c.ExistingErrors = r.ExistingErrors
+			c.Summary = r.Summary
+		}
+	}
+
+	return c, resp, nil
+}
+
 // GetIssuesClosedOnMergeOptions represents the available GetIssuesClosedOnMerge()
 // options.
 //
@@ -541,6 +921,40 @@ func (s *MergeRequestsService) GetIssuesClosedOnMerge(pid interface{}, mergeRequ
 	return i, resp, err
 }
 
+// ListRelatedIssuesOptions represents the available ListRelatedIssues()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#list-issues-related-to-the-merge-request
+type ListRelatedIssuesOptions ListOptions
+
+// ListRelatedIssues gets all the issues that are related to (mentioned in)
+// the provided merge request, including the issues that would be closed by
+// merging it.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#list-issues-related-to-the-merge-request
+func (s *MergeRequestsService) ListRelatedIssues(pid interface{}, mergeRequest int, opt *ListRelatedIssuesOptions, options ...RequestOptionFunc) ([]*Issue, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/merge_requests/%d/related_issues", pathEscape(project), mergeRequest)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var i []*Issue
+	resp, err := s.client.Do(req, &i)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return i, resp, err
+}
+
 // CreateMergeRequestOptions represents the available CreateMergeRequest()
 // options.
 //
@@ -858,6 +1272,38 @@ func (s *MergeRequestsService) UnsubscribeFromMergeRequest(pid interface{}, merg
 	return m, resp, err
 }
 
+// UnsubscribeFromMergeRequests unsubscribes the authenticated user from all
+// merge requests matching the given list options, to stop notifications about
+// them in one go. The matching merge requests are returned together with the
+// result of the unsubscribe call for every merge request, in the same order.
+// The response of merge requests the user wasn't subscribed to has status
+// code 304.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/merge_requests.html#unsubscribe-from-a-merge-request
+func (s *MergeRequestsService) UnsubscribeFromMergeRequests(ctx context.Context, opt *ListMergeRequestsOptions, bopt *BulkOptions) ([]*MergeRequest, []*BulkResult, error) {
+	var mrs []*MergeRequest
+	err := ForEachPage(func(options ...RequestOptionFunc) (*Response, error) {
+		ms, resp, err := s.ListMergeRequests(opt, options...)
+		mrs = append(mrs, ms...)
+		return resp, err
+	}, WithContext(ctx))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ops := make([]BulkOperation, len(mrs))
+	for i, mr := range mrs {
+		mr := mr
+		ops[i] = func(options ...RequestOptionFunc) (*Response, error) {
+			_, resp, err := s.UnsubscribeFromMergeRequest(mr.ProjectID, mr.IID, options...)
+			return ignoreNotModified(resp, err)
+		}
+	}
+
+	return mrs, s.client.ExecuteBulk(ctx, ops, bopt), nil
+}
+
 // CreateTodo manually creates a todo for the current user on a merge request.
 // If there already exists a todo for the user on that merge request,
 // status code 304 is returned.
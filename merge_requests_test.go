@@ -17,6 +17,7 @@
 package gitlab
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
@@ -229,6 +230,97 @@ func TestGetMergeRequestParticipants(t *testing.T) {
 	}
 }
 
+func TestGetMergeRequestReviewers(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5/reviewers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"user":{"id":1,"name":"John Doe1","username":"user1","state":"active"},"state":"unreviewed","created_at":"2022-07-27T17:03:27.684Z"}]`)
+	})
+
+	reviewers, _, err := client.MergeRequests.GetMergeRequestReviewers("1", 5)
+	require.NoError(t, err)
+
+	createdAt := time.Date(2022, 7, 27, 17, 3, 27, 684000000, time.UTC)
+	want := []*MergeRequestReviewer{{
+		User:      &BasicUser{ID: 1, Name: "John Doe1", Username: "user1", State: "active"},
+		State:     "unreviewed",
+		CreatedAt: &createdAt,
+	}}
+	assert.Equal(t, want, reviewers)
+}
+
+func TestListMergeRequestReviewerEvents(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5/notes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/projects/1/merge_requests/5/notes?order_by=created_at&per_page=100&sort=asc")
+		fmt.Fprint(w, `[
+			{"id":1,"body":"requested review from @alice, @bob, and @carol","system":true,"author":{"username":"dave"}},
+			{"id":2,"body":"LGTM, requested review from @nobody","system":false,"author":{"username":"alice"}},
+			{"id":3,"body":"requested review from @erin and removed review request for @bob","system":true,"author":{"username":"dave"}},
+			{"id":4,"body":"assigned @erin as reviewer","system":true,"author":{"username":"dave"}},
+			{"id":5,"body":"marked this merge request as ready","system":true,"author":{"username":"dave"}}
+		]`)
+	})
+
+	events, err := client.MergeRequests.ListMergeRequestReviewerEvents(1, 5)
+	require.NoError(t, err)
+
+	note1 := "requested review from @alice, @bob, and @carol"
+	note3 := "requested review from @erin and removed review request for @bob"
+	want := []*MergeRequestReviewerEvent{
+		{NoteID: 1, Action: ReviewerEventRequested, Usernames: []string{"alice", "bob", "carol"}, Author: "dave", Body: note1},
+		{NoteID: 3, Action: ReviewerEventRequested, Usernames: []string{"erin"}, Author: "dave", Body: note3},
+		{NoteID: 3, Action: ReviewerEventRemoved, Usernames: []string{"bob"}, Author: "dave", Body: note3},
+		{NoteID: 4, Action: ReviewerEventUnparsed, Author: "dave", Body: "assigned @erin as reviewer"},
+	}
+	assert.Equal(t, want, events)
+}
+
+func TestGetMergeRequestCodeQualityReports(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var req graphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "group/project", req.Variables["fullPath"])
+		assert.Equal(t, "5", req.Variables["iid"])
+
+		fmt.Fprint(w, `{"data": {"project": {"mergeRequest": {"codequalityReportsComparer": {
+			"status": "PARSED",
+			"report": {
+				"status": "FAILED",
+				"newErrors": [{"description": "Method has too many lines", "fingerprint": "abc", "severity": "MAJOR", "filePath": "app/foo.rb", "line": 10, "engineName": "structure"}],
+				"resolvedErrors": [],
+				"existingErrors": [],
+				"summary": {"total": 1, "resolved": 0, "errored": 1}
+			}
+		}}}}}`)
+	})
+
+	report, _, err := client.MergeRequests.GetMergeRequestCodeQualityReports("group/project", 5)
+	require.NoError(t, err)
+
+	assert.Equal(t, CodeQualityReportParsed, report.Status)
+	assert.Equal(t, CodeQualityReportFailed, report.ReportStatus)
+	assert.Equal(t, 1, report.Summary.Errored)
+	require.Len(t, report.NewErrors, 1)
+	assert.Equal(t, &CodeQualityDegradation{
+		Description: "Method has too many lines",
+		Fingerprint: "abc",
+		Severity:    CodeQualitySeverityMajor,
+		FilePath:    "app/foo.rb",
+		Line:        10,
+		EngineName:  "structure",
+	}, report.NewErrors[0])
+}
+
 func TestGetIssuesClosedOnMerge_Jira(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
@@ -244,3 +336,66 @@ func TestGetIssuesClosedOnMerge_Jira(t *testing.T) {
 	assert.Equal(t, "PROJECT-123", issues[0].ExternalID)
 	assert.Equal(t, "Title of this issue", issues[0].Title)
 }
+
+func TestListRelatedIssues(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5/related_issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/projects/1/merge_requests/5/related_issues?page=1&per_page=10")
+		fmt.Fprint(w, `[{"id":1,"iid":2,"title":"Related issue"}]`)
+	})
+
+	issues, _, err := client.MergeRequests.ListRelatedIssues(1, 5, &ListRelatedIssuesOptions{Page: 1, PerPage: 10})
+	require.NoError(t, err)
+
+	want := []*Issue{{ID: 1, IID: 2, Title: "Related issue"}}
+	assert.Equal(t, want, issues)
+}
+
+func TestGetMergeRequestMergeRef(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/2/merge_ref", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"commit_id": "854a3a7a17acbcc0bbbea170986df1eb60435f34"}`)
+	})
+
+	ref, _, err := client.MergeRequests.GetMergeRequestMergeRef(1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, &MergeRequestMergeRef{CommitID: "854a3a7a17acbcc0bbbea170986df1eb60435f34"}, ref)
+}
+
+func TestGetMergeRequestMergeStatus(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/projects/1/merge_requests/2?include_diverged_commits_count=true&include_rebase_in_progress=true")
+		fmt.Fprint(w, `{
+			"id": 1,
+			"iid": 2,
+			"detailed_merge_status": "conflict",
+			"has_conflicts": true,
+			"blocking_discussions_resolved": true,
+			"diverged_commits_count": 3
+		}`)
+	})
+
+	status, _, err := client.MergeRequests.GetMergeRequestMergeStatus(1, 2)
+	require.NoError(t, err)
+
+	want := &MergeRequestMergeStatus{
+		DetailedMergeStatus:         MergeStatusConflict,
+		HasConflicts:                true,
+		BlockingDiscussionsResolved: true,
+		DivergedCommitsCount:        3,
+	}
+	assert.Equal(t, want, status)
+	assert.False(t, status.DetailedMergeStatus.IsMergeable())
+	assert.False(t, status.DetailedMergeStatus.IsPending())
+	assert.True(t, MergeStatusChecking.IsPending())
+}
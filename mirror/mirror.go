@@ -0,0 +1,244 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package mirror copies projects between GitLab instances using the project
+// export and import APIs, for example to synchronize air-gapped instances.
+package mirror
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+// ErrProjectExists is returned by Project when the destination project
+// already exists and overwriting is not enabled.
+var ErrProjectExists = errors.New("destination project already exists")
+
+// StageValue represents the stage of a project mirror.
+type StageValue string
+
+// These constants represent all valid mirror stages.
+const (
+	Exporting   StageValue = "exporting"
+	Downloading StageValue = "downloading"
+	Importing   StageValue = "importing"
+	Finished    StageValue = "finished"
+)
+
+// Status represents the progress of a project mirror.
+type Status struct {
+	Stage StageValue
+
+	// ExportStatus is the last export status received from the source
+	// instance, ImportStatus the last import status received from the
+	// destination instance.
+	ExportStatus *gitlab.ExportStatus
+	ImportStatus *gitlab.ImportStatus
+
+	// Downloaded is the number of bytes of the archive downloaded so far.
+	Downloaded int64
+}
+
+// ProjectOptions represents the available Project() options.
+type ProjectOptions struct {
+	// Namespace and Path select the destination project. They default to
+	// the namespace and path of the source project.
+	Namespace *string
+	Path      *string
+
+	// Overwrite replaces an existing destination project. Without it, an
+	// existing destination project results in ErrProjectExists.
+	Overwrite bool
+
+	// SkipVersionCheck skips checking that the destination instance can
+	// import archives created by the source instance.
+	SkipVersionCheck bool
+
+	// TempDir is the directory the archive is stored in while mirroring.
+	// Defaults to the default directory for temporary files.
+	TempDir string
+
+	// PollInterval and MaxPollInterval control waiting for the export and
+	// the import, see gitlab.ExportAndDownloadOptions.
+	PollInterval    time.Duration
+	MaxPollInterval time.Duration
+
+	// StatusFunc, when set, is called whenever the mirror makes progress.
+	StatusFunc func(*Status)
+}
+
+// Result represents a finished project mirror.
+type Result struct {
+	SourceProject *gitlab.Project
+	ImportStatus  *gitlab.ImportStatus
+	Archive       *gitlab.ExportArchiveInfo
+	ArchiveSize   int64
+}
+
+// Project copies a project from the instance of src to the instance of dst
+// using the project export and import APIs. It exports the project, downloads
+// the archive to a temporary file, checks that the destination instance can
+// import it, streams it from the file to the destination instance and waits
+// until the import finished. The archive is stored in a file because it is
+// read twice, once to check it and once to import it, and it is never
+// buffered in memory. Waiting stops when ctx is done, the context is also
+// used for all requests.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_import_export.html
+func Project(ctx context.Context, src, dst *gitlab.Client, pid interface{}, opt *ProjectOptions) (*Result, error) {
+	o := ProjectOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	if o.PollInterval <= 0 {
+		o.PollInterval = time.Second
+	}
+	if o.MaxPollInterval <= 0 {
+		o.MaxPollInterval = 30 * time.Second
+	}
+
+	status := &Status{Stage: Exporting}
+	report := func() {
+		if o.StatusFunc != nil {
+			o.StatusFunc(status)
+		}
+	}
+
+	p, _, err := src.Projects.GetProject(pid, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	namespace := o.Namespace
+	if namespace == nil && p.Namespace != nil {
+		namespace = gitlab.String(p.Namespace.FullPath)
+	}
+	path := o.Path
+	if path == nil {
+		path = gitlab.String(p.Path)
+	}
+
+	if !o.Overwrite {
+		target := *path
+		if namespace != nil {
+			target = *namespace + "/" + target
+		}
+		_, _, err := dst.Projects.GetProject(target, nil, gitlab.WithContext(ctx))
+		if err == nil {
+			return nil, fmt.Errorf("%w: %s", ErrProjectExists, target)
+		}
+		if !gitlab.IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	f, err := ioutil.TempFile(o.TempDir, "gitlab-mirror-*.tar.gz")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	report()
+	_, err = src.ProjectImportExport.ExportAndDownload(ctx, p.ID, f, &gitlab.ExportAndDownloadOptions{
+		PollInterval:    o.PollInterval,
+		MaxPollInterval: o.MaxPollInterval,
+		StatusFunc: func(es *gitlab.ExportStatus) {
+			status.ExportStatus = es
+			if es.ExportStatus.IsFinished() {
+				status.Stage = Downloading
+			}
+			report()
+		},
+		ProgressFunc: func(n int64) {
+			status.Downloaded = n
+			report()
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{SourceProject: p, ArchiveSize: status.Downloaded}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	if o.SkipVersionCheck {
+		result.Archive, err = gitlab.InspectExportArchive(f)
+	} else {
+		result.Archive, _, err = dst.ProjectImportExport.ValidateImportArchive(f, gitlab.WithContext(ctx))
+	}
+	if err != nil {
+		return nil, err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	status.Stage = Importing
+	report()
+
+	is, _, err := dst.ProjectImportExport.ImportFromReader(&gitlab.ImportFileOptions{
+		Namespace: namespace,
+		Path:      path,
+		Overwrite: gitlab.Bool(o.Overwrite),
+	}, f, "project.tar.gz", gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	wait := o.PollInterval
+	for {
+		status.ImportStatus = is
+		report()
+
+		if is.ImportStatus.IsFinished() {
+			break
+		}
+		if is.ImportStatus.IsTerminal() {
+			return nil, fmt.Errorf("import of project %s failed: %s", is.PathWithNamespace, is.ImportError)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+		if wait *= 2; wait > o.MaxPollInterval {
+			wait = o.MaxPollInterval
+		}
+
+		is, _, err = dst.ProjectImportExport.ImportStatus(is.ID, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result.ImportStatus = is
+	status.Stage = Finished
+	report()
+
+	return result, nil
+}
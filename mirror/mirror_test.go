@@ -0,0 +1,193 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package mirror
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+)
+
+// setup sets up a test HTTP server along with a gitlab.Client that is
+// configured to talk to that test server.
+func setup(t *testing.T) (*http.ServeMux, *httptest.Server, *gitlab.Client) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+
+	client, err := gitlab.NewClient("", gitlab.WithBaseURL(server.URL))
+	if err != nil {
+		server.Close()
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	return mux, server, client
+}
+
+// testExportArchive returns a gzipped tar archive with the given files.
+func testExportArchive(t *testing.T, files map[string]string, order []string) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for _, name := range order {
+		content := files[name]
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(content))}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func TestProject(t *testing.T) {
+	srcMux, srcServer, src := setup(t)
+	defer srcServer.Close()
+	dstMux, dstServer, dst := setup(t)
+	defer dstServer.Close()
+
+	files := map[string]string{"VERSION": "0.2.4", "GITLAB_VERSION": "16.3.0", "tree/project.json": "{}"}
+	archive := testExportArchive(t, files, []string{"VERSION", "GITLAB_VERSION", "tree/project.json"})
+
+	srcMux.HandleFunc("/api/v4/projects/acme/app", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, `{"id":1,"path":"app","namespace":{"full_path":"acme"}}`)
+	})
+	srcMux.HandleFunc("/api/v4/projects/1/export", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		fmt.Fprint(w, `{"id":1,"export_status":"finished"}`)
+	})
+	srcMux.HandleFunc("/api/v4/projects/1/export/download", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(archive)
+	})
+
+	dstMux.HandleFunc("/api/v4/projects/mirror/app", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"404 Project Not Found"}`)
+	})
+	dstMux.HandleFunc("/api/v4/metadata", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"version":"16.4.1-ee"}`)
+	})
+	dstMux.HandleFunc("/api/v4/projects/import", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Fatalf("Failed to parse form: %v", err)
+		}
+		assert.Equal(t, "mirror", r.FormValue("namespace"))
+		assert.Equal(t, "app", r.FormValue("path"))
+		assert.Equal(t, "false", r.FormValue("overwrite"))
+
+		f, _, err := r.FormFile("file")
+		require.NoError(t, err)
+		b, _ := ioutil.ReadAll(f)
+		assert.Equal(t, archive, b)
+
+		fmt.Fprint(w, `{"id":7,"path_with_namespace":"mirror/app","import_status":"scheduled"}`)
+	})
+	var checks int32
+	dstMux.HandleFunc("/api/v4/projects/7/import", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		if atomic.AddInt32(&checks, 1) < 2 {
+			fmt.Fprint(w, `{"id":7,"import_status":"started"}`)
+			return
+		}
+		fmt.Fprint(w, `{"id":7,"import_status":"finished"}`)
+	})
+
+	var stages []StageValue
+	opt := &ProjectOptions{
+		Namespace:    gitlab.String("mirror"),
+		PollInterval: time.Millisecond,
+		StatusFunc: func(s *Status) {
+			if len(stages) == 0 || stages[len(stages)-1] != s.Stage {
+				stages = append(stages, s.Stage)
+			}
+		},
+	}
+	result, err := Project(context.Background(), src, dst, "acme/app", opt)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, result.SourceProject.ID)
+	assert.Equal(t, gitlab.ImportFinished, result.ImportStatus.ImportStatus)
+	assert.Equal(t, "16.3.0", result.Archive.GitLabVersion)
+	assert.Equal(t, int64(len(archive)), result.ArchiveSize)
+	assert.Equal(t, []StageValue{Exporting, Downloading, Importing, Finished}, stages)
+}
+
+func TestProjectExists(t *testing.T) {
+	srcMux, srcServer, src := setup(t)
+	defer srcServer.Close()
+	dstMux, dstServer, dst := setup(t)
+	defer dstServer.Close()
+
+	srcMux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":1,"path":"app","namespace":{"full_path":"acme"}}`)
+	})
+	dstMux.HandleFunc("/api/v4/projects/acme/app", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":3}`)
+	})
+
+	_, err := Project(context.Background(), src, dst, 1, nil)
+	assert.True(t, errors.Is(err, ErrProjectExists), "unexpected error: %v", err)
+}
+
+func TestProjectImportFailed(t *testing.T) {
+	srcMux, srcServer, src := setup(t)
+	defer srcServer.Close()
+	dstMux, dstServer, dst := setup(t)
+	defer dstServer.Close()
+
+	archive := testExportArchive(t, map[string]string{"VERSION": "0.2.4", "project.json": "{}"}, []string{"VERSION", "project.json"})
+
+	srcMux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":1,"path":"app","namespace":{"full_path":"acme"}}`)
+	})
+	srcMux.HandleFunc("/api/v4/projects/1/export", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		fmt.Fprint(w, `{"id":1,"export_status":"finished"}`)
+	})
+	srcMux.HandleFunc("/api/v4/projects/1/export/download", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(archive)
+	})
+	dstMux.HandleFunc("/api/v4/projects/import", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":7,"path_with_namespace":"acme/app","import_status":"failed","import_error":"invalid archive"}`)
+	})
+
+	opt := &ProjectOptions{Overwrite: true, SkipVersionCheck: true, PollInterval: time.Millisecond}
+	_, err := Project(context.Background(), src, dst, 1, opt)
+	require.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), "invalid archive"), err.Error())
+}
@@ -0,0 +1,170 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/url"
+	"sort"
+	"sync"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+)
+
+// errMultipartBodyRead is returned when a streamed multipart body is read a
+// second time, for instance when the request is retried.
+var errMultipartBodyRead = errors.New("the multipart upload can't be retried, its file was already read")
+
+// setMultipartBody sets the body of req to a multipart form uploading the
+// content read from r as the file field, followed by the given fields. The
+// form is streamed while the request is sent, so the file is never buffered
+// in memory. As r can only be read once, the request can't be retried once
+// the upload started.
+func setMultipartBody(req *retryablehttp.Request, r io.Reader, filename string, fields url.Values) error {
+	w := multipart.NewWriter(ioutil.Discard)
+	b := &multipartBody{
+		r:        r,
+		filename: filename,
+		fields:   fields,
+		boundary: w.Boundary(),
+	}
+
+	if err := req.SetBody(retryablehttp.ReaderFunc(b.reader)); err != nil {
+		return err
+	}
+
+	// Overwrite the default content type.
+	req.Header.Set("Content-Type", w.FormDataContentType())
+
+	return nil
+}
+
+// multipartBody is a multipart form that is written while it is read.
+type multipartBody struct {
+	r        io.Reader
+	filename string
+	fields   url.Values
+	boundary string
+
+	mu      sync.Mutex
+	started bool
+}
+
+// reader returns a reader for the body. The form is only written once the
+// reader is read from, as retryablehttp also calls this to probe the body.
+func (b *multipartBody) reader() (io.Reader, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.started {
+		return nil, errMultipartBodyRead
+	}
+	return &multipartBodyReader{body: b}, nil
+}
+
+// start starts writing the form into a pipe, unless it was already started
+// by another reader.
+func (b *multipartBody) start() (*io.PipeReader, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.started {
+		return nil, errMultipartBodyRead
+	}
+	b.started = true
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(b.write(pw))
+	}()
+
+	return pr, nil
+}
+
+func (b *multipartBody) write(w io.Writer) error {
+	mw := multipart.NewWriter(w)
+	if err := mw.SetBoundary(b.boundary); err != nil {
+		return err
+	}
+
+	fw, err := mw.CreateFormFile("file", b.filename)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(fw, b.r); err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(b.fields))
+	for k := range b.fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		for _, v := range b.fields[k] {
+			if err := mw.WriteField(k, v); err != nil {
+				return err
+			}
+		}
+	}
+
+	return mw.Close()
+}
+
+// multipartBodyReader reads a multipartBody. Closing it stops writing the
+// form, for instance when the request failed.
+type multipartBodyReader struct {
+	body *multipartBody
+
+	mu     sync.Mutex
+	pr     *io.PipeReader
+	closed bool
+}
+
+func (r *multipartBodyReader) Read(p []byte) (int, error) {
+	r.mu.Lock()
+	if r.closed {
+		r.mu.Unlock()
+		return 0, io.ErrClosedPipe
+	}
+	if r.pr == nil {
+		pr, err := r.body.start()
+		if err != nil {
+			r.mu.Unlock()
+			return 0, err
+		}
+		r.pr = pr
+	}
+	pr := r.pr
+	r.mu.Unlock()
+
+	return pr.Read(p)
+}
+
+func (r *multipartBodyReader) Close() error {
+	r.mu.Lock()
+	r.closed = true
+	pr := r.pr
+	r.mu.Unlock()
+
+	if pr != nil {
+		return pr.Close()
+	}
+	return nil
+}
@@ -33,13 +33,17 @@ type NamespacesService struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/namespaces.html
 type Namespace struct {
-	ID                          int    `json:"id"`
-	Name                        string `json:"name"`
-	Path                        string `json:"path"`
-	Kind                        string `json:"kind"`
-	FullPath                    string `json:"full_path"`
-	ParentID                    int    `json:"parent_id"`
-	MembersCountWithDescendants int    `json:"members_count_with_descendants"`
+	ID                             int    `json:"id"`
+	Name                           string `json:"name"`
+	Path                           string `json:"path"`
+	Kind                           string `json:"kind"`
+	FullPath                       string `json:"full_path"`
+	ParentID                       int    `json:"parent_id"`
+	MembersCountWithDescendants    int    `json:"members_count_with_descendants"`
+	Plan                           string `json:"plan"`
+	BillableMembersCount           int    `json:"billable_members_count"`
+	SharedRunnersMinutesLimit      *int   `json:"shared_runners_minutes_limit"`
+	ExtraSharedRunnersMinutesLimit *int   `json:"extra_shared_runners_minutes_limit"`
 }
 
 func (n Namespace) String() string {
@@ -121,3 +125,46 @@ func (s *NamespacesService) GetNamespace(id interface{}, options ...RequestOptio
 
 	return n, resp, err
 }
+
+// NamespaceExistence represents a namespace exists result.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/namespaces.html#get-existence-of-a-namespace
+type NamespaceExistence struct {
+	Exists   bool     `json:"exists"`
+	Suggests []string `json:"suggests"`
+}
+
+// NamespaceExistsOptions represents the available NamespaceExists() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/namespaces.html#get-existence-of-a-namespace
+type NamespaceExistsOptions struct {
+	ParentID *int `url:"parent_id,omitempty" json:"parent_id,omitempty"`
+}
+
+// NamespaceExists checks the existence of a namespace. When the path is
+// already taken, a list of available alternative paths is suggested.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/namespaces.html#get-existence-of-a-namespace
+func (s *NamespacesService) NamespaceExists(id interface{}, opt *NamespaceExistsOptions, options ...RequestOptionFunc) (*NamespaceExistence, *Response, error) {
+	namespace, err := parseID(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("namespaces/%s/exists", pathEscape(namespace))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	n := new(NamespaceExistence)
+	resp, err := s.client.Do(req, n)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return n, resp, err
+}
@@ -0,0 +1,46 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestNamespaceExists(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/namespaces/my-group/exists", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/namespaces/my-group/exists?parent_id=1")
+		fmt.Fprint(w, `{"exists":true,"suggests":["my-group1"]}`)
+	})
+
+	opt := &NamespaceExistsOptions{ParentID: Int(1)}
+	exists, _, err := client.Namespaces.NamespaceExists("my-group", opt)
+	if err != nil {
+		t.Fatalf("Namespaces.NamespaceExists returned error: %v", err)
+	}
+
+	want := &NamespaceExistence{Exists: true, Suggests: []string{"my-group1"}}
+	if !reflect.DeepEqual(want, exists) {
+		t.Errorf("Namespaces.NamespaceExists returned %+v, want %+v", exists, want)
+	}
+}
@@ -100,14 +100,34 @@ func (n Note) String() string {
 	return Stringify(n)
 }
 
+// NoteOrderByValue represents a column notes can be ordered by.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/notes.html
+type NoteOrderByValue string
+
+// These constants represent all valid columns to order notes by.
+const (
+	NoteOrderByCreatedAt NoteOrderByValue = "created_at"
+	NoteOrderByUpdatedAt NoteOrderByValue = "updated_at"
+)
+
+// NoteOrderBy is a helper routine that allocates a new NoteOrderByValue
+// to store v and returns a pointer to it.
+func NoteOrderBy(v NoteOrderByValue) *NoteOrderByValue {
+	p := new(NoteOrderByValue)
+	*p = v
+	return p
+}
+
 // ListIssueNotesOptions represents the available ListIssueNotes() options.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/notes.html#list-project-issue-notes
 type ListIssueNotesOptions struct {
 	ListOptions
-	OrderBy *string `url:"order_by,omitempty" json:"order_by,omitempty"`
-	Sort    *string `url:"sort,omitempty" json:"sort,omitempty"`
+	OrderBy *NoteOrderByValue `url:"order_by,omitempty" json:"order_by,omitempty"`
+	Sort    *SortValue        `url:"sort,omitempty" json:"sort,omitempty"`
 }
 
 // ListIssueNotes gets a list of all notes for a single issue.
@@ -254,8 +274,8 @@ func (s *NotesService) DeleteIssueNote(pid interface{}, issue, note int, options
 // https://docs.gitlab.com/ce/api/notes.html#list-all-snippet-notes
 type ListSnippetNotesOptions struct {
 	ListOptions
-	OrderBy *string `url:"order_by,omitempty" json:"order_by,omitempty"`
-	Sort    *string `url:"sort,omitempty" json:"sort,omitempty"`
+	OrderBy *NoteOrderByValue `url:"order_by,omitempty" json:"order_by,omitempty"`
+	Sort    *SortValue        `url:"sort,omitempty" json:"sort,omitempty"`
 }
 
 // ListSnippetNotes gets a list of all notes for a single snippet. Snippet
@@ -404,8 +424,8 @@ func (s *NotesService) DeleteSnippetNote(pid interface{}, snippet, note int, opt
 // https://docs.gitlab.com/ce/api/notes.html#list-all-merge-request-notes
 type ListMergeRequestNotesOptions struct {
 	ListOptions
-	OrderBy *string `url:"order_by,omitempty" json:"order_by,omitempty"`
-	Sort    *string `url:"sort,omitempty" json:"sort,omitempty"`
+	OrderBy *NoteOrderByValue `url:"order_by,omitempty" json:"order_by,omitempty"`
+	Sort    *SortValue        `url:"sort,omitempty" json:"sort,omitempty"`
 }
 
 // ListMergeRequestNotes gets a list of all notes for a single merge request.
@@ -552,8 +572,8 @@ func (s *NotesService) DeleteMergeRequestNote(pid interface{}, mergeRequest, not
 // https://docs.gitlab.com/ee/api/notes.html#list-all-epic-notes
 type ListEpicNotesOptions struct {
 	ListOptions
-	OrderBy *string `url:"order_by,omitempty" json:"order_by,omitempty"`
-	Sort    *string `url:"sort,omitempty" json:"sort,omitempty"`
+	OrderBy *NoteOrderByValue `url:"order_by,omitempty" json:"order_by,omitempty"`
+	Sort    *SortValue        `url:"sort,omitempty" json:"sort,omitempty"`
 }
 
 // ListEpicNotes gets a list of all notes for a single epic.
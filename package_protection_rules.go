@@ -0,0 +1,174 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// PackageProtectionRulesService handles communication with the package
+// protection rules related methods of the GitLab API.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_packages_protection_rules.html
+type PackageProtectionRulesService struct {
+	client *Client
+}
+
+// PackageProtectionRule represents a GitLab package protection rule.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_packages_protection_rules.html
+type PackageProtectionRule struct {
+	ID                          int                            `json:"id"`
+	ProjectID                   int                            `json:"project_id"`
+	PackageNamePattern          string                         `json:"package_name_pattern"`
+	PackageType                 string                         `json:"package_type"`
+	MinimumAccessLevelForPush   ProtectionRuleAccessLevelValue `json:"minimum_access_level_for_push"`
+	MinimumAccessLevelForDelete ProtectionRuleAccessLevelValue `json:"minimum_access_level_for_delete"`
+}
+
+func (s PackageProtectionRule) String() string {
+	return Stringify(s)
+}
+
+// ListPackageProtectionRulesOptions represents the available
+// ListPackageProtectionRules() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_packages_protection_rules.html#list-package-protection-rules
+type ListPackageProtectionRulesOptions ListOptions
+
+// ListPackageProtectionRules gets a list of the package protection rules of a
+// project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_packages_protection_rules.html#list-package-protection-rules
+func (s *PackageProtectionRulesService) ListPackageProtectionRules(pid interface{}, opt *ListPackageProtectionRulesOptions, options ...RequestOptionFunc) ([]*PackageProtectionRule, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/packages/protection/rules", pathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rules []*PackageProtectionRule
+	resp, err := s.client.Do(req, &rules)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return rules, resp, err
+}
+
+// CreatePackageProtectionRuleOptions represents the available
+// CreatePackageProtectionRule() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_packages_protection_rules.html#create-a-package-protection-rule
+type CreatePackageProtectionRuleOptions struct {
+	PackageNamePattern          *string                         `url:"package_name_pattern,omitempty" json:"package_name_pattern,omitempty"`
+	PackageType                 *string                         `url:"package_type,omitempty" json:"package_type,omitempty"`
+	MinimumAccessLevelForPush   *ProtectionRuleAccessLevelValue `url:"minimum_access_level_for_push,omitempty" json:"minimum_access_level_for_push,omitempty"`
+	MinimumAccessLevelForDelete *ProtectionRuleAccessLevelValue `url:"minimum_access_level_for_delete,omitempty" json:"minimum_access_level_for_delete,omitempty"`
+}
+
+// CreatePackageProtectionRule creates a new package protection rule.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_packages_protection_rules.html#create-a-package-protection-rule
+func (s *PackageProtectionRulesService) CreatePackageProtectionRule(pid interface{}, opt *CreatePackageProtectionRuleOptions, options ...RequestOptionFunc) (*PackageProtectionRule, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/packages/protection/rules", pathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodPost, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rule := new(PackageProtectionRule)
+	resp, err := s.client.Do(req, rule)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return rule, resp, err
+}
+
+// UpdatePackageProtectionRuleOptions represents the available
+// UpdatePackageProtectionRule() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_packages_protection_rules.html#update-a-package-protection-rule
+type UpdatePackageProtectionRuleOptions struct {
+	PackageNamePattern          *string                         `url:"package_name_pattern,omitempty" json:"package_name_pattern,omitempty"`
+	PackageType                 *string                         `url:"package_type,omitempty" json:"package_type,omitempty"`
+	MinimumAccessLevelForPush   *ProtectionRuleAccessLevelValue `url:"minimum_access_level_for_push,omitempty" json:"minimum_access_level_for_push,omitempty"`
+	MinimumAccessLevelForDelete *ProtectionRuleAccessLevelValue `url:"minimum_access_level_for_delete,omitempty" json:"minimum_access_level_for_delete,omitempty"`
+}
+
+// UpdatePackageProtectionRule updates an existing package protection rule.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_packages_protection_rules.html#update-a-package-protection-rule
+func (s *PackageProtectionRulesService) UpdatePackageProtectionRule(pid interface{}, rule int, opt *UpdatePackageProtectionRuleOptions, options ...RequestOptionFunc) (*PackageProtectionRule, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/packages/protection/rules/%d", pathEscape(project), rule)
+
+	req, err := s.client.NewRequest(http.MethodPatch, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(PackageProtectionRule)
+	resp, err := s.client.Do(req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, err
+}
+
+// DeletePackageProtectionRule deletes a package protection rule.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_packages_protection_rules.html#delete-a-package-protection-rule
+func (s *PackageProtectionRulesService) DeletePackageProtectionRule(pid interface{}, rule int, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/packages/protection/rules/%d", pathEscape(project), rule)
+
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
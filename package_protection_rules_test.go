@@ -0,0 +1,106 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListPackageProtectionRules(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/7/packages/protection/rules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{
+			"id": 1,
+			"project_id": 7,
+			"package_name_pattern": "@my-scope/my-package-*",
+			"package_type": "npm",
+			"minimum_access_level_for_push": "maintainer",
+			"minimum_access_level_for_delete": "owner"
+		}]`)
+	})
+
+	rules, _, err := client.PackageProtectionRules.ListPackageProtectionRules(7, nil)
+	require.NoError(t, err)
+
+	want := []*PackageProtectionRule{{
+		ID:                          1,
+		ProjectID:                   7,
+		PackageNamePattern:          "@my-scope/my-package-*",
+		PackageType:                 "npm",
+		MinimumAccessLevelForPush:   MaintainerProtectionRuleAccessLevel,
+		MinimumAccessLevelForDelete: OwnerProtectionRuleAccessLevel,
+	}}
+	assert.Equal(t, want, rules)
+}
+
+func TestCreatePackageProtectionRule(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/7/packages/protection/rules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"package_name_pattern":"@my-scope/*","package_type":"npm","minimum_access_level_for_push":"owner"}`)
+		fmt.Fprint(w, `{"id": 2, "project_id": 7, "package_name_pattern": "@my-scope/*", "package_type": "npm", "minimum_access_level_for_push": "owner"}`)
+	})
+
+	opt := &CreatePackageProtectionRuleOptions{
+		PackageNamePattern:        String("@my-scope/*"),
+		PackageType:               String("npm"),
+		MinimumAccessLevelForPush: ProtectionRuleAccessLevel(OwnerProtectionRuleAccessLevel),
+	}
+
+	rule, _, err := client.PackageProtectionRules.CreatePackageProtectionRule(7, opt)
+	require.NoError(t, err)
+	assert.Equal(t, 2, rule.ID)
+	assert.Equal(t, OwnerProtectionRuleAccessLevel, rule.MinimumAccessLevelForPush)
+}
+
+func TestUpdateAndDeletePackageProtectionRule(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/7/packages/protection/rules/2", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPatch:
+			testURL(t, r, "/api/v4/projects/7/packages/protection/rules/2?minimum_access_level_for_delete=admin")
+			fmt.Fprint(w, `{"id": 2, "project_id": 7, "minimum_access_level_for_delete": "admin"}`)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request method %s", r.Method)
+		}
+	})
+
+	opt := &UpdatePackageProtectionRuleOptions{
+		MinimumAccessLevelForDelete: ProtectionRuleAccessLevel(AdminProtectionRuleAccessLevel),
+	}
+
+	rule, _, err := client.PackageProtectionRules.UpdatePackageProtectionRule(7, 2, opt)
+	require.NoError(t, err)
+	assert.Equal(t, AdminProtectionRuleAccessLevel, rule.MinimumAccessLevelForDelete)
+
+	_, err = client.PackageProtectionRules.DeletePackageProtectionRule(7, 2)
+	require.NoError(t, err)
+}
@@ -74,17 +74,39 @@ func (s PackageFile) String() string {
 	return Stringify(s)
 }
 
+// PackageOrderByValue represents a column packages can be ordered by.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/packages.html#within-a-project
+type PackageOrderByValue string
+
+// These constants represent all valid columns to order packages by.
+const (
+	PackageOrderByCreatedAt PackageOrderByValue = "created_at"
+	PackageOrderByName      PackageOrderByValue = "name"
+	PackageOrderByVersion   PackageOrderByValue = "version"
+	PackageOrderByType      PackageOrderByValue = "type"
+)
+
+// PackageOrderBy is a helper routine that allocates a new PackageOrderByValue
+// to store v and returns a pointer to it.
+func PackageOrderBy(v PackageOrderByValue) *PackageOrderByValue {
+	p := new(PackageOrderByValue)
+	*p = v
+	return p
+}
+
 // ListProjectPackagesOptions are the parameters available in a ListProjectPackages() Operation.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/packages.html#within-a-project
 type ListProjectPackagesOptions struct {
 	ListOptions
-	OrderBy            *string `url:"order_by,omitempty" json:"order_by,omitempty"`
-	Sort               *string `url:"sort,omitempty" json:"sort,omitempty"`
-	PackageType        *string `url:"package_type,omitempty" json:"package_type,omitempty"`
-	PackageName        *string `url:"package_name,omitempty" json:"package_name,omitempty"`
-	IncludeVersionless *bool   `url:"include_versionless,omitempty" json:"include_versionless,omitempty"`
+	OrderBy            *PackageOrderByValue `url:"order_by,omitempty" json:"order_by,omitempty"`
+	Sort               *SortValue           `url:"sort,omitempty" json:"sort,omitempty"`
+	PackageType        *string              `url:"package_type,omitempty" json:"package_type,omitempty"`
+	PackageName        *string              `url:"package_name,omitempty" json:"package_name,omitempty"`
+	IncludeVersionless *bool                `url:"include_versionless,omitempty" json:"include_versionless,omitempty"`
 }
 
 // ListProjectPackages gets a list of packages in a project.
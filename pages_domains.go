@@ -193,6 +193,33 @@ func (s *PagesDomainsService) UpdatePagesDomain(pid interface{}, domain string,
 	return pd, resp, err
 }
 
+// VerifyPagesDomain verifies a project pages domain by checking the DNS TXT
+// record containing the domain's verification code, and returns the domain
+// with its updated verification status.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/pages_domains.html#verify-pages-domain
+func (s *PagesDomainsService) VerifyPagesDomain(pid interface{}, domain string, options ...RequestOptionFunc) (*PagesDomain, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/pages/domains/%s/verify", pathEscape(project), pathEscape(domain))
+
+	req, err := s.client.NewRequest(http.MethodPut, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pd := new(PagesDomain)
+	resp, err := s.client.Do(req, pd)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pd, resp, err
+}
+
 // DeletePagesDomain deletes an existing prject pages domain.
 //
 // GitLab API docs:
@@ -0,0 +1,48 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyPagesDomain(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/pages/domains/www.domain.example/verify", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testURL(t, r, "/api/v4/projects/5/pages/domains/www%2Edomain%2Eexample/verify")
+		fmt.Fprint(w, `{
+			"domain": "www.domain.example",
+			"url": "http://www.domain.example",
+			"verified": true,
+			"verification_code": "1234567890abcdef"
+		}`)
+	})
+
+	domain, _, err := client.PagesDomains.VerifyPagesDomain(5, "www.domain.example")
+	require.NoError(t, err)
+
+	assert.True(t, domain.Verified)
+	assert.Equal(t, "1234567890abcdef", domain.VerificationCode)
+}
@@ -0,0 +1,89 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPages(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/pages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+			"url": "https://group.gitlab.io/project",
+			"is_unique_domain_enabled": true,
+			"force_https": false,
+			"deployments": [{
+				"created_at": "2024-01-05T18:58:14.916Z",
+				"url": "https://group.gitlab.io/project/",
+				"path_prefix": "",
+				"root_directory": null
+			}]
+		}`)
+	})
+
+	pages, _, err := client.Pages.GetPages(1)
+	require.NoError(t, err)
+
+	createdAt := time.Date(2024, 1, 5, 18, 58, 14, 916000000, time.UTC)
+	want := &Pages{
+		URL:                   "https://group.gitlab.io/project",
+		IsUniqueDomainEnabled: true,
+		Deployments: []*PagesDeployment{{
+			CreatedAt: &createdAt,
+			URL:       "https://group.gitlab.io/project/",
+		}},
+	}
+	assert.Equal(t, want, pages)
+}
+
+func TestUpdatePages(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/pages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPatch)
+		testURL(t, r, "/api/v4/projects/1/pages?pages_https_only=true")
+		fmt.Fprint(w, `{"url": "https://group.gitlab.io/project", "force_https": true}`)
+	})
+
+	pages, _, err := client.Pages.UpdatePages(1, &UpdatePagesOptions{PagesHTTPSOnly: Bool(true)})
+	require.NoError(t, err)
+	assert.True(t, pages.ForceHTTPS)
+}
+
+func TestUnpublishPages(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/pages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Pages.UnpublishPages(1)
+	require.NoError(t, err)
+}
@@ -0,0 +1,163 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrStopPagination can be returned by a PageFunc to stop ForEachPage
+// without returning an error.
+var ErrStopPagination = errors.New("stop pagination")
+
+// PageFunc is the function called by ForEachPage for every page of a
+// paginated list. It should pass the given request options to the list
+// method, handle the returned items and return the response of the list
+// method.
+type PageFunc func(options ...RequestOptionFunc) (*Response, error)
+
+// PaginationOptions represents the available ForEachPageWithOptions()
+// options.
+type PaginationOptions struct {
+	// MaxStall is the maximum total time spent waiting for rate limits while
+	// iterating. When a page is rate limited after the retries of the client
+	// are exhausted, the same page is requested again once the rate limit is
+	// reset, until MaxStall is reached. Defaults to 15 minutes, a negative
+	// value disables waiting for rate limits.
+	MaxStall time.Duration
+
+	// RetryWait is the time to wait for a rate limit when GitLab doesn't
+	// specify when to retry. It doubles for every consecutive rate limited
+	// request, up to one minute. Defaults to one second.
+	RetryWait time.Duration
+}
+
+// ForEachPage calls fn for every page of a paginated list, starting with the
+// page selected by the list options, until the last page is reached. Both
+// offset-based and keyset-based pagination are supported, keyset-based
+// pagination follows the next link of every response. The
+// given request options are passed to every call of fn. For example, to list
+// all projects:
+//
+//	var projects []*gitlab.Project
+//	err := gitlab.ForEachPage(func(options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+//		ps, resp, err := git.Projects.ListProjects(opt, options...)
+//		projects = append(projects, ps...)
+//		return resp, err
+//	})
+//
+// If fn returns an error, ForEachPage stops and returns that error, unless it
+// is ErrStopPagination. Rate limited pages are requested again using the
+// default PaginationOptions, see ForEachPageWithOptions.
+func ForEachPage(fn PageFunc, options ...RequestOptionFunc) error {
+	return ForEachPageWithOptions(fn, nil, options...)
+}
+
+// ForEachPageWithOptions works like ForEachPage, with the given options for
+// handling rate limits in the middle of an iteration, which allows long
+// scans, like listing all projects of an instance, to continue instead of
+// failing halfway. fn must not keep the items of a rate limited page, which
+// list methods don't return anyway.
+func ForEachPageWithOptions(fn PageFunc, opt *PaginationOptions, options ...RequestOptionFunc) error {
+	o := PaginationOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	if o.MaxStall == 0 {
+		o.MaxStall = 15 * time.Minute
+	}
+	if o.RetryWait <= 0 {
+		o.RetryWait = time.Second
+	}
+
+	var stalled time.Duration
+	retryWait := o.RetryWait
+
+	opts := options
+	for {
+		resp, err := fn(opts...)
+		if err != nil {
+			if errors.Is(err, ErrStopPagination) {
+				return nil
+			}
+			if !IsRateLimited(err) || o.MaxStall < 0 {
+				return err
+			}
+
+			wait := retryWait
+			if resp != nil {
+				if w := serverRetryWait(resp.Response); w > 0 {
+					wait = w
+				}
+			}
+			if stalled+wait > o.MaxStall {
+				return err
+			}
+			if err := sleepContext(responseContext(resp), wait); err != nil {
+				return err
+			}
+			stalled += wait
+			if retryWait *= 2; retryWait > time.Minute {
+				retryWait = time.Minute
+			}
+
+			// Request the same page again.
+			continue
+		}
+		retryWait = o.RetryWait
+
+		if resp == nil {
+			return nil
+		}
+
+		var next RequestOptionFunc
+		switch {
+		case resp.NextPage != 0:
+			next = WithOffsetPaginationParameters(resp.NextPage)
+		case resp.NextLink != "":
+			next = WithKeysetPaginationParameters(resp.NextLink)
+		default:
+			return nil
+		}
+
+		opts = append(options[:len(options):len(options)], next)
+	}
+}
+
+// responseContext returns the context of the request of resp, so waiting
+// between requests stops when the context of the requests is done.
+func responseContext(resp *Response) context.Context {
+	if resp != nil && resp.Response != nil && resp.Request != nil {
+		return resp.Request.Context()
+	}
+	return context.Background()
+}
+
+// sleepContext waits for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,171 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForEachPage(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		assert.Equal(t, "2", r.URL.Query().Get("per_page"))
+
+		switch r.URL.Query().Get("page") {
+		case "", "1":
+			w.Header().Set("X-Next-Page", "2")
+			fmt.Fprint(w, `[{"id":1},{"id":2}]`)
+		case "2":
+			w.Header().Set("X-Next-Page", "3")
+			fmt.Fprint(w, `[{"id":3},{"id":4}]`)
+		case "3":
+			fmt.Fprint(w, `[{"id":5}]`)
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+
+	opt := &ListProjectsOptions{ListOptions: ListOptions{PerPage: 2}}
+
+	var ids []int
+	err := ForEachPage(func(options ...RequestOptionFunc) (*Response, error) {
+		ps, resp, err := client.Projects.ListProjects(opt, options...)
+		for _, p := range ps {
+			ids = append(ids, p.ID)
+		}
+		return resp, err
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, ids)
+
+	ids = nil
+	err = ForEachPage(func(options ...RequestOptionFunc) (*Response, error) {
+		ps, resp, err := client.Projects.ListProjects(opt, options...)
+		for _, p := range ps {
+			ids = append(ids, p.ID)
+		}
+		if len(ids) >= 3 {
+			return resp, ErrStopPagination
+		}
+		return resp, err
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4}, ids)
+}
+
+func TestForEachPageKeyset(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		assert.Equal(t, "keyset", r.URL.Query().Get("pagination"))
+
+		switch r.URL.Query().Get("id_after") {
+		case "":
+			w.Header().Set("Link", fmt.Sprintf(
+				`<%s/api/v4/projects?id_after=2&order_by=id&pagination=keyset&per_page=2&sort=asc>; rel="next"`,
+				server.URL,
+			))
+			fmt.Fprint(w, `[{"id":1},{"id":2}]`)
+		case "2":
+			fmt.Fprint(w, `[{"id":3}]`)
+		default:
+			t.Errorf("unexpected cursor %q", r.URL.Query().Get("id_after"))
+		}
+	})
+
+	opt := &ListProjectsOptions{
+		ListOptions: ListOptions{PerPage: 2},
+		OrderBy:     ProjectOrderBy(ProjectOrderByID),
+		Sort:        Sort(SortAsc),
+	}
+
+	var ids []int
+	err := ForEachPage(func(options ...RequestOptionFunc) (*Response, error) {
+		ps, resp, err := client.Projects.ListProjects(opt, options...)
+		for _, p := range ps {
+			ids = append(ids, p.ID)
+		}
+		return resp, err
+	}, WithKeysetPagination())
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, ids)
+}
+
+func TestForEachPageRateLimited(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	if err := WithoutRetries()(client); err != nil {
+		t.Fatal(err)
+	}
+
+	limited := 0
+	mux.HandleFunc("/api/v4/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		switch r.URL.Query().Get("page") {
+		case "", "1":
+			w.Header().Set("X-Next-Page", "2")
+			fmt.Fprint(w, `[{"id":1}]`)
+		case "2":
+			if limited < 2 {
+				limited++
+				w.WriteHeader(http.StatusTooManyRequests)
+				fmt.Fprint(w, `{"message":"429 Too Many Requests"}`)
+				return
+			}
+			fmt.Fprint(w, `[{"id":2}]`)
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+
+	var ids []int
+	fn := func(options ...RequestOptionFunc) (*Response, error) {
+		ps, resp, err := client.Projects.ListProjects(nil, options...)
+		for _, p := range ps {
+			ids = append(ids, p.ID)
+		}
+		return resp, err
+	}
+
+	err := ForEachPageWithOptions(fn, &PaginationOptions{RetryWait: time.Millisecond})
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, ids)
+	assert.Equal(t, 2, limited)
+
+	ids, limited = nil, 0
+	err = ForEachPageWithOptions(fn, &PaginationOptions{RetryWait: time.Millisecond, MaxStall: 2 * time.Millisecond})
+	assert.True(t, IsRateLimited(err), "unexpected error: %v", err)
+	assert.Equal(t, []int{1}, ids)
+
+	ids, limited = nil, 0
+	err = ForEachPageWithOptions(fn, &PaginationOptions{MaxStall: -1})
+	assert.True(t, IsRateLimited(err), "unexpected error: %v", err)
+	assert.Equal(t, 1, limited)
+}
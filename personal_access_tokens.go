@@ -0,0 +1,184 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// PersonalAccessTokensService handles communication with the personal access
+// tokens related methods of the GitLab API.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/personal_access_tokens.html
+type PersonalAccessTokensService struct {
+	client *Client
+}
+
+// ListPersonalAccessTokensOptions represents the available
+// ListPersonalAccessTokens() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/personal_access_tokens.html#list-personal-access-tokens
+type ListPersonalAccessTokensOptions struct {
+	ListOptions
+	CreatedAfter   *time.Time `url:"created_after,omitempty" json:"created_after,omitempty"`
+	CreatedBefore  *time.Time `url:"created_before,omitempty" json:"created_before,omitempty"`
+	LastUsedAfter  *time.Time `url:"last_used_after,omitempty" json:"last_used_after,omitempty"`
+	LastUsedBefore *time.Time `url:"last_used_before,omitempty" json:"last_used_before,omitempty"`
+	Revoked        *bool      `url:"revoked,omitempty" json:"revoked,omitempty"`
+	Search         *string    `url:"search,omitempty" json:"search,omitempty"`
+	State          *string    `url:"state,omitempty" json:"state,omitempty"`
+	UserID         *int       `url:"user_id,omitempty" json:"user_id,omitempty"`
+}
+
+// ListPersonalAccessTokens gets the personal access tokens of the
+// authenticated user, or of all users for administrators.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/personal_access_tokens.html#list-personal-access-tokens
+func (s *PersonalAccessTokensService) ListPersonalAccessTokens(opt *ListPersonalAccessTokensOptions, options ...RequestOptionFunc) ([]*PersonalAccessToken, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "personal_access_tokens", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var pats []*PersonalAccessToken
+	resp, err := s.client.Do(req, &pats)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pats, resp, err
+}
+
+// GetSinglePersonalAccessTokenByID gets a single personal access token by ID.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/personal_access_tokens.html#using-a-personal-access-token-id
+func (s *PersonalAccessTokensService) GetSinglePersonalAccessTokenByID(token int, options ...RequestOptionFunc) (*PersonalAccessToken, *Response, error) {
+	u := fmt.Sprintf("personal_access_tokens/%d", token)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pat := new(PersonalAccessToken)
+	resp, err := s.client.Do(req, pat)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pat, resp, err
+}
+
+// GetSinglePersonalAccessToken gets the personal access token the client
+// authenticates with, for example to check its scopes and expiry date.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/personal_access_tokens.html#using-a-request-header
+func (s *PersonalAccessTokensService) GetSinglePersonalAccessToken(options ...RequestOptionFunc) (*PersonalAccessToken, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "personal_access_tokens/self", nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pat := new(PersonalAccessToken)
+	resp, err := s.client.Do(req, pat)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pat, resp, err
+}
+
+// RotatePersonalAccessTokenOptions represents the available
+// RotatePersonalAccessToken() and RotatePersonalAccessTokenSelf() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/personal_access_tokens.html#rotate-a-personal-access-token
+type RotatePersonalAccessTokenOptions struct {
+	ExpiresAt *ISOTime `url:"expires_at,omitempty" json:"expires_at,omitempty"`
+}
+
+// RotatePersonalAccessToken revokes a personal access token and returns a
+// new token, which expires at the given date or after a week.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/personal_access_tokens.html#rotate-a-personal-access-token
+func (s *PersonalAccessTokensService) RotatePersonalAccessToken(token int, opt *RotatePersonalAccessTokenOptions, options ...RequestOptionFunc) (*PersonalAccessToken, *Response, error) {
+	u := fmt.Sprintf("personal_access_tokens/%d/rotate", token)
+	return s.rotatePersonalAccessToken(u, opt, options)
+}
+
+// RotatePersonalAccessTokenSelf rotates the personal access token the client
+// authenticates with. The current token is revoked, so the client must use
+// the returned token for all further requests. This requires the api or
+// self_rotate scope.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/personal_access_tokens.html#rotate-a-personal-access-token
+func (s *PersonalAccessTokensService) RotatePersonalAccessTokenSelf(opt *RotatePersonalAccessTokenOptions, options ...RequestOptionFunc) (*PersonalAccessToken, *Response, error) {
+	return s.rotatePersonalAccessToken("personal_access_tokens/self/rotate", opt, options)
+}
+
+func (s *PersonalAccessTokensService) rotatePersonalAccessToken(u string, opt *RotatePersonalAccessTokenOptions, options []RequestOptionFunc) (*PersonalAccessToken, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodPost, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pat := new(PersonalAccessToken)
+	resp, err := s.client.Do(req, pat)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pat, resp, err
+}
+
+// RevokePersonalAccessToken revokes a personal access token by ID.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/personal_access_tokens.html#using-a-personal-access-token-id-1
+func (s *PersonalAccessTokensService) RevokePersonalAccessToken(token int, options ...RequestOptionFunc) (*Response, error) {
+	u := fmt.Sprintf("personal_access_tokens/%d", token)
+
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// RevokePersonalAccessTokenSelf revokes the personal access token the client
+// authenticates with.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/personal_access_tokens.html#using-a-request-header-1
+func (s *PersonalAccessTokensService) RevokePersonalAccessTokenSelf(options ...RequestOptionFunc) (*Response, error) {
+	req, err := s.client.NewRequest(http.MethodDelete, "personal_access_tokens/self", nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -0,0 +1,160 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestListPersonalAccessTokens(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/personal_access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/personal_access_tokens?state=active&user_id=1")
+		fmt.Fprint(w, `[{"id":4,"name":"Test Token","revoked":false,"scopes":["api"],"active":true,"user_id":3,"expires_at":"2024-06-01"}]`)
+	})
+
+	pats, _, err := client.PersonalAccessTokens.ListPersonalAccessTokens(&ListPersonalAccessTokensOptions{State: String("active"), UserID: Int(1)})
+	if err != nil {
+		t.Fatalf("PersonalAccessTokens.ListPersonalAccessTokens returned error: %v", err)
+	}
+
+	expiresAt := ISOTime(time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC))
+	want := []*PersonalAccessToken{{ID: 4, Name: "Test Token", Scopes: []string{"api"}, Active: true, UserID: 3, ExpiresAt: &expiresAt}}
+	if !reflect.DeepEqual(want, pats) {
+		t.Errorf("PersonalAccessTokens.ListPersonalAccessTokens returned %+v, want %+v", pats, want)
+	}
+}
+
+func TestGetSinglePersonalAccessTokenByID(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/personal_access_tokens/4", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":4,"name":"Test Token","scopes":["api"],"active":true,"user_id":3}`)
+	})
+
+	pat, _, err := client.PersonalAccessTokens.GetSinglePersonalAccessTokenByID(4)
+	if err != nil {
+		t.Fatalf("PersonalAccessTokens.GetSinglePersonalAccessTokenByID returned error: %v", err)
+	}
+
+	want := &PersonalAccessToken{ID: 4, Name: "Test Token", Scopes: []string{"api"}, Active: true, UserID: 3}
+	if !reflect.DeepEqual(want, pat) {
+		t.Errorf("PersonalAccessTokens.GetSinglePersonalAccessTokenByID returned %+v, want %+v", pat, want)
+	}
+}
+
+func TestGetSinglePersonalAccessToken(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/personal_access_tokens/self", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":5,"name":"self","scopes":["api","self_rotate"],"active":true,"user_id":3,"expires_at":"2024-06-01"}`)
+	})
+
+	pat, _, err := client.PersonalAccessTokens.GetSinglePersonalAccessToken()
+	if err != nil {
+		t.Fatalf("PersonalAccessTokens.GetSinglePersonalAccessToken returned error: %v", err)
+	}
+
+	expiresAt := ISOTime(time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC))
+	want := &PersonalAccessToken{ID: 5, Name: "self", Scopes: []string{"api", "self_rotate"}, Active: true, UserID: 3, ExpiresAt: &expiresAt}
+	if !reflect.DeepEqual(want, pat) {
+		t.Errorf("PersonalAccessTokens.GetSinglePersonalAccessToken returned %+v, want %+v", pat, want)
+	}
+}
+
+func TestRotatePersonalAccessToken(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/personal_access_tokens/4/rotate", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"expires_at":"2024-07-01"}`)
+		fmt.Fprint(w, `{"id":6,"name":"Test Token","scopes":["api"],"active":true,"user_id":3,"expires_at":"2024-07-01","token":"glpat-new"}`)
+	})
+
+	expiresAt := ISOTime(time.Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC))
+	pat, _, err := client.PersonalAccessTokens.RotatePersonalAccessToken(4, &RotatePersonalAccessTokenOptions{ExpiresAt: &expiresAt})
+	if err != nil {
+		t.Fatalf("PersonalAccessTokens.RotatePersonalAccessToken returned error: %v", err)
+	}
+
+	want := &PersonalAccessToken{ID: 6, Name: "Test Token", Scopes: []string{"api"}, Active: true, UserID: 3, ExpiresAt: &expiresAt, Token: "glpat-new"}
+	if !reflect.DeepEqual(want, pat) {
+		t.Errorf("PersonalAccessTokens.RotatePersonalAccessToken returned %+v, want %+v", pat, want)
+	}
+}
+
+func TestRotatePersonalAccessTokenSelf(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/personal_access_tokens/self/rotate", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"id":7,"name":"self","active":true,"user_id":3,"token":"glpat-rotated"}`)
+	})
+
+	pat, _, err := client.PersonalAccessTokens.RotatePersonalAccessTokenSelf(nil)
+	if err != nil {
+		t.Fatalf("PersonalAccessTokens.RotatePersonalAccessTokenSelf returned error: %v", err)
+	}
+
+	want := &PersonalAccessToken{ID: 7, Name: "self", Active: true, UserID: 3, Token: "glpat-rotated"}
+	if !reflect.DeepEqual(want, pat) {
+		t.Errorf("PersonalAccessTokens.RotatePersonalAccessTokenSelf returned %+v, want %+v", pat, want)
+	}
+}
+
+func TestRevokePersonalAccessTokenByID(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/personal_access_tokens/4", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.PersonalAccessTokens.RevokePersonalAccessToken(4)
+	if err != nil {
+		t.Errorf("PersonalAccessTokens.RevokePersonalAccessToken returned error: %v", err)
+	}
+}
+
+func TestRevokePersonalAccessTokenSelf(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/personal_access_tokens/self", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.PersonalAccessTokens.RevokePersonalAccessTokenSelf()
+	if err != nil {
+		t.Errorf("PersonalAccessTokens.RevokePersonalAccessTokenSelf returned error: %v", err)
+	}
+}
@@ -131,6 +131,7 @@ func (p PipelineTestReport) String() string {
 // on other assets, like Commit.
 type PipelineInfo struct {
 	ID        int        `json:"id"`
+	ProjectID int        `json:"project_id"`
 	Status    string     `json:"status"`
 	Ref       string     `json:"ref"`
 	SHA       string     `json:"sha"`
@@ -143,22 +144,45 @@ func (p PipelineInfo) String() string {
 	return Stringify(p)
 }
 
+// PipelineOrderByValue represents a column pipelines can be ordered by.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/pipelines.html#list-project-pipelines
+type PipelineOrderByValue string
+
+// These constants represent all valid columns to order pipelines by.
+const (
+	PipelineOrderByID        PipelineOrderByValue = "id"
+	PipelineOrderByStatus    PipelineOrderByValue = "status"
+	PipelineOrderByRef       PipelineOrderByValue = "ref"
+	PipelineOrderByUpdatedAt PipelineOrderByValue = "updated_at"
+	PipelineOrderByUserID    PipelineOrderByValue = "user_id"
+)
+
+// PipelineOrderBy is a helper routine that allocates a new PipelineOrderByValue
+// to store v and returns a pointer to it.
+func PipelineOrderBy(v PipelineOrderByValue) *PipelineOrderByValue {
+	p := new(PipelineOrderByValue)
+	*p = v
+	return p
+}
+
 // ListProjectPipelinesOptions represents the available ListProjectPipelines() options.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/pipelines.html#list-project-pipelines
 type ListProjectPipelinesOptions struct {
 	ListOptions
-	Scope         *string          `url:"scope,omitempty" json:"scope,omitempty"`
-	Status        *BuildStateValue `url:"status,omitempty" json:"status,omitempty"`
-	Ref           *string          `url:"ref,omitempty" json:"ref,omitempty"`
-	SHA           *string          `url:"sha,omitempty" json:"sha,omitempty"`
-	YamlErrors    *bool            `url:"yaml_errors,omitempty" json:"yaml_errors,omitempty"`
-	Name          *string          `url:"name,omitempty" json:"name,omitempty"`
-	Username      *string          `url:"username,omitempty" json:"username,omitempty"`
-	UpdatedAfter  *time.Time       `url:"updated_after,omitempty" json:"updated_after,omitempty"`
-	UpdatedBefore *time.Time       `url:"updated_before,omitempty" json:"updated_before,omitempty"`
-	OrderBy       *string          `url:"order_by,omitempty" json:"order_by,omitempty"`
-	Sort          *string          `url:"sort,omitempty" json:"sort,omitempty"`
+	Scope         *string               `url:"scope,omitempty" json:"scope,omitempty"`
+	Status        *BuildStateValue      `url:"status,omitempty" json:"status,omitempty"`
+	Ref           *string               `url:"ref,omitempty" json:"ref,omitempty"`
+	SHA           *string               `url:"sha,omitempty" json:"sha,omitempty"`
+	YamlErrors    *bool                 `url:"yaml_errors,omitempty" json:"yaml_errors,omitempty"`
+	Name          *string               `url:"name,omitempty" json:"name,omitempty"`
+	Username      *string               `url:"username,omitempty" json:"username,omitempty"`
+	UpdatedAfter  *time.Time            `url:"updated_after,omitempty" json:"updated_after,omitempty"`
+	UpdatedBefore *time.Time            `url:"updated_before,omitempty" json:"updated_before,omitempty"`
+	OrderBy       *PipelineOrderByValue `url:"order_by,omitempty" json:"order_by,omitempty"`
+	Sort          *SortValue            `url:"sort,omitempty" json:"sort,omitempty"`
 }
 
 // ListProjectPipelines gets a list of project piplines.
@@ -209,6 +233,94 @@ func (s *PipelinesService) GetPipeline(pid interface{}, pipeline int, options ..
 	return p, resp, err
 }
 
+// GetLatestPipelineOptions represents the available GetLatestPipeline() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipelines.html#get-the-latest-pipeline
+type GetLatestPipelineOptions struct {
+	Ref *string `url:"ref,omitempty" json:"ref,omitempty"`
+}
+
+// GetLatestPipeline gets the latest pipeline for a specific ref in a project.
+// If no ref is given, the latest pipeline of the default branch is returned.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipelines.html#get-the-latest-pipeline
+func (s *PipelinesService) GetLatestPipeline(pid interface{}, opt *GetLatestPipelineOptions, options ...RequestOptionFunc) (*Pipeline, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/pipelines/latest", pathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	p := new(Pipeline)
+	resp, err := s.client.Do(req, p)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return p, resp, err
+}
+
+// DownstreamPipelineFunc is the function called by WalkDownstreamPipelines
+// for every bridge (trigger job) found. The project is the project of the
+// pipeline containing the bridge.
+type DownstreamPipelineFunc func(project interface{}, bridge *Bridge) error
+
+// WalkDownstreamPipelines traverses the graph of downstream pipelines of the
+// given pipeline, depth-first. The given function is called for every bridge
+// (trigger job), after which the downstream pipeline created by the bridge,
+// if any, is traversed as well. Multi-project pipelines are traversed using
+// the project ID of the downstream pipeline.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/jobs.html#list-pipeline-bridges
+func (s *PipelinesService) WalkDownstreamPipelines(pid interface{}, pipeline int, fn DownstreamPipelineFunc, options ...RequestOptionFunc) error {
+	return s.walkDownstreamPipelines(pid, pipeline, fn, make(map[int]bool), options)
+}
+
+func (s *PipelinesService) walkDownstreamPipelines(pid interface{}, pipeline int, fn DownstreamPipelineFunc, seen map[int]bool, options []RequestOptionFunc) error {
+	if seen[pipeline] {
+		return nil
+	}
+	seen[pipeline] = true
+
+	opt := &ListJobsOptions{ListOptions: ListOptions{PerPage: 100}}
+	for {
+		bridges, resp, err := s.client.Jobs.ListPipelineBridges(pid, pipeline, opt, options...)
+		if err != nil {
+			return err
+		}
+
+		for _, b := range bridges {
+			if err := fn(pid, b); err != nil {
+				return err
+			}
+			if b.DownstreamPipeline == nil {
+				continue
+			}
+
+			var downstream interface{} = pid
+			if b.DownstreamPipeline.ProjectID != 0 {
+				downstream = b.DownstreamPipeline.ProjectID
+			}
+			if err := s.walkDownstreamPipelines(downstream, b.DownstreamPipeline.ID, fn, seen, options); err != nil {
+				return err
+			}
+		}
+
+		if resp.NextPage == 0 {
+			return nil
+		}
+		opt.Page = resp.NextPage
+	}
+}
+
 // GetPipelineVariables gets the variables of a single project pipeline.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/pipelines.html#get-variables-of-a-pipeline
@@ -236,14 +348,14 @@ func (s *PipelinesService) GetPipelineVariables(pid interface{}, pipeline int, o
 // GetPipelineTestReport gets the test report of a single project pipeline.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/pipelines.html#get-a-pipelines-test-report
-func (s *PipelinesService) GetPipelineTestReport(pid interface{}, pipeline int) (*PipelineTestReport, *Response, error) {
+func (s *PipelinesService) GetPipelineTestReport(pid interface{}, pipeline int, options ...RequestOptionFunc) (*PipelineTestReport, *Response, error) {
 	project, err := parseID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
 	u := fmt.Sprintf("projects/%s/pipelines/%d/test_report", pathEscape(project), pipeline)
 
-	req, err := s.client.NewRequest(http.MethodGet, u, nil, nil)
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -317,7 +429,7 @@ func (s *PipelinesService) RetryPipelineBuild(pid interface{}, pipeline int, opt
 // CancelPipelineBuild cancels a pipeline builds
 //
 // GitLab API docs:
-//https://docs.gitlab.com/ce/api/pipelines.html#cancel-a-pipelines-builds
+// https://docs.gitlab.com/ce/api/pipelines.html#cancel-a-pipelines-builds
 func (s *PipelinesService) CancelPipelineBuild(pid interface{}, pipeline int, options ...RequestOptionFunc) (*Pipeline, *Response, error) {
 	project, err := parseID(pid)
 	if err != nil {
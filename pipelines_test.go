@@ -261,3 +261,57 @@ func TestDeletePipeline(t *testing.T) {
 		t.Errorf("Pipelines.DeletePipeline returned error: %v", err)
 	}
 }
+
+func TestGetLatestPipeline(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/pipelines/latest", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/projects/1/pipelines/latest?ref=main")
+		fmt.Fprint(w, `{"id":1,"status":"success","ref":"main"}`)
+	})
+
+	pipeline, _, err := client.Pipelines.GetLatestPipeline(1, &GetLatestPipelineOptions{Ref: String("main")})
+	if err != nil {
+		t.Errorf("Pipelines.GetLatestPipeline returned error: %v", err)
+	}
+
+	want := &Pipeline{ID: 1, Status: "success", Ref: "main"}
+	if !reflect.DeepEqual(want, pipeline) {
+		t.Errorf("Pipelines.GetLatestPipeline returned %+v, want %+v", pipeline, want)
+	}
+}
+
+func TestWalkDownstreamPipelines(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/pipelines/10/bridges", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[
+			{"id":100,"name":"child","downstream_pipeline":{"id":11,"project_id":1}},
+			{"id":101,"name":"deploy","downstream_pipeline":{"id":20,"project_id":2}}
+		]`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/pipelines/11/bridges", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[]`)
+	})
+	mux.HandleFunc("/api/v4/projects/2/pipelines/20/bridges", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":200,"name":"pending"}]`)
+	})
+
+	var visited []string
+	err := client.Pipelines.WalkDownstreamPipelines(1, 10, func(project interface{}, bridge *Bridge) error {
+		visited = append(visited, fmt.Sprintf("%v/%s", project, bridge.Name))
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Pipelines.WalkDownstreamPipelines returned error: %v", err)
+	}
+
+	want := []string{"1/child", "1/deploy", "2/pending"}
+	if !reflect.DeepEqual(want, visited) {
+		t.Errorf("Pipelines.WalkDownstreamPipelines visited %v, want %v", visited, want)
+	}
+}
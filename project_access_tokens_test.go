@@ -17,6 +17,7 @@
 package gitlab
 
 import (
+	"fmt"
 	"net/http"
 	"reflect"
 	"testing"
@@ -107,6 +108,36 @@ func TestCreateProjectAccessToken(t *testing.T) {
 	}
 }
 
+func TestRotateProjectAccessToken(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/access_tokens/42/rotate", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"expires_at":"2024-06-01"}`)
+		fmt.Fprint(w, `{"id":43,"user_id":2453,"name":"ci","scopes":["api"],"expires_at":"2024-06-01","active":true,"token":"glpat-rotated"}`)
+	})
+
+	expiresAt := ISOTime(time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC))
+	token, _, err := client.ProjectAccessTokens.RotateProjectAccessToken(1, 42, &RotateProjectAccessTokenOptions{ExpiresAt: &expiresAt})
+	if err != nil {
+		t.Fatalf("ProjectAccessTokens.RotateProjectAccessToken returned error: %v", err)
+	}
+
+	want := &ProjectAccessToken{
+		ID:        43,
+		UserID:    2453,
+		Name:      "ci",
+		Scopes:    []string{"api"},
+		ExpiresAt: &expiresAt,
+		Active:    true,
+		Token:     "glpat-rotated",
+	}
+	if !reflect.DeepEqual(want, token) {
+		t.Errorf("ProjectAccessTokens.RotateProjectAccessToken returned %+v, want %+v", token, want)
+	}
+}
+
 func TestDeleteProjectAccessToken(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
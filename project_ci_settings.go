@@ -0,0 +1,115 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// ProjectCISettingsService handles communication with the CI/CD settings of
+// projects. The settings are part of the project resource; this service gives
+// typed access to just the CI/CD related attributes.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/projects.html#edit-project
+type ProjectCISettingsService struct {
+	client *Client
+}
+
+// ProjectCISettings represents the CI/CD settings of a project.
+type ProjectCISettings struct {
+	CIConfigPath                             string `json:"ci_config_path"`
+	CIDefaultGitDepth                        int    `json:"ci_default_git_depth"`
+	CIForwardDeploymentEnabled               bool   `json:"ci_forward_deployment_enabled"`
+	CISeparatedCaches                        bool   `json:"ci_separated_caches"`
+	CIAllowForkPipelinesToRunInParentProject bool   `json:"ci_allow_fork_pipelines_to_run_in_parent_project"`
+	BuildGitStrategy                         string `json:"build_git_strategy"`
+	BuildTimeout                             int    `json:"build_timeout"`
+	BuildCoverageRegex                       string `json:"build_coverage_regex"`
+	AutoCancelPendingPipelines               string `json:"auto_cancel_pending_pipelines"`
+	PublicBuilds                             bool   `json:"public_builds"`
+}
+
+func (s ProjectCISettings) String() string {
+	return Stringify(s)
+}
+
+// GetProjectCISettings gets the CI/CD settings of a project.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/projects.html#get-single-project
+func (s *ProjectCISettingsService) GetProjectCISettings(pid interface{}, options ...RequestOptionFunc) (*ProjectCISettings, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s", pathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ps := new(ProjectCISettings)
+	resp, err := s.client.Do(req, ps)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ps, resp, err
+}
+
+// UpdateProjectCISettingsOptions represents the available
+// UpdateProjectCISettings() options.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/projects.html#edit-project
+type UpdateProjectCISettingsOptions struct {
+	CIConfigPath                             *string `url:"ci_config_path,omitempty" json:"ci_config_path,omitempty"`
+	CIDefaultGitDepth                        *int    `url:"ci_default_git_depth,omitempty" json:"ci_default_git_depth,omitempty"`
+	CIForwardDeploymentEnabled               *bool   `url:"ci_forward_deployment_enabled,omitempty" json:"ci_forward_deployment_enabled,omitempty"`
+	CISeparatedCaches                        *bool   `url:"ci_separated_caches,omitempty" json:"ci_separated_caches,omitempty"`
+	CIAllowForkPipelinesToRunInParentProject *bool   `url:"ci_allow_fork_pipelines_to_run_in_parent_project,omitempty" json:"ci_allow_fork_pipelines_to_run_in_parent_project,omitempty"`
+	BuildGitStrategy                         *string `url:"build_git_strategy,omitempty" json:"build_git_strategy,omitempty"`
+	BuildTimeout                             *int    `url:"build_timeout,omitempty" json:"build_timeout,omitempty"`
+	BuildCoverageRegex                       *string `url:"build_coverage_regex,omitempty" json:"build_coverage_regex,omitempty"`
+	AutoCancelPendingPipelines               *string `url:"auto_cancel_pending_pipelines,omitempty" json:"auto_cancel_pending_pipelines,omitempty"`
+	PublicBuilds                             *bool   `url:"public_builds,omitempty" json:"public_builds,omitempty"`
+}
+
+// UpdateProjectCISettings updates the CI/CD settings of a project, leaving
+// all other project settings untouched.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/projects.html#edit-project
+func (s *ProjectCISettingsService) UpdateProjectCISettings(pid interface{}, opt *UpdateProjectCISettingsOptions, options ...RequestOptionFunc) (*ProjectCISettings, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s", pathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodPut, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ps := new(ProjectCISettings)
+	resp, err := s.client.Do(req, ps)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ps, resp, err
+}
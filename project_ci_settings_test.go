@@ -0,0 +1,89 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestGetProjectCISettings(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+			"id": 1,
+			"name": "project",
+			"ci_config_path": "ci/pipeline.yml",
+			"ci_separated_caches": true,
+			"ci_allow_fork_pipelines_to_run_in_parent_project": false,
+			"ci_forward_deployment_enabled": true,
+			"build_timeout": 3600,
+			"auto_cancel_pending_pipelines": "enabled"
+		}`)
+	})
+
+	settings, _, err := client.ProjectCISettings.GetProjectCISettings(1)
+	if err != nil {
+		t.Fatalf("ProjectCISettings.GetProjectCISettings returned error: %v", err)
+	}
+
+	want := &ProjectCISettings{
+		CIConfigPath:               "ci/pipeline.yml",
+		CISeparatedCaches:          true,
+		CIForwardDeploymentEnabled: true,
+		BuildTimeout:               3600,
+		AutoCancelPendingPipelines: "enabled",
+	}
+	if !reflect.DeepEqual(want, settings) {
+		t.Errorf("ProjectCISettings.GetProjectCISettings returned %+v, want %+v", settings, want)
+	}
+}
+
+func TestUpdateProjectCISettings(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"ci_config_path":"ci/pipeline.yml","ci_allow_fork_pipelines_to_run_in_parent_project":true,"build_timeout":7200}`)
+		fmt.Fprint(w, `{"id":1,"ci_config_path":"ci/pipeline.yml","ci_allow_fork_pipelines_to_run_in_parent_project":true,"build_timeout":7200}`)
+	})
+
+	opt := &UpdateProjectCISettingsOptions{
+		CIConfigPath:                             String("ci/pipeline.yml"),
+		CIAllowForkPipelinesToRunInParentProject: Bool(true),
+		BuildTimeout:                             Int(7200),
+	}
+	settings, _, err := client.ProjectCISettings.UpdateProjectCISettings(1, opt)
+	if err != nil {
+		t.Fatalf("ProjectCISettings.UpdateProjectCISettings returned error: %v", err)
+	}
+
+	want := &ProjectCISettings{
+		CIConfigPath:                             "ci/pipeline.yml",
+		CIAllowForkPipelinesToRunInParentProject: true,
+		BuildTimeout:                             7200,
+	}
+	if !reflect.DeepEqual(want, settings) {
+		t.Errorf("ProjectCISettings.UpdateProjectCISettings returned %+v, want %+v", settings, want)
+	}
+}
@@ -17,10 +17,25 @@
 package gitlab
 
 import (
+	"archive/tar"
 	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"time"
+
+	"github.com/google/go-querystring/query"
 )
 
 // ProjectImportExportService handles communication with the project
@@ -37,34 +52,62 @@ type ProjectImportExportService struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/project_import_export.html#import-status
 type ImportStatus struct {
-	ID                int        `json:"id"`
-	Description       string     `json:"description"`
-	Name              string     `json:"name"`
-	NameWithNamespace string     `json:"name_with_namespace"`
-	Path              string     `json:"path"`
-	PathWithNamespace string     `json:"path_with_namespace"`
-	CreateAt          *time.Time `json:"create_at"`
-	ImportStatus      string     `json:"import_status"`
+	ID                int                     `json:"id"`
+	Description       string                  `json:"description"`
+	Name              string                  `json:"name"`
+	NameWithNamespace string                  `json:"name_with_namespace"`
+	Path              string                  `json:"path"`
+	PathWithNamespace string                  `json:"path_with_namespace"`
+	CreateAt          *time.Time              `json:"create_at"`
+	ImportStatus      ImportStatusValue       `json:"import_status"`
+	ImportError       string                  `json:"import_error"`
+	CorrelationID     string                  `json:"correlation_id"`
+	FailedRelations   []*ImportFailedRelation `json:"failed_relations"`
+	Stats             *ImportStats            `json:"stats"`
 }
 
 func (s ImportStatus) String() string {
 	return Stringify(s)
 }
 
+// ImportFailedRelation represents a relation that failed to import.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_import_export.html#import-status
+type ImportFailedRelation struct {
+	ID               int        `json:"id"`
+	CreatedAt        *time.Time `json:"created_at"`
+	ExceptionClass   string     `json:"exception_class"`
+	ExceptionMessage string     `json:"exception_message"`
+	Source           string     `json:"source"`
+	RelationName     string     `json:"relation_name"`
+	LineNumber       int        `json:"line_number"`
+}
+
+// ImportStats represents the number of fetched and imported objects per
+// object type of an import.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_import_export.html#import-status
+type ImportStats struct {
+	Fetched  map[string]int `json:"fetched"`
+	Imported map[string]int `json:"imported"`
+}
+
 // ExportStatus represents a project export status.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/project_import_export.html#export-status
 type ExportStatus struct {
-	ID                int        `json:"id"`
-	Description       string     `json:"description"`
-	Name              string     `json:"name"`
-	NameWithNamespace string     `json:"name_with_namespace"`
-	Path              string     `json:"path"`
-	PathWithNamespace string     `json:"path_with_namespace"`
-	CreatedAt         *time.Time `json:"created_at"`
-	ExportStatus      string     `json:"export_status"`
-	Message           string     `json:"message"`
+	ID                int               `json:"id"`
+	Description       string            `json:"description"`
+	Name              string            `json:"name"`
+	NameWithNamespace string            `json:"name_with_namespace"`
+	Path              string            `json:"path"`
+	PathWithNamespace string            `json:"path_with_namespace"`
+	CreatedAt         *time.Time        `json:"created_at"`
+	ExportStatus      ExportStatusValue `json:"export_status"`
+	Message           string            `json:"message"`
 	Links             struct {
 		APIURL string `json:"api_url"`
 		WebURL string `json:"web_url"`
@@ -156,6 +199,129 @@ func (s *ProjectImportExportService) ExportDownload(pid interface{}, options ...
 	return b.Bytes(), resp, err
 }
 
+// ExportDownloadStream downloads the finished export of a project and writes
+// it to w while it is received, so large exports don't have to be kept in
+// memory. To stop the download early, return an error from the writer or
+// cancel the request context.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_import_export.html#export-download
+func (s *ProjectImportExportService) ExportDownloadStream(pid interface{}, w io.Writer, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/export/download", pathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, w)
+}
+
+// ExportAndDownloadOptions represents the available ExportAndDownload()
+// options.
+type ExportAndDownloadOptions struct {
+	ScheduleExportOptions
+
+	// PollInterval is the time to wait before the first status check. The
+	// interval doubles after every check up to MaxPollInterval. Defaults to
+	// one second.
+	PollInterval time.Duration
+
+	// MaxPollInterval caps the time between two status checks. Defaults to
+	// thirty seconds.
+	MaxPollInterval time.Duration
+
+	// StatusFunc, when set, is called with every export status received
+	// while waiting for the export to finish.
+	StatusFunc func(*ExportStatus)
+
+	// ProgressFunc, when set, is called with the total number of bytes
+	// written to w after every write of the download.
+	ProgressFunc func(written int64)
+}
+
+// ExportAndDownload schedules an export of a project, waits until GitLab
+// finished generating it and streams the archive to w. Waiting stops when ctx
+// is done, the context is also used for all requests.
+//
+// When an upload URL is set in the options, GitLab uploads the archive itself
+// and nothing is written to w, which may be nil in that case.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_import_export.html#schedule-an-export
+func (s *ProjectImportExportService) ExportAndDownload(ctx context.Context, pid interface{}, w io.Writer, opt *ExportAndDownloadOptions, options ...RequestOptionFunc) (*Response, error) {
+	if opt == nil {
+		opt = new(ExportAndDownloadOptions)
+	}
+	wait := opt.PollInterval
+	if wait <= 0 {
+		wait = time.Second
+	}
+	maxWait := opt.MaxPollInterval
+	if maxWait <= 0 {
+		maxWait = 30 * time.Second
+	}
+	options = append(options, WithContext(ctx))
+
+	resp, err := s.ScheduleExport(pid, &opt.ScheduleExportOptions, options...)
+	if err != nil {
+		return resp, err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return resp, ctx.Err()
+		case <-time.After(wait):
+		}
+		if wait *= 2; wait > maxWait {
+			wait = maxWait
+		}
+
+		var es *ExportStatus
+		es, resp, err = s.ExportStatus(pid, options...)
+		if err != nil {
+			return resp, err
+		}
+		if opt.StatusFunc != nil {
+			opt.StatusFunc(es)
+		}
+		if es.ExportStatus.IsFinished() {
+			break
+		}
+		if es.ExportStatus.IsTerminal() {
+			return resp, fmt.Errorf("export of project %v failed: %s", pid, es.Message)
+		}
+	}
+
+	if opt.Upload.URL != nil {
+		return resp, nil
+	}
+	if opt.ProgressFunc != nil {
+		w = &progressWriter{w: w, fn: opt.ProgressFunc}
+	}
+
+	return s.ExportDownloadStream(pid, w, options...)
+}
+
+// progressWriter reports the number of bytes written through it.
+type progressWriter struct {
+	w       io.Writer
+	fn      func(int64)
+	written int64
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	n, err := p.w.Write(b)
+	p.written += int64(n)
+	p.fn(p.written)
+	return n, err
+}
+
 // ImportFileOptions represents the available ImportFile() options.
 //
 // GitLab API docs:
@@ -170,10 +336,137 @@ type ImportFileOptions struct {
 
 // ImportFile import a file.
 //
+// Deprecated: ImportFile only supports importing a file from disk, use
+// ImportFromReader instead.
+//
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/project_import_export.html#import-a-file
 func (s *ProjectImportExportService) ImportFile(opt *ImportFileOptions, options ...RequestOptionFunc) (*ImportStatus, *Response, error) {
-	req, err := s.client.NewRequest(http.MethodPost, "projects/import", opt, options)
+	if opt == nil || opt.File == nil || *opt.File == "" {
+		return nil, nil, fmt.Errorf("Missing required option: File")
+	}
+
+	f, err := os.Open(*opt.File)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	_, filename := filepath.Split(*opt.File)
+
+	return s.ImportFromReader(opt, f, filename, options...)
+}
+
+// ImportFromReader imports a project from an export archive read from r. The
+// File field of the options is ignored, the archive is uploaded using the
+// given filename. The archive is streamed while it is uploaded, so it is
+// never buffered in memory, but the request isn't retried once the upload
+// started.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_import_export.html#import-a-file
+func (s *ProjectImportExportService) ImportFromReader(opt *ImportFileOptions, r io.Reader, filename string, options ...RequestOptionFunc) (*ImportStatus, *Response, error) {
+	if opt == nil {
+		opt = &ImportFileOptions{}
+	}
+
+	// Populate the additional fields.
+	fields := url.Values{}
+	if opt.Namespace != nil {
+		fields.Set("namespace", *opt.Namespace)
+	}
+	if opt.Path != nil {
+		fields.Set("path", *opt.Path)
+	}
+	if opt.Overwrite != nil {
+		fields.Set("overwrite", strconv.FormatBool(*opt.Overwrite))
+	}
+	if opt.OverrideParams != nil {
+		params, err := query.Values(opt.OverrideParams)
+		if err != nil {
+			return nil, nil, err
+		}
+		for k, vs := range params {
+			for _, v := range vs {
+				fields.Add(fmt.Sprintf("override_params[%s]", k), v)
+			}
+		}
+	}
+
+	req, err := s.client.NewRequest(http.MethodPost, "projects/import", nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = setMultipartBody(req, r, filename, fields); err != nil {
+		return nil, nil, err
+	}
+
+	is := new(ImportStatus)
+	resp, err := s.client.Do(req, is)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return is, resp, err
+}
+
+// RemoteImportOptions represents the available ImportFromURL() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_import_export.html#import-a-file-from-a-remote-object-storage
+type RemoteImportOptions struct {
+	URL            *string               `url:"url,omitempty" json:"url,omitempty"`
+	Path           *string               `url:"path,omitempty" json:"path,omitempty"`
+	Name           *string               `url:"name,omitempty" json:"name,omitempty"`
+	Namespace      *string               `url:"namespace,omitempty" json:"namespace,omitempty"`
+	Overwrite      *bool                 `url:"overwrite,omitempty" json:"overwrite,omitempty"`
+	OverrideParams *CreateProjectOptions `url:"override_params,omitempty" json:"override_params,omitempty"`
+}
+
+// ImportFromURL imports a project from an export archive GitLab downloads
+// from the given URL, so the archive doesn't have to be uploaded by the
+// client.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_import_export.html#import-a-file-from-a-remote-object-storage
+func (s *ProjectImportExportService) ImportFromURL(opt *RemoteImportOptions, options ...RequestOptionFunc) (*ImportStatus, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodPost, "projects/remote-import", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	is := new(ImportStatus)
+	resp, err := s.client.Do(req, is)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return is, resp, err
+}
+
+// RemoteImportS3Options represents the available ImportFromS3() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_import_export.html#import-a-file-from-aws-s3
+type RemoteImportS3Options struct {
+	AccessKeyID     *string `url:"access_key_id,omitempty" json:"access_key_id,omitempty"`
+	SecretAccessKey *string `url:"secret_access_key,omitempty" json:"secret_access_key,omitempty"`
+	Region          *string `url:"region,omitempty" json:"region,omitempty"`
+	BucketName      *string `url:"bucket_name,omitempty" json:"bucket_name,omitempty"`
+	FileKey         *string `url:"file_key,omitempty" json:"file_key,omitempty"`
+	Path            *string `url:"path,omitempty" json:"path,omitempty"`
+	Name            *string `url:"name,omitempty" json:"name,omitempty"`
+	Namespace       *string `url:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// ImportFromS3 imports a project from an export archive GitLab downloads
+// from an AWS S3 bucket.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_import_export.html#import-a-file-from-aws-s3
+func (s *ProjectImportExportService) ImportFromS3(opt *RemoteImportS3Options, options ...RequestOptionFunc) (*ImportStatus, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodPost, "projects/remote-import-s3", opt, options)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -211,3 +504,273 @@ func (s *ProjectImportExportService) ImportStatus(pid interface{}, options ...Re
 
 	return is, resp, err
 }
+
+// Errors returned when validating a project export archive.
+var (
+	ErrInvalidExportArchive      = errors.New("invalid project export archive")
+	ErrIncompatibleExportArchive = errors.New("incompatible project export archive")
+)
+
+// ExportArchiveInfo describes the contents of a project export archive.
+type ExportArchiveInfo struct {
+	// Version is the version of the import/export format.
+	Version string
+	// GitLabVersion and GitLabRevision identify the GitLab instance that
+	// created the archive. They are empty for archives of old GitLab
+	// versions.
+	GitLabVersion  string
+	GitLabRevision string
+	// NDJSON reports whether the relations are stored as newline-delimited
+	// JSON, instead of a single legacy project.json file.
+	NDJSON bool
+}
+
+func (i ExportArchiveInfo) String() string {
+	return Stringify(i)
+}
+
+// InspectExportArchive reads a project export archive from r and returns
+// information about it. An error wrapping ErrInvalidExportArchive is returned
+// when r is not a gzipped tar archive, or when the VERSION file or the
+// project attributes are missing.
+func InspectExportArchive(r io.Reader) (*ExportArchiveInfo, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidExportArchive, err)
+	}
+	defer gz.Close()
+
+	info := new(ExportArchiveInfo)
+	hasProject := false
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidExportArchive, err)
+		}
+
+		switch strings.TrimPrefix(path.Clean(hdr.Name), "./") {
+		case "VERSION":
+			info.Version, err = readExportArchiveFile(tr)
+		case "GITLAB_VERSION":
+			info.GitLabVersion, err = readExportArchiveFile(tr)
+		case "GITLAB_REVISION":
+			info.GitLabRevision, err = readExportArchiveFile(tr)
+		case "tree/project.json":
+			hasProject, info.NDJSON = true, true
+		case "project.json":
+			hasProject = true
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidExportArchive, err)
+		}
+	}
+
+	if info.Version == "" {
+		return nil, fmt.Errorf("%w: missing VERSION file", ErrInvalidExportArchive)
+	}
+	if !hasProject {
+		return nil, fmt.Errorf("%w: missing project attributes", ErrInvalidExportArchive)
+	}
+
+	return info, nil
+}
+
+// readExportArchiveFile reads a small text file of an export archive.
+func readExportArchiveFile(r io.Reader) (string, error) {
+	b, err := ioutil.ReadAll(io.LimitReader(r, 1024))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(b)), nil
+}
+
+// ValidateImportArchive inspects a project export archive read from r before
+// it is imported, so corrupt or incompatible archives are rejected without
+// uploading them. Next to the checks done by InspectExportArchive, the version
+// of the GitLab instance that created the archive is compared with the version
+// of the instance of the client: archives created by a newer GitLab version
+// can't be imported and result in an error wrapping
+// ErrIncompatibleExportArchive.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/user/project/settings/import_export.html#compatibility
+func (s *ProjectImportExportService) ValidateImportArchive(r io.Reader, options ...RequestOptionFunc) (*ExportArchiveInfo, *Response, error) {
+	info, err := InspectExportArchive(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	if info.GitLabVersion == "" {
+		return info, nil, nil
+	}
+
+	m, resp, err := s.client.Version.GetMetadata(options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if compareGitLabVersions(info.GitLabVersion, m.Version) > 0 {
+		return info, resp, fmt.Errorf("%w: archive was created by GitLab %s, the instance runs GitLab %s",
+			ErrIncompatibleExportArchive, info.GitLabVersion, m.Version)
+	}
+
+	return info, resp, nil
+}
+
+// compareGitLabVersions compares two GitLab versions like "16.4.1-ee" and
+// returns -1, 0 or 1 when a is older, equal to or newer than b.
+func compareGitLabVersions(a, b string) int {
+	pa, pb := parseGitLabVersion(a), parseGitLabVersion(b)
+	for i := range pa {
+		switch {
+		case pa[i] < pb[i]:
+			return -1
+		case pa[i] > pb[i]:
+			return 1
+		}
+	}
+	return 0
+}
+
+// parseGitLabVersion returns the major, minor and patch number of a GitLab
+// version, ignoring any suffix.
+func parseGitLabVersion(v string) [3]int {
+	var parts [3]int
+	if i := strings.IndexAny(v, "-+ "); i >= 0 {
+		v = v[:i]
+	}
+	for i, p := range strings.SplitN(v, ".", 3) {
+		parts[i], _ = strconv.Atoi(p)
+	}
+	return parts
+}
+
+// ExportRelationFunc is the function called by StreamExportRelations for every
+// selected relation found in a project export archive.
+type ExportRelationFunc func(relation string, r io.Reader) error
+
+// StreamExportRelations downloads the finished export of a project and calls
+// fn for each of the given relations found in the archive (for instance
+// "issues" or "merge_requests"), while the archive is being downloaded. The
+// archive is never written to disk or buffered in memory completely, and the
+// download is stopped as soon as all given relations are processed.
+//
+// Relations are stored as newline-delimited JSON, see DecodeNDJSON. The
+// project attributes themselves can be selected using the "project" relation.
+// If no relations are given, fn is called for all relations in the archive.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_import_export.html#export-download
+func (s *ProjectImportExportService) StreamExportRelations(pid interface{}, relations []string, fn ExportRelationFunc, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/export/download", pathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	pr, pw := io.Pipe()
+
+	var resp *Response
+	done := make(chan error, 1)
+	go func() {
+		var err error
+		resp, err = s.client.Do(req, pw)
+		pw.CloseWithError(err)
+		done <- err
+	}()
+
+	err = s.extractExportRelations(pr, relations, fn)
+
+	// Stop the download in case not the whole archive was read.
+	pr.CloseWithError(errExportExtractionDone)
+	if doErr := <-done; doErr != nil && !errors.Is(doErr, errExportExtractionDone) {
+		return resp, doErr
+	}
+
+	return resp, err
+}
+
+// errExportExtractionDone is used to stop the download of an export archive.
+var errExportExtractionDone = errors.New("export extraction done")
+
+// extractExportRelations reads a project export archive from r and calls fn
+// for the selected relations.
+func (s *ProjectImportExportService) extractExportRelations(r io.Reader, relations []string, fn ExportRelationFunc) error {
+	gz, err := s.client.newGzipReader(r)
+	if err != nil {
+		return err
+	}
+	defer gz.Close()
+
+	pending := make(map[string]bool, len(relations))
+	for _, rel := range relations {
+		pending[rel] = true
+	}
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		rel, ok := exportRelationName(hdr.Name)
+		if !ok || (len(relations) > 0 && !pending[rel]) {
+			continue
+		}
+
+		if err := fn(rel, tr); err != nil {
+			return err
+		}
+
+		delete(pending, rel)
+		if len(relations) > 0 && len(pending) == 0 {
+			return nil
+		}
+	}
+}
+
+// exportRelationName returns the relation name of a file in a project export
+// archive, if the file contains a relation.
+func exportRelationName(name string) (string, bool) {
+	name = strings.TrimPrefix(path.Clean(name), "./")
+	switch {
+	case name == "tree/project.json":
+		return "project", true
+	case strings.HasPrefix(name, "tree/project/") && strings.HasSuffix(name, ".ndjson"):
+		rel := strings.TrimSuffix(strings.TrimPrefix(name, "tree/project/"), ".ndjson")
+		return rel, !strings.Contains(rel, "/")
+	default:
+		return "", false
+	}
+}
+
+// DecodeNDJSON decodes the newline-delimited JSON records read from r, as
+// used for the relations in export archives, and calls fn for each record.
+func DecodeNDJSON(r io.Reader, fn func(record json.RawMessage) error) error {
+	dec := json.NewDecoder(r)
+	for {
+		var record json.RawMessage
+		if err := dec.Decode(&record); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if err := fn(record); err != nil {
+			return err
+		}
+	}
+}
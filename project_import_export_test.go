@@ -0,0 +1,505 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectImportStatus(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/import", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+			"id": 1,
+			"path_with_namespace": "gitlab-org/gitlab-test",
+			"import_status": "failed",
+			"import_error": "Import failed",
+			"correlation_id": "633432fd88f94c3d89cf2e1b5a5a3c91",
+			"failed_relations": [
+				{
+					"id": 42,
+					"created_at": "2020-04-02T14:48:59.526Z",
+					"exception_class": "RuntimeError",
+					"exception_message": "A failure occurred",
+					"source": "custom error context",
+					"relation_name": "merge_requests",
+					"line_number": 0
+				}
+			],
+			"stats": {
+				"fetched": {"issue": 10, "merge_request": 5},
+				"imported": {"issue": 10, "merge_request": 4}
+			}
+		}`)
+	})
+
+	is, _, err := client.ProjectImportExport.ImportStatus(1)
+	require.NoError(t, err)
+
+	createdAt := time.Date(2020, 4, 2, 14, 48, 59, 526000000, time.UTC)
+	want := &ImportStatus{
+		ID:                1,
+		PathWithNamespace: "gitlab-org/gitlab-test",
+		ImportStatus:      ImportFailed,
+		ImportError:       "Import failed",
+		CorrelationID:     "633432fd88f94c3d89cf2e1b5a5a3c91",
+		FailedRelations: []*ImportFailedRelation{{
+			ID:               42,
+			CreatedAt:        &createdAt,
+			ExceptionClass:   "RuntimeError",
+			ExceptionMessage: "A failure occurred",
+			Source:           "custom error context",
+			RelationName:     "merge_requests",
+		}},
+		Stats: &ImportStats{
+			Fetched:  map[string]int{"issue": 10, "merge_request": 5},
+			Imported: map[string]int{"issue": 10, "merge_request": 4},
+		},
+	}
+	assert.Equal(t, want, is)
+}
+
+func TestProjectExportStatus(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/export", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 1, "export_status": "regeneration_in_progress"}`)
+	})
+
+	es, _, err := client.ProjectImportExport.ExportStatus(1)
+	require.NoError(t, err)
+
+	assert.Equal(t, ExportRegenerationInProgress, es.ExportStatus)
+	assert.False(t, es.ExportStatus.IsTerminal())
+	assert.False(t, es.ExportStatus.IsFinished())
+}
+
+func TestImportExportStatusValues(t *testing.T) {
+	exports := map[ExportStatusValue][2]bool{
+		ExportNone:                   {true, false},
+		ExportQueued:                 {false, false},
+		ExportStarted:                {false, false},
+		ExportFinished:               {true, true},
+		ExportRegenerationInProgress: {false, false},
+	}
+	for s, want := range exports {
+		assert.Equal(t, want[0], s.IsTerminal(), "IsTerminal for export status %q", s)
+		assert.Equal(t, want[1], s.IsFinished(), "IsFinished for export status %q", s)
+	}
+
+	imports := map[ImportStatusValue][2]bool{
+		ImportNone:      {false, false},
+		ImportScheduled: {false, false},
+		ImportStarted:   {false, false},
+		ImportFinished:  {true, true},
+		ImportFailed:    {true, false},
+	}
+	for s, want := range imports {
+		assert.Equal(t, want[0], s.IsTerminal(), "IsTerminal for import status %q", s)
+		assert.Equal(t, want[1], s.IsFinished(), "IsFinished for import status %q", s)
+	}
+}
+
+// testExportArchive returns a gzipped tar archive with the given files.
+func testExportArchive(t *testing.T, files map[string]string, order []string) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for _, name := range order {
+		content := files[name]
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(content))}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func TestInspectExportArchive(t *testing.T) {
+	files := map[string]string{
+		"VERSION":           "0.2.4\n",
+		"GITLAB_VERSION":    "16.4.1-ee\n",
+		"GITLAB_REVISION":   "14d3a1d",
+		"tree/project.json": `{"description":"test"}`,
+	}
+	archive := testExportArchive(t, files, []string{"VERSION", "GITLAB_VERSION", "GITLAB_REVISION", "tree/project.json"})
+
+	info, err := InspectExportArchive(bytes.NewReader(archive))
+	require.NoError(t, err)
+	assert.Equal(t, &ExportArchiveInfo{
+		Version:        "0.2.4",
+		GitLabVersion:  "16.4.1-ee",
+		GitLabRevision: "14d3a1d",
+		NDJSON:         true,
+	}, info)
+
+	_, err = InspectExportArchive(strings.NewReader("not an archive"))
+	assert.True(t, errors.Is(err, ErrInvalidExportArchive))
+
+	archive = testExportArchive(t, files, []string{"tree/project.json"})
+	_, err = InspectExportArchive(bytes.NewReader(archive))
+	assert.True(t, errors.Is(err, ErrInvalidExportArchive))
+
+	archive = testExportArchive(t, files, []string{"VERSION"})
+	_, err = InspectExportArchive(bytes.NewReader(archive))
+	assert.True(t, errors.Is(err, ErrInvalidExportArchive))
+}
+
+func TestValidateImportArchive(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/metadata", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"version":"16.4.1-ee","revision":"14d3a1d"}`)
+	})
+
+	tests := []struct {
+		version string
+		wantErr bool
+	}{
+		{"16.3.0", false},
+		{"16.4.1-ee", false},
+		{"16.4.2", true},
+		{"17.0.0-pre", true},
+	}
+	for _, tt := range tests {
+		files := map[string]string{"VERSION": "0.2.4", "GITLAB_VERSION": tt.version, "tree/project.json": "{}"}
+		archive := testExportArchive(t, files, []string{"VERSION", "GITLAB_VERSION", "tree/project.json"})
+
+		_, _, err := client.ProjectImportExport.ValidateImportArchive(bytes.NewReader(archive))
+		assert.Equal(t, tt.wantErr, errors.Is(err, ErrIncompatibleExportArchive), tt.version)
+	}
+}
+
+func TestStreamExportRelations(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	files := map[string]string{
+		"VERSION":                            "0.2.4",
+		"tree/project.json":                  `{"description":"test"}`,
+		"tree/project/issues.ndjson":         "{\"iid\":1}\n{\"iid\":2}\n",
+		"tree/project/merge_requests.ndjson": "{\"iid\":3}\n",
+		"tree/project/labels.ndjson":         "{\"title\":\"bug\"}\n",
+	}
+	order := []string{"VERSION", "tree/project.json", "tree/project/issues.ndjson", "tree/project/merge_requests.ndjson", "tree/project/labels.ndjson"}
+	archive := testExportArchive(t, files, order)
+
+	mux.HandleFunc("/api/v4/projects/1/export/download", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		w.Write(archive)
+	})
+
+	var issues []json.RawMessage
+	var seen []string
+	_, err := client.ProjectImportExport.StreamExportRelations(1, []string{"issues", "merge_requests"}, func(relation string, r io.Reader) error {
+		seen = append(seen, relation)
+		if relation != "issues" {
+			return nil
+		}
+		return DecodeNDJSON(r, func(record json.RawMessage) error {
+			issues = append(issues, record)
+			return nil
+		})
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"issues", "merge_requests"}, seen)
+	assert.Equal(t, []json.RawMessage{json.RawMessage(`{"iid":1}`), json.RawMessage(`{"iid":2}`)}, issues)
+
+	// Without relations all relations are passed to the function.
+	seen = nil
+	_, err = client.ProjectImportExport.StreamExportRelations(1, nil, func(relation string, r io.Reader) error {
+		seen = append(seen, relation)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"project", "issues", "merge_requests", "labels"}, seen)
+}
+
+func TestStreamExportRelationsNotFound(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/export/download", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"404 Not found"}`)
+	})
+
+	resp, err := client.ProjectImportExport.StreamExportRelations(1, []string{"issues"}, func(relation string, r io.Reader) error {
+		t.Errorf("unexpected relation %s", relation)
+		return nil
+	})
+	require.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestExportDownloadStream(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	archive := bytes.Repeat([]byte("export"), 1024)
+
+	mux.HandleFunc("/api/v4/projects/1/export/download", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Write(archive)
+	})
+
+	var b bytes.Buffer
+	_, err := client.ProjectImportExport.ExportDownloadStream(1, &b)
+	require.NoError(t, err)
+	assert.Equal(t, archive, b.Bytes())
+}
+
+func TestExportAndDownload(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	archive := bytes.Repeat([]byte("export"), 1024)
+	var checks int32
+
+	mux.HandleFunc("/api/v4/projects/1/export", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			testBody(t, r, `{"description":"backup","upload":{}}`)
+			w.WriteHeader(http.StatusAccepted)
+			fmt.Fprint(w, `{"message": "202 Accepted"}`)
+		case http.MethodGet:
+			if atomic.AddInt32(&checks, 1) < 3 {
+				fmt.Fprint(w, `{"id": 1, "export_status": "started"}`)
+				return
+			}
+			fmt.Fprint(w, `{"id": 1, "export_status": "finished"}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+	mux.HandleFunc("/api/v4/projects/1/export/download", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		w.Write(archive)
+	})
+
+	var statuses []ExportStatusValue
+	var written int64
+	opt := &ExportAndDownloadOptions{
+		PollInterval: time.Millisecond,
+		StatusFunc:   func(es *ExportStatus) { statuses = append(statuses, es.ExportStatus) },
+		ProgressFunc: func(n int64) { written = n },
+	}
+	opt.Description = String("backup")
+
+	var b bytes.Buffer
+	_, err := client.ProjectImportExport.ExportAndDownload(context.Background(), 1, &b, opt)
+	require.NoError(t, err)
+	assert.Equal(t, archive, b.Bytes())
+	assert.Equal(t, int64(len(archive)), written)
+	assert.Equal(t, []ExportStatusValue{ExportStarted, ExportStarted, ExportFinished}, statuses)
+}
+
+func TestExportAndDownloadFailed(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/export", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		fmt.Fprint(w, `{"id": 1, "export_status": "none"}`)
+	})
+
+	opt := &ExportAndDownloadOptions{PollInterval: time.Millisecond}
+	_, err := client.ProjectImportExport.ExportAndDownload(context.Background(), 1, ioutil.Discard, opt)
+	assert.Error(t, err)
+}
+
+func TestExportAndDownloadCanceled(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/export", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		fmt.Fprint(w, `{"id": 1, "export_status": "queued"}`)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	opt := &ExportAndDownloadOptions{PollInterval: time.Millisecond, MaxPollInterval: 2 * time.Millisecond}
+	_, err := client.ProjectImportExport.ExportAndDownload(ctx, 1, ioutil.Discard, opt)
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestImportFromReader(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/import", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		require.NoError(t, r.ParseMultipartForm(1<<20))
+
+		assert.Equal(t, "group", r.FormValue("namespace"))
+		assert.Equal(t, "project", r.FormValue("path"))
+		assert.Equal(t, "true", r.FormValue("overwrite"))
+		assert.Equal(t, "Imported", r.FormValue("override_params[description]"))
+
+		f, fh, err := r.FormFile("file")
+		require.NoError(t, err)
+		defer f.Close()
+		assert.Equal(t, "export.tar.gz", fh.Filename)
+
+		b, err := ioutil.ReadAll(f)
+		require.NoError(t, err)
+		assert.Equal(t, "archive", string(b))
+
+		fmt.Fprint(w, `{"id": 1, "path": "project", "import_status": "scheduled"}`)
+	})
+
+	opt := &ImportFileOptions{
+		Namespace:      String("group"),
+		Path:           String("project"),
+		Overwrite:      Bool(true),
+		OverrideParams: &CreateProjectOptions{Description: String("Imported")},
+	}
+
+	status, _, err := client.ProjectImportExport.ImportFromReader(opt, strings.NewReader("archive"), "export.tar.gz")
+	require.NoError(t, err)
+	assert.Equal(t, 1, status.ID)
+	assert.Equal(t, ImportScheduled, status.ImportStatus)
+}
+
+func TestImportFromReaderStreamsArchive(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	pr, pw := io.Pipe()
+	received := make(chan struct{})
+
+	var calls int32
+	mux.HandleFunc("/api/v4/projects/import", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+
+		mr, err := r.MultipartReader()
+		require.NoError(t, err)
+		part, err := mr.NextPart()
+		require.NoError(t, err)
+
+		// The first chunk of the archive arrives before the rest is written.
+		buf := make([]byte, 5)
+		_, err = io.ReadFull(part, buf)
+		require.NoError(t, err)
+		assert.Equal(t, "first", string(buf))
+		close(received)
+
+		rest, err := ioutil.ReadAll(part)
+		require.NoError(t, err)
+		assert.Equal(t, "-second", string(rest))
+
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	go func() {
+		pw.Write([]byte("first"))
+		select {
+		case <-received:
+		case <-time.After(5 * time.Second):
+			pw.CloseWithError(errors.New("archive buffered before uploading it"))
+			return
+		}
+		pw.Write([]byte("-second"))
+		pw.Close()
+	}()
+
+	// The archive was read while uploading it, so the failed request can't
+	// be retried.
+	_, _, err := client.ProjectImportExport.ImportFromReader(nil, pr, "export.tar.gz")
+	assert.True(t, errors.Is(err, errMultipartBodyRead), "unexpected error: %v", err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestImportFromURL(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/remote-import", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"url":"https://example.com/export.tar.gz","path":"project","namespace":"group"}`)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": 1, "path": "project", "import_status": "scheduled"}`)
+	})
+
+	opt := &RemoteImportOptions{
+		URL:       String("https://example.com/export.tar.gz"),
+		Path:      String("project"),
+		Namespace: String("group"),
+	}
+	is, _, err := client.ProjectImportExport.ImportFromURL(opt)
+	require.NoError(t, err)
+	assert.Equal(t, ImportScheduled, is.ImportStatus)
+}
+
+func TestImportFromS3(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/remote-import-s3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"access_key_id":"key","secret_access_key":"secret","region":"eu-west-1","bucket_name":"exports","file_key":"project.tar.gz","path":"project","namespace":"group"}`)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": 1, "path": "project", "import_status": "scheduled"}`)
+	})
+
+	opt := &RemoteImportS3Options{
+		AccessKeyID:     String("key"),
+		SecretAccessKey: String("secret"),
+		Region:          String("eu-west-1"),
+		BucketName:      String("exports"),
+		FileKey:         String("project.tar.gz"),
+		Path:            String("project"),
+		Namespace:       String("group"),
+	}
+	is, _, err := client.ProjectImportExport.ImportFromS3(opt)
+	require.NoError(t, err)
+	assert.Equal(t, ImportScheduled, is.ImportStatus)
+}
@@ -44,6 +44,7 @@ type ProjectMirror struct {
 	KeepDivergentRefs      bool       `json:"keep_divergent_refs"`
 	UpdateStatus           string     `json:"update_status"`
 	URL                    string     `json:"url"`
+	AuthMethod             string     `json:"auth_method"`
 }
 
 // ListProjectMirror gets a list of mirrors configured on the project.
@@ -81,6 +82,7 @@ type AddProjectMirrorOptions struct {
 	Enabled               *bool   `url:"enabled,omitempty" json:"enabled,omitempty"`
 	OnlyProtectedBranches *bool   `url:"only_protected_branches,omitempty" json:"only_protected_branches,omitempty"`
 	KeepDivergentRefs     *bool   `url:"keep_divergent_refs,omitempty" json:"keep_divergent_refs,omitempty"`
+	AuthMethod            *string `url:"auth_method,omitempty" json:"auth_method,omitempty"`
 }
 
 // AddProjectMirror creates a new mirror on the project.
@@ -114,9 +116,10 @@ func (s *ProjectMirrorService) AddProjectMirror(pid interface{}, opt *AddProject
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/remote_mirrors.html#update-a-remote-mirrors-attributes
 type EditProjectMirrorOptions struct {
-	Enabled               *bool `url:"enabled,omitempty" json:"enabled,omitempty"`
-	OnlyProtectedBranches *bool `url:"only_protected_branches,omitempty" json:"only_protected_branches,omitempty"`
-	KeepDivergentRefs     *bool `url:"keep_divergent_refs,omitempty" json:"keep_divergent_refs,omitempty"`
+	Enabled               *bool   `url:"enabled,omitempty" json:"enabled,omitempty"`
+	OnlyProtectedBranches *bool   `url:"only_protected_branches,omitempty" json:"only_protected_branches,omitempty"`
+	KeepDivergentRefs     *bool   `url:"keep_divergent_refs,omitempty" json:"keep_divergent_refs,omitempty"`
+	AuthMethod            *string `url:"auth_method,omitempty" json:"auth_method,omitempty"`
 }
 
 // EditProjectMirror updates a project team member to a specified access level..
@@ -143,3 +146,39 @@ func (s *ProjectMirrorService) EditProjectMirror(pid interface{}, mirror int, op
 
 	return pm, resp, err
 }
+
+// ProjectMirrorPublicKey represents the SSH public key of a project mirror
+// that uses SSH public key authentication.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/remote_mirrors.html#get-a-single-projects-remote-mirror-public-key
+type ProjectMirrorPublicKey struct {
+	PublicKey string `json:"public_key"`
+}
+
+// GetProjectMirrorPublicKey gets the SSH public key of a project mirror. The
+// key must be added as a deploy key (with write access) to the target
+// repository to allow GitLab to push to it.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/remote_mirrors.html#get-a-single-projects-remote-mirror-public-key
+func (s *ProjectMirrorService) GetProjectMirrorPublicKey(pid interface{}, mirror int, options ...RequestOptionFunc) (*ProjectMirrorPublicKey, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/remote_mirrors/%d/public_key", pathEscape(project), mirror)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pk := new(ProjectMirrorPublicKey)
+	resp, err := s.client.Do(req, pk)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pk, resp, err
+}
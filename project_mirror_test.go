@@ -0,0 +1,59 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetProjectMirrorPublicKey(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/42/remote_mirrors/101486/public_key", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"public_key": "ssh-rsa AAAA example"}`)
+	})
+
+	pk, _, err := client.ProjectMirrors.GetProjectMirrorPublicKey(42, 101486)
+	require.NoError(t, err)
+	assert.Equal(t, &ProjectMirrorPublicKey{PublicKey: "ssh-rsa AAAA example"}, pk)
+}
+
+func TestAddProjectMirrorWithAuthMethod(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/42/remote_mirrors", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"url":"ssh://git@example.com/foo.git","auth_method":"ssh_public_key"}`)
+		fmt.Fprint(w, `{"id": 1, "auth_method": "ssh_public_key"}`)
+	})
+
+	opt := &AddProjectMirrorOptions{
+		URL:        String("ssh://git@example.com/foo.git"),
+		AuthMethod: String("ssh_public_key"),
+	}
+	pm, _, err := client.ProjectMirrors.AddProjectMirror(42, opt)
+	require.NoError(t, err)
+	assert.Equal(t, "ssh_public_key", pm.AuthMethod)
+}
@@ -0,0 +1,219 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// ProjectRelationsExportService handles communication with the project
+// relations export related methods of the GitLab API. These endpoints are
+// used by migrations using direct transfer.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_relations_export.html
+type ProjectRelationsExportService struct {
+	client *Client
+}
+
+// RelationExportStatusValue represents the status of a relation export.
+type RelationExportStatusValue int
+
+// These constants represent all valid relation export statuses.
+const (
+	RelationExportFailed   RelationExportStatusValue = -1
+	RelationExportStarted  RelationExportStatusValue = 0
+	RelationExportFinished RelationExportStatusValue = 1
+)
+
+// RelationExportStatus represents the export status of a single relation.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_relations_export.html#export-status
+type RelationExportStatus struct {
+	Relation     string                       `json:"relation"`
+	Status       RelationExportStatusValue    `json:"status"`
+	Error        string                       `json:"error"`
+	UpdatedAt    *time.Time                   `json:"updated_at"`
+	Batched      bool                         `json:"batched"`
+	BatchesCount int                          `json:"batches_count"`
+	Batches      []*RelationExportBatchStatus `json:"batches"`
+}
+
+func (s RelationExportStatus) String() string {
+	return Stringify(s)
+}
+
+// RelationExportBatchStatus represents the export status of a single batch
+// of a batched relation export.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_relations_export.html#export-status
+type RelationExportBatchStatus struct {
+	Status       RelationExportStatusValue `json:"status"`
+	BatchNumber  int                       `json:"batch_number"`
+	ObjectsCount int                       `json:"objects_count"`
+	Error        string                    `json:"error"`
+	UpdatedAt    *time.Time                `json:"updated_at"`
+}
+
+func (s RelationExportBatchStatus) String() string {
+	return Stringify(s)
+}
+
+// ScheduleRelationsExportOptions represents the available
+// ScheduleRelationsExport() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_relations_export.html#schedule-new-export
+type ScheduleRelationsExportOptions struct {
+	Batched *bool `url:"batched,omitempty" json:"batched,omitempty"`
+}
+
+// ScheduleRelationsExport schedules a new export of all relations of a
+// project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_relations_export.html#schedule-new-export
+func (s *ProjectRelationsExportService) ScheduleRelationsExport(pid interface{}, opt *ScheduleRelationsExportOptions, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/export_relations", pathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodPost, u, opt, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// ListRelationsExportStatusOptions represents the available
+// ListRelationsExportStatus() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_relations_export.html#export-status
+type ListRelationsExportStatusOptions struct {
+	Relation *string `url:"relation,omitempty" json:"relation,omitempty"`
+}
+
+// ListRelationsExportStatus gets the export status of the relations of a
+// project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_relations_export.html#export-status
+func (s *ProjectRelationsExportService) ListRelationsExportStatus(pid interface{}, opt *ListRelationsExportStatusOptions, options ...RequestOptionFunc) ([]*RelationExportStatus, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/export_relations/status", pathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rs []*RelationExportStatus
+	resp, err := s.client.Do(req, &rs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return rs, resp, err
+}
+
+// DownloadRelationExportOptions represents the available
+// DownloadRelationExport() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_relations_export.html#export-download
+type DownloadRelationExportOptions struct {
+	Relation    *string `url:"relation,omitempty" json:"relation,omitempty"`
+	Batched     *bool   `url:"batched,omitempty" json:"batched,omitempty"`
+	BatchNumber *int    `url:"batch_number,omitempty" json:"batch_number,omitempty"`
+}
+
+// DownloadRelationExport downloads the finished export of a single relation
+// and writes it to w while it is received. Relations stored in the database
+// are downloaded as gzipped newline-delimited JSON, the "uploads" and
+// "repository" relations as gzipped tar archives.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_relations_export.html#export-download
+func (s *ProjectRelationsExportService) DownloadRelationExport(pid interface{}, opt *DownloadRelationExportOptions, w io.Writer, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/export_relations/download", pathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, w)
+}
+
+// StreamRelationExport downloads the finished export of a single relation
+// stored as newline-delimited JSON, like "issues" or "merge_requests", and
+// calls fn for every record while the export is being downloaded.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_relations_export.html#export-download
+func (s *ProjectRelationsExportService) StreamRelationExport(pid interface{}, opt *DownloadRelationExportOptions, fn func(record json.RawMessage) error, options ...RequestOptionFunc) (*Response, error) {
+	pr, pw := io.Pipe()
+
+	var resp *Response
+	done := make(chan error, 1)
+	go func() {
+		var err error
+		resp, err = s.DownloadRelationExport(pid, opt, pw, options...)
+		pw.CloseWithError(err)
+		done <- err
+	}()
+
+	err := s.decodeGzippedNDJSON(pr, fn)
+
+	// Stop the download in case not the whole export was read.
+	pr.CloseWithError(errExportExtractionDone)
+	if doErr := <-done; doErr != nil && !errors.Is(doErr, errExportExtractionDone) {
+		return resp, doErr
+	}
+
+	return resp, err
+}
+
+// decodeGzippedNDJSON decodes the gzipped newline-delimited JSON records read
+// from r and calls fn for each record.
+func (s *ProjectRelationsExportService) decodeGzippedNDJSON(r io.Reader, fn func(record json.RawMessage) error) error {
+	gz, err := s.client.newGzipReader(r)
+	if err != nil {
+		return err
+	}
+	defer gz.Close()
+
+	return DecodeNDJSON(gz, fn)
+}
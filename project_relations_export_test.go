@@ -0,0 +1,105 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleRelationsExport(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/export_relations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"batched":true}`)
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"message":"202 Accepted"}`)
+	})
+
+	_, err := client.ProjectRelationsExport.ScheduleRelationsExport(1, &ScheduleRelationsExportOptions{Batched: Bool(true)})
+	require.NoError(t, err)
+}
+
+func TestListRelationsExportStatus(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/export_relations/status", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/projects/1/export_relations/status?relation=issues")
+		fmt.Fprint(w, `[{
+			"relation": "issues",
+			"status": 1,
+			"error": "",
+			"batched": true,
+			"batches_count": 1,
+			"batches": [{"status": 1, "batch_number": 1, "objects_count": 3}]
+		}]`)
+	})
+
+	statuses, _, err := client.ProjectRelationsExport.ListRelationsExportStatus(1, &ListRelationsExportStatusOptions{Relation: String("issues")})
+	require.NoError(t, err)
+
+	want := []*RelationExportStatus{{
+		Relation:     "issues",
+		Status:       RelationExportFinished,
+		Batched:      true,
+		BatchesCount: 1,
+		Batches:      []*RelationExportBatchStatus{{Status: RelationExportFinished, BatchNumber: 1, ObjectsCount: 3}},
+	}}
+	assert.Equal(t, want, statuses)
+}
+
+func TestStreamRelationExport(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	fmt.Fprint(gz, "{\"iid\":1}\n{\"iid\":2}\n")
+	require.NoError(t, gz.Close())
+
+	mux.HandleFunc("/api/v4/projects/1/export_relations/download", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/projects/1/export_relations/download?relation=issues")
+		w.Write(buf.Bytes())
+	})
+
+	var iids []int
+	opt := &DownloadRelationExportOptions{Relation: String("issues")}
+	_, err := client.ProjectRelationsExport.StreamRelationExport(1, opt, func(record json.RawMessage) error {
+		var issue struct {
+			IID int `json:"iid"`
+		}
+		if err := json.Unmarshal(record, &issue); err != nil {
+			return err
+		}
+		iids = append(iids, issue.IID)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, iids)
+}
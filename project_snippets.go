@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"fmt"
 	"net/http"
+	"net/url"
 )
 
 // ProjectSnippetsService handles communication with the project snippets
@@ -205,3 +206,56 @@ func (s *ProjectSnippetsService) SnippetContent(pid interface{}, snippet int, op
 
 	return b.Bytes(), resp, err
 }
+
+// SnippetFileContent returns the raw content of a single file of a project
+// snippet at the given ref (a commit SHA or branch name of the snippet
+// repository).
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_snippets.html#snippet-repository-file-content
+func (s *ProjectSnippetsService) SnippetFileContent(pid interface{}, snippet int, ref, filename string, options ...RequestOptionFunc) ([]byte, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/snippets/%d/files/%s/%s/raw", pathEscape(project), snippet, url.PathEscape(ref), url.PathEscape(filename))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var b bytes.Buffer
+	resp, err := s.client.Do(req, &b)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return b.Bytes(), resp, err
+}
+
+// GetSnippetUserAgentDetails gets the user agent details of a project snippet.
+// Available only for admins.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_snippets.html#get-user-agent-details
+func (s *ProjectSnippetsService) GetSnippetUserAgentDetails(pid interface{}, snippet int, options ...RequestOptionFunc) (*SnippetUserAgentDetails, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/snippets/%d/user_agent_detail", pathEscape(project), snippet)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	d := new(SnippetUserAgentDetails)
+	resp, err := s.client.Do(req, d)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return d, resp, err
+}
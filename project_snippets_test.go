@@ -0,0 +1,57 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectSnippetFileContent(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/snippets/2/files/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		assert.Equal(t, "/api/v4/projects/1/snippets/2/files/e0d4f5a/hello.rb/raw", r.RequestURI)
+		fmt.Fprint(w, "puts 'hello'")
+	})
+
+	b, _, err := client.ProjectSnippets.SnippetFileContent(1, 2, "e0d4f5a", "hello.rb")
+	require.NoError(t, err)
+	assert.Equal(t, "puts 'hello'", string(b))
+}
+
+func TestGetProjectSnippetUserAgentDetails(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/snippets/2/user_agent_detail", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"user_agent": "AppleWebKit/537.36", "ip_address": "127.0.0.1", "akismet_submitted": true}`)
+	})
+
+	d, _, err := client.ProjectSnippets.GetSnippetUserAgentDetails(1, 2)
+	require.NoError(t, err)
+
+	want := &SnippetUserAgentDetails{UserAgent: "AppleWebKit/537.36", IPAddress: "127.0.0.1", AkismetSubmitted: true}
+	assert.Equal(t, want, d)
+}
@@ -0,0 +1,161 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// ProjectTemplatesService handles communication with the project templates
+// related methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/project_templates.html
+type ProjectTemplatesService struct {
+	client *Client
+}
+
+// ProjectTemplate represents a GitLab project template.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/project_templates.html
+type ProjectTemplate struct {
+	Key         string   `json:"key"`
+	Name        string   `json:"name"`
+	Nickname    string   `json:"nickname"`
+	Popular     bool     `json:"popular"`
+	HTMLURL     string   `json:"html_url"`
+	SourceURL   string   `json:"source_url"`
+	Description string   `json:"description"`
+	Conditions  []string `json:"conditions"`
+	Permissions []string `json:"permissions"`
+	Limitations []string `json:"limitations"`
+	Content     string   `json:"content"`
+}
+
+func (s ProjectTemplate) String() string {
+	return Stringify(s)
+}
+
+// ListProjectTemplatesOptions represents the available ListProjectTemplates()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_templates.html#get-all-templates-of-a-particular-type
+type ListProjectTemplatesOptions struct {
+	ListOptions
+}
+
+// ListProjectTemplates gets a list of the templates of the given type that are
+// available to the project. Valid template types are "dockerfiles",
+// "gitignores", "gitlab_ci_ymls", "licenses", "issues" and "merge_requests".
+// Listed templates only contain the key and name, use GetProjectTemplate to
+// get the content of a template.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_templates.html#get-all-templates-of-a-particular-type
+func (s *ProjectTemplatesService) ListProjectTemplates(pid interface{}, templateType string, opt *ListProjectTemplatesOptions, options ...RequestOptionFunc) ([]*ProjectTemplate, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/templates/%s", pathEscape(project), pathEscape(templateType))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var pt []*ProjectTemplate
+	resp, err := s.client.Do(req, &pt)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pt, resp, err
+}
+
+// GetProjectTemplateOptions represents the available GetProjectTemplate()
+// options. The options are only used for license templates.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_templates.html#get-one-template-of-a-particular-type
+type GetProjectTemplateOptions struct {
+	SourceTemplateProjectID *int    `url:"source_template_project_id,omitempty" json:"source_template_project_id,omitempty"`
+	Project                 *string `url:"project,omitempty" json:"project,omitempty"`
+	FullName                *string `url:"fullname,omitempty" json:"fullname,omitempty"`
+}
+
+// GetProjectTemplate gets a single template of the given type, including its
+// content.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_templates.html#get-one-template-of-a-particular-type
+func (s *ProjectTemplatesService) GetProjectTemplate(pid interface{}, templateType string, key string, opt *GetProjectTemplateOptions, options ...RequestOptionFunc) (*ProjectTemplate, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/templates/%s/%s", pathEscape(project), pathEscape(templateType), pathEscape(key))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pt := new(ProjectTemplate)
+	resp, err := s.client.Do(req, pt)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pt, resp, err
+}
+
+// ListIssueTemplates gets a list of the issue description templates of a
+// project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_templates.html#get-all-templates-of-a-particular-type
+func (s *ProjectTemplatesService) ListIssueTemplates(pid interface{}, opt *ListProjectTemplatesOptions, options ...RequestOptionFunc) ([]*ProjectTemplate, *Response, error) {
+	return s.ListProjectTemplates(pid, "issues", opt, options...)
+}
+
+// GetIssueTemplate gets a single issue description template of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_templates.html#get-one-template-of-a-particular-type
+func (s *ProjectTemplatesService) GetIssueTemplate(pid interface{}, name string, options ...RequestOptionFunc) (*ProjectTemplate, *Response, error) {
+	return s.GetProjectTemplate(pid, "issues", name, nil, options...)
+}
+
+// ListMergeRequestTemplates gets a list of the merge request description
+// templates of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_templates.html#get-all-templates-of-a-particular-type
+func (s *ProjectTemplatesService) ListMergeRequestTemplates(pid interface{}, opt *ListProjectTemplatesOptions, options ...RequestOptionFunc) ([]*ProjectTemplate, *Response, error) {
+	return s.ListProjectTemplates(pid, "merge_requests", opt, options...)
+}
+
+// GetMergeRequestTemplate gets a single merge request description template of
+// a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_templates.html#get-one-template-of-a-particular-type
+func (s *ProjectTemplatesService) GetMergeRequestTemplate(pid interface{}, name string, options ...RequestOptionFunc) (*ProjectTemplate, *Response, error) {
+	return s.GetProjectTemplate(pid, "merge_requests", name, nil, options...)
+}
@@ -0,0 +1,110 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestProjectTemplatesService_ListProjectTemplates(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/templates/gitlab_ci_ymls", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/projects/1/templates/gitlab_ci_ymls?per_page=2")
+		fmt.Fprint(w, `[{"key": "Go", "name": "Go"}]`)
+	})
+
+	opt := &ListProjectTemplatesOptions{ListOptions: ListOptions{PerPage: 2}}
+	templates, _, err := client.ProjectTemplates.ListProjectTemplates(1, "gitlab_ci_ymls", opt)
+	if err != nil {
+		t.Errorf("ProjectTemplates.ListProjectTemplates returned error: %v", err)
+	}
+
+	want := []*ProjectTemplate{{Key: "Go", Name: "Go"}}
+	if !reflect.DeepEqual(want, templates) {
+		t.Errorf("ProjectTemplates.ListProjectTemplates returned %+v, want %+v", templates, want)
+	}
+}
+
+func TestProjectTemplatesService_ListIssueTemplates(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/templates/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"key": "Bug", "name": "Bug"}, {"key": "Feature", "name": "Feature"}]`)
+	})
+
+	templates, _, err := client.ProjectTemplates.ListIssueTemplates(1, nil)
+	if err != nil {
+		t.Errorf("ProjectTemplates.ListIssueTemplates returned error: %v", err)
+	}
+
+	want := []*ProjectTemplate{
+		{Key: "Bug", Name: "Bug"},
+		{Key: "Feature", Name: "Feature"},
+	}
+	if !reflect.DeepEqual(want, templates) {
+		t.Errorf("ProjectTemplates.ListIssueTemplates returned %+v, want %+v", templates, want)
+	}
+}
+
+func TestProjectTemplatesService_GetMergeRequestTemplate(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/templates/merge_requests/Default", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"name": "Default", "content": "## What does this MR do?"}`)
+	})
+
+	template, _, err := client.ProjectTemplates.GetMergeRequestTemplate(1, "Default")
+	if err != nil {
+		t.Errorf("ProjectTemplates.GetMergeRequestTemplate returned error: %v", err)
+	}
+
+	want := &ProjectTemplate{Name: "Default", Content: "## What does this MR do?"}
+	if !reflect.DeepEqual(want, template) {
+		t.Errorf("ProjectTemplates.GetMergeRequestTemplate returned %+v, want %+v", template, want)
+	}
+}
+
+func TestProjectTemplatesService_GetProjectTemplate(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/templates/licenses/mit", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/projects/1/templates/licenses/mit?fullname=Jane+Doe")
+		fmt.Fprint(w, `{"key": "mit", "name": "MIT License", "content": "Copyright Jane Doe"}`)
+	})
+
+	template, _, err := client.ProjectTemplates.GetProjectTemplate(1, "licenses", "mit", &GetProjectTemplateOptions{FullName: String("Jane Doe")})
+	if err != nil {
+		t.Errorf("ProjectTemplates.GetProjectTemplate returned error: %v", err)
+	}
+
+	want := &ProjectTemplate{Key: "mit", Name: "MIT License", Content: "Copyright Jane Doe"}
+	if !reflect.DeepEqual(want, template) {
+		t.Errorf("ProjectTemplates.GetProjectTemplate returned %+v, want %+v", template, want)
+	}
+}
@@ -18,12 +18,15 @@ package gitlab
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"mime/multipart"
 	"net/http"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"time"
 )
 
@@ -68,10 +71,11 @@ type Project struct {
 	LastActivityAt                            *time.Time                 `json:"last_activity_at,omitempty"`
 	CreatorID                                 int                        `json:"creator_id"`
 	Namespace                                 *ProjectNamespace          `json:"namespace"`
-	ImportStatus                              string                     `json:"import_status"`
+	ImportStatus                              ImportStatusValue          `json:"import_status"`
 	ImportError                               string                     `json:"import_error"`
 	Permissions                               *Permissions               `json:"permissions"`
 	MarkedForDeletionAt                       *ISOTime                   `json:"marked_for_deletion_at"`
+	MarkedForDeletionOn                       *ISOTime                   `json:"marked_for_deletion_on"`
 	EmptyRepo                                 bool                       `json:"empty_repo"`
 	Archived                                  bool                       `json:"archived"`
 	AvatarURL                                 string                     `json:"avatar_url"`
@@ -86,6 +90,7 @@ type Project struct {
 	OnlyAllowMergeIfPipelineSucceeds          bool                       `json:"only_allow_merge_if_pipeline_succeeds"`
 	OnlyAllowMergeIfAllDiscussionsAreResolved bool                       `json:"only_allow_merge_if_all_discussions_are_resolved"`
 	RemoveSourceBranchAfterMerge              bool                       `json:"remove_source_branch_after_merge"`
+	SquashOption                              SquashOptionValue          `json:"squash_option"`
 	LFSEnabled                                bool                       `json:"lfs_enabled"`
 	RequestAccessEnabled                      bool                       `json:"request_access_enabled"`
 	MergeMethod                               MergeMethodValue           `json:"merge_method"`
@@ -115,13 +120,19 @@ type Project struct {
 		GroupName        string `json:"group_name"`
 		GroupAccessLevel int    `json:"group_access_level"`
 	} `json:"shared_with_groups"`
-	Statistics           *ProjectStatistics `json:"statistics"`
-	Links                *Links             `json:"_links,omitempty"`
-	CIConfigPath         string             `json:"ci_config_path"`
-	CIDefaultGitDepth    int                `json:"ci_default_git_depth"`
-	CustomAttributes     []*CustomAttribute `json:"custom_attributes"`
-	ComplianceFrameworks []string           `json:"compliance_frameworks"`
-	BuildCoverageRegex   string             `json:"build_coverage_regex"`
+	Statistics                               *ProjectStatistics `json:"statistics"`
+	Links                                    *Links             `json:"_links,omitempty"`
+	CIConfigPath                             string             `json:"ci_config_path"`
+	CIDefaultGitDepth                        int                `json:"ci_default_git_depth"`
+	CISeparatedCaches                        bool               `json:"ci_separated_caches"`
+	CIAllowForkPipelinesToRunInParentProject bool               `json:"ci_allow_fork_pipelines_to_run_in_parent_project"`
+	BuildGitStrategy                         string             `json:"build_git_strategy"`
+	BuildTimeout                             int                `json:"build_timeout"`
+	AutoCancelPendingPipelines               string             `json:"auto_cancel_pending_pipelines"`
+	CustomAttributes                         []*CustomAttribute `json:"custom_attributes"`
+	ComplianceFrameworks                     []string           `json:"compliance_frameworks"`
+	BuildCoverageRegex                       string             `json:"build_coverage_regex"`
+	RepositoryStorage                        string             `json:"repository_storage"`
 }
 
 // ContainerExpirationPolicy represents the container expiration policy.
@@ -173,13 +184,18 @@ type ProjectLicense struct {
 	SourceURL string `json:"source_url"`
 }
 
-
 // StorageStatistics represents a statistics record for a group or project.
 type StorageStatistics struct {
-	StorageSize      int64 `json:"storage_size"`
-	RepositorySize   int64 `json:"repository_size"`
-	LfsObjectsSize   int64 `json:"lfs_objects_size"`
-	JobArtifactsSize int64 `json:"job_artifacts_size"`
+	StorageSize           int64 `json:"storage_size"`
+	RepositorySize        int64 `json:"repository_size"`
+	WikiSize              int64 `json:"wiki_size"`
+	LfsObjectsSize        int64 `json:"lfs_objects_size"`
+	JobArtifactsSize      int64 `json:"job_artifacts_size"`
+	PipelineArtifactsSize int64 `json:"pipeline_artifacts_size"`
+	PackagesSize          int64 `json:"packages_size"`
+	SnippetsSize          int64 `json:"snippets_size"`
+	UploadsSize           int64 `json:"uploads_size"`
+	ContainerRegistrySize int64 `json:"container_registry_size"`
 }
 
 // ProjectStatistics represents a statistics record for a project.
@@ -253,33 +269,67 @@ func (s ProjectApprovalRule) String() string {
 	return Stringify(s)
 }
 
+// ProjectOrderByValue represents a column projects can be ordered by.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/projects.html#list-all-projects
+type ProjectOrderByValue string
+
+// These constants represent all valid columns to order projects by.
+const (
+	ProjectOrderByID             ProjectOrderByValue = "id"
+	ProjectOrderByName           ProjectOrderByValue = "name"
+	ProjectOrderByPath           ProjectOrderByValue = "path"
+	ProjectOrderByCreatedAt      ProjectOrderByValue = "created_at"
+	ProjectOrderByUpdatedAt      ProjectOrderByValue = "updated_at"
+	ProjectOrderByLastActivityAt ProjectOrderByValue = "last_activity_at"
+	ProjectOrderBySimilarity     ProjectOrderByValue = "similarity"
+	ProjectOrderByStarCount      ProjectOrderByValue = "star_count"
+	ProjectOrderByRepositorySize ProjectOrderByValue = "repository_size"
+	ProjectOrderByStorageSize    ProjectOrderByValue = "storage_size"
+	ProjectOrderByPackagesSize   ProjectOrderByValue = "packages_size"
+	ProjectOrderByWikiSize       ProjectOrderByValue = "wiki_size"
+)
+
+// ProjectOrderBy is a helper routine that allocates a new ProjectOrderByValue
+// to store v and returns a pointer to it.
+func ProjectOrderBy(v ProjectOrderByValue) *ProjectOrderByValue {
+	p := new(ProjectOrderByValue)
+	*p = v
+	return p
+}
+
 // ListProjectsOptions represents the available ListProjects() options.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/projects.html#list-projects
 type ListProjectsOptions struct {
 	ListOptions
-	Archived                 *bool             `url:"archived,omitempty" json:"archived,omitempty"`
-	Visibility               *VisibilityValue  `url:"visibility,omitempty" json:"visibility,omitempty"`
-	OrderBy                  *string           `url:"order_by,omitempty" json:"order_by,omitempty"`
-	Sort                     *string           `url:"sort,omitempty" json:"sort,omitempty"`
-	Search                   *string           `url:"search,omitempty" json:"search,omitempty"`
-	SearchNamespaces         *bool             `url:"search_namespaces,omitempty" json:"search_namespaces,omitempty"`
-	Simple                   *bool             `url:"simple,omitempty" json:"simple,omitempty"`
-	Owned                    *bool             `url:"owned,omitempty" json:"owned,omitempty"`
-	Membership               *bool             `url:"membership,omitempty" json:"membership,omitempty"`
-	Starred                  *bool             `url:"starred,omitempty" json:"starred,omitempty"`
-	Statistics               *bool             `url:"statistics,omitempty" json:"statistics,omitempty"`
-	WithCustomAttributes     *bool             `url:"with_custom_attributes,omitempty" json:"with_custom_attributes,omitempty"`
-	WithIssuesEnabled        *bool             `url:"with_issues_enabled,omitempty" json:"with_issues_enabled,omitempty"`
-	WithMergeRequestsEnabled *bool             `url:"with_merge_requests_enabled,omitempty" json:"with_merge_requests_enabled,omitempty"`
-	WithProgrammingLanguage  *string           `url:"with_programming_language,omitempty" json:"with_programming_language,omitempty"`
-	WikiChecksumFailed       *bool             `url:"wiki_checksum_failed,omitempty" json:"wiki_checksum_failed,omitempty"`
-	RepositoryChecksumFailed *bool             `url:"repository_checksum_failed,omitempty" json:"repository_checksum_failed,omitempty"`
-	MinAccessLevel           *AccessLevelValue `url:"min_access_level,omitempty" json:"min_access_level,omitempty"`
-	IDAfter                  *int              `url:"id_after,omitempty" json:"id_after,omitempty"`
-	IDBefore                 *int              `url:"id_before,omitempty" json:"id_before,omitempty"`
-	LastActivityAfter        *time.Time        `url:"last_activity_after,omitempty" json:"last_activity_after,omitempty"`
-	LastActivityBefore       *time.Time        `url:"last_activity_before,omitempty" json:"last_activity_before,omitempty"`
+	Archived                 *bool                `url:"archived,omitempty" json:"archived,omitempty"`
+	Visibility               *VisibilityValue     `url:"visibility,omitempty" json:"visibility,omitempty"`
+	OrderBy                  *ProjectOrderByValue `url:"order_by,omitempty" json:"order_by,omitempty"`
+	Sort                     *SortValue           `url:"sort,omitempty" json:"sort,omitempty"`
+	Search                   *string              `url:"search,omitempty" json:"search,omitempty"`
+	SearchNamespaces         *bool                `url:"search_namespaces,omitempty" json:"search_namespaces,omitempty"`
+	Simple                   *bool                `url:"simple,omitempty" json:"simple,omitempty"`
+	Owned                    *bool                `url:"owned,omitempty" json:"owned,omitempty"`
+	Membership               *bool                `url:"membership,omitempty" json:"membership,omitempty"`
+	Starred                  *bool                `url:"starred,omitempty" json:"starred,omitempty"`
+	Statistics               *bool                `url:"statistics,omitempty" json:"statistics,omitempty"`
+	WithCustomAttributes     *bool                `url:"with_custom_attributes,omitempty" json:"with_custom_attributes,omitempty"`
+	WithIssuesEnabled        *bool                `url:"with_issues_enabled,omitempty" json:"with_issues_enabled,omitempty"`
+	WithMergeRequestsEnabled *bool                `url:"with_merge_requests_enabled,omitempty" json:"with_merge_requests_enabled,omitempty"`
+	WithProgrammingLanguage  *string              `url:"with_programming_language,omitempty" json:"with_programming_language,omitempty"`
+	WikiChecksumFailed       *bool                `url:"wiki_checksum_failed,omitempty" json:"wiki_checksum_failed,omitempty"`
+	RepositoryChecksumFailed *bool                `url:"repository_checksum_failed,omitempty" json:"repository_checksum_failed,omitempty"`
+	MinAccessLevel           *AccessLevelValue    `url:"min_access_level,omitempty" json:"min_access_level,omitempty"`
+	IDAfter                  *int                 `url:"id_after,omitempty" json:"id_after,omitempty"`
+	IDBefore                 *int                 `url:"id_before,omitempty" json:"id_before,omitempty"`
+	LastActivityAfter        *time.Time           `url:"last_activity_after,omitempty" json:"last_activity_after,omitempty"`
+	LastActivityBefore       *time.Time           `url:"last_activity_before,omitempty" json:"last_activity_before,omitempty"`
+	RepositoryStorage        *string              `url:"repository_storage,omitempty" json:"repository_storage,omitempty"`
+	Topic                    *string              `url:"topic,omitempty" json:"topic,omitempty"`
+	Active                   *bool                `url:"active,omitempty" json:"active,omitempty"`
+	MarkedForDeletionOn      *ISOTime             `url:"marked_for_deletion_on,omitempty" json:"marked_for_deletion_on,omitempty"`
 }
 
 // ListProjects gets a list of projects accessible by the authenticated user.
@@ -300,6 +350,44 @@ func (s *ProjectsService) ListProjects(opt *ListProjectsOptions, options ...Requ
 	return p, resp, err
 }
 
+// StreamAllProjects iterates over all projects matching the given options
+// and calls fn for each of them. Instead of using offset pagination, it uses
+// the id_after filter to walk the projects ordered by ID, which keeps
+// instance-wide scans fast and consistent. Any OrderBy, Sort and Page values
+// in opt are overridden. An IDAfter value in opt is used as the starting
+// point, so an interrupted scan can be resumed after the last project seen.
+// Iteration stops at the first error returned by either the API or fn.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/projects.html#list-projects
+func (s *ProjectsService) StreamAllProjects(opt *ListProjectsOptions, fn func(*Project) error, options ...RequestOptionFunc) error {
+	o := ListProjectsOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	o.OrderBy = ProjectOrderBy(ProjectOrderByID)
+	o.Sort = Sort(SortAsc)
+	o.Page = 0
+	if o.PerPage == 0 {
+		o.PerPage = 100
+	}
+
+	for {
+		ps, _, err := s.ListProjects(&o, options...)
+		if err != nil {
+			return err
+		}
+		if len(ps) == 0 {
+			return nil
+		}
+		for _, p := range ps {
+			if err := fn(p); err != nil {
+				return err
+			}
+		}
+		o.IDAfter = Int(ps[len(ps)-1].ID)
+	}
+}
+
 // ListUserProjects gets a list of projects for the given user.
 //
 // GitLab API docs:
@@ -368,6 +456,94 @@ func (s *ProjectsService) ListProjectsUsers(pid interface{}, opt *ListProjectUse
 	return p, resp, err
 }
 
+// ProjectGroup represents a GitLab project group.
+type ProjectGroup struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	AvatarURL string `json:"avatar_url"`
+	WebURL    string `json:"web_url"`
+	FullName  string `json:"full_name"`
+	FullPath  string `json:"full_path"`
+}
+
+// ListProjectGroupOptions represents the available ListProjectsGroups() options.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/projects.html#list-a-projects-groups
+type ListProjectGroupOptions struct {
+	ListOptions
+	Search               *string           `url:"search,omitempty" json:"search,omitempty"`
+	SharedMinAccessLevel *AccessLevelValue `url:"shared_min_access_level,omitempty" json:"shared_min_access_level,omitempty"`
+	SharedVisibleOnly    *bool             `url:"shared_visible_only,omitempty" json:"shared_visible_only,omitempty"`
+	SkipGroups           []int             `url:"skip_groups,omitempty" json:"skip_groups,omitempty"`
+	WithShared           *bool             `url:"with_shared,omitempty" json:"with_shared,omitempty"`
+}
+
+// ListProjectsGroups gets a list of the ancestor groups of the given project,
+// optionally including the groups the project is shared with.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/projects.html#list-a-projects-groups
+func (s *ProjectsService) ListProjectsGroups(pid interface{}, opt *ListProjectGroupOptions, options ...RequestOptionFunc) ([]*ProjectGroup, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/groups", pathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var p []*ProjectGroup
+	resp, err := s.client.Do(req, &p)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return p, resp, err
+}
+
+// ListProjectInvitedGroupOptions represents the available
+// ListProjectInvitedGroups() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/projects.html#list-a-projects-invited-groups
+type ListProjectInvitedGroupOptions struct {
+	ListOptions
+	Search               *string           `url:"search,omitempty" json:"search,omitempty"`
+	MinAccessLevel       *AccessLevelValue `url:"min_access_level,omitempty" json:"min_access_level,omitempty"`
+	Relation             []string          `url:"relation[],omitempty" json:"relation,omitempty"`
+	WithCustomAttributes *bool             `url:"with_custom_attributes,omitempty" json:"with_custom_attributes,omitempty"`
+}
+
+// ListProjectInvitedGroups gets a list of the groups invited to the given
+// project. Relation can be "direct" and/or "inherited" to only list the groups
+// invited directly to the project or to one of its ancestor groups.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/projects.html#list-a-projects-invited-groups
+func (s *ProjectsService) ListProjectInvitedGroups(pid interface{}, opt *ListProjectInvitedGroupOptions, options ...RequestOptionFunc) ([]*Group, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/invited_groups", pathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var g []*Group
+	resp, err := s.client.Do(req, &g)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return g, resp, err
+}
+
 // ProjectLanguages is a map of strings because the response is arbitrary
 //
 // Gitlab API docs: https://docs.gitlab.com/ce/api/projects.html#languages
@@ -432,6 +608,35 @@ func (s *ProjectsService) GetProject(pid interface{}, opt *GetProjectOptions, op
 	return p, resp, err
 }
 
+// ProjectExists checks whether a project, identified by project ID or
+// NAMESPACE/PROJECT_NAME, exists and is visible to the authenticated user.
+// A 404 response is reported as false instead of as an error.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/projects.html#get-single-project
+func (s *ProjectsService) ProjectExists(pid interface{}, options ...RequestOptionFunc) (bool, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return false, nil, err
+	}
+	u := fmt.Sprintf("projects/%s", pathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodHead, u, nil, options)
+	if err != nil {
+		return false, nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return false, resp, nil
+		}
+		return false, resp, err
+	}
+
+	return true, resp, err
+}
+
 // ProjectEvent represents a GitLab project event.
 //
 // GitLab API docs:
@@ -524,6 +729,7 @@ type CreateProjectOptions struct {
 	OnlyAllowMergeIfAllDiscussionsAreResolved *bool                                `url:"only_allow_merge_if_all_discussions_are_resolved,omitempty" json:"only_allow_merge_if_all_discussions_are_resolved,omitempty"`
 	MergeMethod                               *MergeMethodValue                    `url:"merge_method,omitempty" json:"merge_method,omitempty"`
 	RemoveSourceBranchAfterMerge              *bool                                `url:"remove_source_branch_after_merge,omitempty" json:"remove_source_branch_after_merge,omitempty"`
+	SquashOption                              *SquashOptionValue                   `url:"squash_option,omitempty" json:"squash_option,omitempty"`
 	LFSEnabled                                *bool                                `url:"lfs_enabled,omitempty" json:"lfs_enabled,omitempty"`
 	RequestAccessEnabled                      *bool                                `url:"request_access_enabled,omitempty" json:"request_access_enabled,omitempty"`
 	TagList                                   *[]string                            `url:"tag_list,omitempty" json:"tag_list,omitempty"`
@@ -599,6 +805,75 @@ func (s *ProjectsService) CreateProject(opt *CreateProjectOptions, options ...Re
 	return p, resp, err
 }
 
+// CreateProjectWithFiles creates a new project and seeds its repository with
+// an initial commit containing the given files, keyed by file path. The files
+// are committed to the default branch of the project. When creating the
+// commit fails, the already created project is returned together with the
+// error, so the caller can decide to retry or clean up.
+//
+// As the files are created in the initial commit, the options that populate
+// the repository themselves (InitializeWithReadme, ImportURL and the template
+// options) can't be combined with files and result in an error.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/projects.html#create-project
+func (s *ProjectsService) CreateProjectWithFiles(opt *CreateProjectOptions, files map[string]string, commitMessage string, options ...RequestOptionFunc) (*Project, *Response, error) {
+	if len(files) > 0 && opt != nil && populatesRepository(opt) {
+		return nil, nil, errors.New("files can't be combined with options that populate the repository")
+	}
+
+	p, resp, err := s.CreateProject(opt, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+	if len(files) == 0 {
+		return p, resp, err
+	}
+
+	branch := "master"
+	switch {
+	case p.DefaultBranch != "":
+		branch = p.DefaultBranch
+	case opt != nil && opt.DefaultBranch != nil:
+		branch = *opt.DefaultBranch
+	}
+
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	actions := make([]*CommitActionOptions, 0, len(paths))
+	for _, path := range paths {
+		actions = append(actions, &CommitActionOptions{
+			Action:   FileAction(FileCreate),
+			FilePath: String(path),
+			Content:  String(files[path]),
+		})
+	}
+
+	commitOpt := &CreateCommitOptions{
+		Branch:        String(branch),
+		CommitMessage: String(commitMessage),
+		Actions:       actions,
+	}
+
+	_, resp, err = s.client.Commits.CreateCommit(p.ID, commitOpt, options...)
+
+	return p, resp, err
+}
+
+// populatesRepository reports whether the given options make GitLab populate
+// the repository of the new project.
+func populatesRepository(opt *CreateProjectOptions) bool {
+	return (opt.InitializeWithReadme != nil && *opt.InitializeWithReadme) ||
+		opt.ImportURL != nil ||
+		opt.TemplateName != nil ||
+		opt.TemplateProjectID != nil ||
+		(opt.UseCustomTemplate != nil && *opt.UseCustomTemplate)
+}
+
 // CreateProjectForUserOptions represents the available CreateProjectForUser()
 // options.
 //
@@ -664,6 +939,7 @@ type EditProjectOptions struct {
 	OnlyAllowMergeIfAllDiscussionsAreResolved *bool                                `url:"only_allow_merge_if_all_discussions_are_resolved,omitempty" json:"only_allow_merge_if_all_discussions_are_resolved,omitempty"`
 	MergeMethod                               *MergeMethodValue                    `url:"merge_method,omitempty" json:"merge_method,omitempty"`
 	RemoveSourceBranchAfterMerge              *bool                                `url:"remove_source_branch_after_merge,omitempty" json:"remove_source_branch_after_merge,omitempty"`
+	SquashOption                              *SquashOptionValue                   `url:"squash_option,omitempty" json:"squash_option,omitempty"`
 	LFSEnabled                                *bool                                `url:"lfs_enabled,omitempty" json:"lfs_enabled,omitempty"`
 	RequestAccessEnabled                      *bool                                `url:"request_access_enabled,omitempty" json:"request_access_enabled,omitempty"`
 	TagList                                   *[]string                            `url:"tag_list,omitempty" json:"tag_list,omitempty"`
@@ -674,6 +950,8 @@ type EditProjectOptions struct {
 	CIConfigPath                              *string                              `url:"ci_config_path,omitempty" json:"ci_config_path,omitempty"`
 	CIForwardDeploymentEnabled                *bool                                `url:"ci_forward_deployment_enabled,omitempty" json:"ci_forward_deployment_enabled,omitempty"`
 	CIDefaultGitDepth                         *int                                 `url:"ci_default_git_depth,omitempty" json:"ci_default_git_depth,omitempty"`
+	CISeparatedCaches                         *bool                                `url:"ci_separated_caches,omitempty" json:"ci_separated_caches,omitempty"`
+	CIAllowForkPipelinesToRunInParentProject  *bool                                `url:"ci_allow_fork_pipelines_to_run_in_parent_project,omitempty" json:"ci_allow_fork_pipelines_to_run_in_parent_project,omitempty"`
 	AutoDevopsEnabled                         *bool                                `url:"auto_devops_enabled,omitempty" json:"auto_devops_enabled,omitempty"`
 	AutoDevopsDeployStrategy                  *string                              `url:"auto_devops_deploy_strategy,omitempty" json:"auto_devops_deploy_strategy,omitempty"`
 	ApprovalsBeforeMerge                      *int                                 `url:"approvals_before_merge,omitempty" json:"approvals_before_merge,omitempty"`
@@ -727,6 +1005,27 @@ func (s *ProjectsService) EditProject(pid interface{}, opt *EditProjectOptions,
 	return p, resp, err
 }
 
+// GetDefaultBranch gets the name of the default branch of a project. An empty
+// name is returned for projects with an empty repository.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/projects.html#get-single-project
+func (s *ProjectsService) GetDefaultBranch(pid interface{}, options ...RequestOptionFunc) (string, *Response, error) {
+	p, resp, err := s.GetProject(pid, nil, options...)
+	if err != nil {
+		return "", resp, err
+	}
+
+	return p.DefaultBranch, resp, err
+}
+
+// SetDefaultBranch changes the default branch of a project. The branch must
+// already exist in the repository of the project.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/projects.html#edit-project
+func (s *ProjectsService) SetDefaultBranch(pid interface{}, branch string, options ...RequestOptionFunc) (*Project, *Response, error) {
+	return s.EditProject(pid, &EditProjectOptions{DefaultBranch: &branch}, options...)
+}
+
 // ForkProjectOptions represents the available ForkProject() options.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/projects.html#fork-project
@@ -863,18 +1162,31 @@ func (s *ProjectsService) UnarchiveProject(pid interface{}, options ...RequestOp
 	return p, resp, err
 }
 
+// DeleteProjectOptions represents the available DeleteProject() options.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/projects.html#delete-a-project
+type DeleteProjectOptions struct {
+	FullPath          *string `url:"full_path,omitempty" json:"full_path,omitempty"`
+	PermanentlyRemove *bool   `url:"permanently_remove,omitempty" json:"permanently_remove,omitempty"`
+}
+
 // DeleteProject removes a project including all associated resources
 // (issues, merge requests etc.)
 //
-// GitLab API docs: https://docs.gitlab.com/ce/api/projects.html#remove-project
-func (s *ProjectsService) DeleteProject(pid interface{}, options ...RequestOptionFunc) (*Response, error) {
+// When delayed deletion is enabled, the project is only marked for deletion
+// and removed after the configured period. A project that is already marked
+// for deletion can be removed immediately by setting PermanentlyRemove,
+// together with FullPath as a confirmation of which project is removed.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/projects.html#delete-a-project
+func (s *ProjectsService) DeleteProject(pid interface{}, opt *DeleteProjectOptions, options ...RequestOptionFunc) (*Response, error) {
 	project, err := parseID(pid)
 	if err != nil {
 		return nil, err
 	}
 	u := fmt.Sprintf("projects/%s", pathEscape(project))
 
-	req, err := s.client.NewRequest(http.MethodDelete, u, nil, options)
+	req, err := s.client.NewRequest(http.MethodDelete, u, opt, options)
 	if err != nil {
 		return nil, err
 	}
@@ -882,13 +1194,89 @@ func (s *ProjectsService) DeleteProject(pid interface{}, options ...RequestOptio
 	return s.client.Do(req, nil)
 }
 
+// RestoreProject restores a project that is marked for deletion.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/projects.html#restore-project-marked-for-deletion
+func (s *ProjectsService) RestoreProject(pid interface{}, options ...RequestOptionFunc) (*Project, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/restore", pathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodPost, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	p := new(Project)
+	resp, err := s.client.Do(req, p)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return p, resp, err
+}
+
+// ListProjectsPendingDeletion walks all projects accessible by the
+// authenticated user and returns the projects that are marked for deletion,
+// but not yet deleted. The ListProjects() options can be used to further
+// filter the projects.
+//
+// GitLab does not support filtering on all projects marked for deletion, so
+// this lists all inactive projects (archived projects and projects marked for
+// deletion) and filters out the archived projects that are not marked for
+// deletion. As the filtering happens client-side, all pages are fetched and
+// no pagination information is returned.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/projects.html#list-projects
+func (s *ProjectsService) ListProjectsPendingDeletion(opt *ListProjectsOptions, options ...RequestOptionFunc) ([]*Project, error) {
+	o := ListProjectsOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	if o.MarkedForDeletionOn == nil {
+		o.Active = Bool(false)
+	}
+	if o.PerPage == 0 {
+		o.PerPage = 100
+	}
+
+	var pending []*Project
+	for {
+		ps, resp, err := s.ListProjects(&o, options...)
+		if err != nil {
+			return nil, err
+		}
+		pending = appendPendingDeletion(pending, ps)
+		if resp.NextPage == 0 {
+			break
+		}
+		o.Page = resp.NextPage
+	}
+
+	return pending, nil
+}
+
+// appendPendingDeletion appends the projects of ps that are marked for
+// deletion to pending.
+func appendPendingDeletion(pending, ps []*Project) []*Project {
+	for _, p := range ps {
+		if p.MarkedForDeletionAt != nil || p.MarkedForDeletionOn != nil {
+			pending = append(pending, p)
+		}
+	}
+	return pending
+}
+
 // ShareWithGroupOptions represents options to share project with groups
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/projects.html#share-project-with-group
 type ShareWithGroupOptions struct {
 	GroupID     *int              `url:"group_id" json:"group_id"`
 	GroupAccess *AccessLevelValue `url:"group_access" json:"group_access"`
-	ExpiresAt   *string           `url:"expires_at" json:"expires_at"`
+	ExpiresAt   *string           `url:"expires_at,omitempty" json:"expires_at,omitempty"`
 }
 
 // ShareProjectWithGroup allows to share a project with a group.
@@ -1140,6 +1528,100 @@ func (s *ProjectsService) DeleteProjectHook(pid interface{}, hook int, options .
 	return s.client.Do(req, nil)
 }
 
+// HookDelivery represents a single delivery of a webhook event, as recorded
+// in the webhook event log of a project or group hook.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/projects.html#list-project-hook-events
+type HookDelivery struct {
+	ID                int                    `json:"id"`
+	URL               string                 `json:"url"`
+	Trigger           string                 `json:"trigger"`
+	RequestHeaders    map[string]string      `json:"request_headers"`
+	RequestData       map[string]interface{} `json:"request_data"`
+	ResponseHeaders   map[string]string      `json:"response_headers"`
+	ResponseBody      string                 `json:"response_body"`
+	ExecutionDuration float64                `json:"execution_duration"`
+	ResponseStatus    string                 `json:"response_status"`
+}
+
+func (d HookDelivery) String() string {
+	return Stringify(d)
+}
+
+// StatusCode returns the HTTP status code returned by the receiver of the
+// webhook, or 0 if no response was received (for instance because of a
+// network error, in which case ResponseStatus contains the error).
+func (d *HookDelivery) StatusCode() int {
+	code, err := strconv.Atoi(d.ResponseStatus)
+	if err != nil {
+		return 0
+	}
+	return code
+}
+
+// Failed reports whether the delivery of the webhook event failed.
+func (d *HookDelivery) Failed() bool {
+	code := d.StatusCode()
+	return code < 200 || code >= 300
+}
+
+// ListHookDeliveriesOptions represents the available ListProjectHookDeliveries()
+// and ListGroupHookDeliveries() options. Status can be a HTTP status code or
+// one of "successful", "client_failure" and "server_failure".
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/projects.html#list-project-hook-events
+type ListHookDeliveriesOptions struct {
+	ListOptions
+	Status *string `url:"status,omitempty" json:"status,omitempty"`
+}
+
+// ListProjectHookDeliveries gets the logged deliveries of a project hook from
+// the past 7 days.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/projects.html#list-project-hook-events
+func (s *ProjectsService) ListProjectHookDeliveries(pid interface{}, hook int, opt *ListHookDeliveriesOptions, options ...RequestOptionFunc) ([]*HookDelivery, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/hooks/%d/events", pathEscape(project), hook)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var hd []*HookDelivery
+	resp, err := s.client.Do(req, &hd)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return hd, resp, err
+}
+
+// ResendProjectHookDelivery resends a logged delivery of a project hook.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/projects.html#resend-project-hook-event
+func (s *ProjectsService) ResendProjectHookDelivery(pid interface{}, hook int, delivery int, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/hooks/%d/events/%d/resend", pathEscape(project), hook, delivery)
+
+	req, err := s.client.NewRequest(http.MethodPost, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
 // ProjectForkRelation represents a project fork relationship.
 //
 // GitLab API docs:
@@ -39,8 +39,8 @@ func TestListProjects(t *testing.T) {
 	opt := &ListProjectsOptions{
 		ListOptions: ListOptions{2, 3},
 		Archived:    Bool(true),
-		OrderBy:     String("name"),
-		Sort:        String("asc"),
+		OrderBy:     ProjectOrderBy(ProjectOrderByName),
+		Sort:        Sort(SortAsc),
 		Search:      String("query"),
 		Simple:      Bool(true),
 		Visibility:  Visibility(PublicVisibility),
@@ -69,8 +69,8 @@ func TestListUserProjects(t *testing.T) {
 	opt := &ListProjectsOptions{
 		ListOptions: ListOptions{2, 3},
 		Archived:    Bool(true),
-		OrderBy:     String("name"),
-		Sort:        String("asc"),
+		OrderBy:     ProjectOrderBy(ProjectOrderByName),
+		Sort:        Sort(SortAsc),
 		Search:      String("query"),
 		Simple:      Bool(true),
 		Visibility:  Visibility(PublicVisibility),
@@ -151,8 +151,8 @@ func TestListOwnedProjects(t *testing.T) {
 	opt := &ListProjectsOptions{
 		ListOptions: ListOptions{2, 3},
 		Archived:    Bool(true),
-		OrderBy:     String("name"),
-		Sort:        String("asc"),
+		OrderBy:     ProjectOrderBy(ProjectOrderByName),
+		Sort:        Sort(SortAsc),
 		Search:      String("query"),
 		Simple:      Bool(true),
 		Owned:       Bool(true),
@@ -182,8 +182,8 @@ func TestListStarredProjects(t *testing.T) {
 	opt := &ListProjectsOptions{
 		ListOptions: ListOptions{2, 3},
 		Archived:    Bool(true),
-		OrderBy:     String("name"),
-		Sort:        String("asc"),
+		OrderBy:     ProjectOrderBy(ProjectOrderByName),
+		Sort:        Sort(SortAsc),
 		Search:      String("query"),
 		Simple:      Bool(true),
 		Starred:     Bool(true),
@@ -424,8 +424,8 @@ func TestListProjectForks(t *testing.T) {
 	opt := &ListProjectsOptions{}
 	opt.ListOptions = ListOptions{2, 3}
 	opt.Archived = Bool(true)
-	opt.OrderBy = String("name")
-	opt.Sort = String("asc")
+	opt.OrderBy = ProjectOrderBy(ProjectOrderByName)
+	opt.Sort = Sort(SortAsc)
 	opt.Search = String("query")
 	opt.Simple = Bool(true)
 	opt.Visibility = Visibility(PublicVisibility)
@@ -995,3 +995,414 @@ func TestCreateProjectApprovalRule(t *testing.T) {
 		t.Errorf("Projects.CreateProjectApprovalRule returned %+v, want %+v", rule, want)
 	}
 }
+
+func TestProjectExists(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodHead)
+		if r.RequestURI == "/api/v4/projects/group%2Fexisting" {
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	exists, _, err := client.Projects.ProjectExists("group/existing")
+	if err != nil {
+		t.Fatalf("Projects.ProjectExists returned error: %v", err)
+	}
+	if !exists {
+		t.Errorf("Projects.ProjectExists returned false, want true")
+	}
+
+	exists, _, err = client.Projects.ProjectExists("group/missing")
+	if err != nil {
+		t.Fatalf("Projects.ProjectExists returned error: %v", err)
+	}
+	if exists {
+		t.Errorf("Projects.ProjectExists returned true, want false")
+	}
+}
+
+func TestStreamAllProjects(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.Query().Get("id_after") {
+		case "":
+			testURL(t, r, "/api/v4/projects?order_by=id&per_page=2&sort=asc&topic=go")
+			fmt.Fprint(w, `[{"id":1},{"id":3}]`)
+		case "3":
+			fmt.Fprint(w, `[{"id":7}]`)
+		default:
+			fmt.Fprint(w, `[]`)
+		}
+	})
+
+	var ids []int
+	opt := &ListProjectsOptions{ListOptions: ListOptions{PerPage: 2}, Topic: String("go")}
+	err := client.Projects.StreamAllProjects(opt, func(p *Project) error {
+		ids = append(ids, p.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Projects.StreamAllProjects returned error: %v", err)
+	}
+
+	want := []int{1, 3, 7}
+	if !reflect.DeepEqual(want, ids) {
+		t.Errorf("Projects.StreamAllProjects visited %v, want %v", ids, want)
+	}
+}
+
+func TestStreamAllProjectsResumes(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.Query().Get("id_after") {
+		case "3":
+			fmt.Fprint(w, `[{"id":7}]`)
+		case "7":
+			fmt.Fprint(w, `[]`)
+		default:
+			t.Errorf("unexpected id_after %q", r.URL.Query().Get("id_after"))
+			fmt.Fprint(w, `[]`)
+		}
+	})
+
+	var ids []int
+	opt := &ListProjectsOptions{IDAfter: Int(3)}
+	err := client.Projects.StreamAllProjects(opt, func(p *Project) error {
+		ids = append(ids, p.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Projects.StreamAllProjects returned error: %v", err)
+	}
+
+	want := []int{7}
+	if !reflect.DeepEqual(want, ids) {
+		t.Errorf("Projects.StreamAllProjects visited %v, want %v", ids, want)
+	}
+}
+
+func TestCreateProjectWithFiles(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"name":"seeded","default_branch":"main"}`)
+		fmt.Fprint(w, `{"id":7,"name":"seeded"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/7/repository/commits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"branch":"main","commit_message":"Initial commit","actions":[`+
+			`{"action":"create","file_path":".gitlab-ci.yml","content":"test: {}"},`+
+			`{"action":"create","file_path":"README.md","content":"# Seeded"}]}`)
+		fmt.Fprint(w, `{"id":"ed899a2f4b50b4370feeea94676502b42383c746"}`)
+	})
+
+	opt := &CreateProjectOptions{
+		Name:          String("seeded"),
+		DefaultBranch: String("main"),
+	}
+	files := map[string]string{
+		"README.md":      "# Seeded",
+		".gitlab-ci.yml": "test: {}",
+	}
+
+	project, _, err := client.Projects.CreateProjectWithFiles(opt, files, "Initial commit")
+	if err != nil {
+		t.Fatalf("Projects.CreateProjectWithFiles returned error: %v", err)
+	}
+
+	want := &Project{ID: 7, Name: "seeded"}
+	if !reflect.DeepEqual(want, project) {
+		t.Errorf("Projects.CreateProjectWithFiles returned %+v, want %+v", project, want)
+	}
+}
+
+func TestCreateProjectWithFilesPopulatedRepository(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("project created although its repository would be populated")
+	})
+
+	files := map[string]string{"README.md": "# Seeded"}
+	for name, opt := range map[string]*CreateProjectOptions{
+		"readme":          {Name: String("seeded"), InitializeWithReadme: Bool(true)},
+		"import":          {Name: String("seeded"), ImportURL: String("https://gitlab.example.com/group/project.git")},
+		"template":        {Name: String("seeded"), TemplateName: String("go")},
+		"custom template": {Name: String("seeded"), UseCustomTemplate: Bool(true), TemplateProjectID: Int(3)},
+	} {
+		project, _, err := client.Projects.CreateProjectWithFiles(opt, files, "Initial commit")
+		if err == nil {
+			t.Errorf("%s: Projects.CreateProjectWithFiles returned no error", name)
+		}
+		if project != nil {
+			t.Errorf("%s: Projects.CreateProjectWithFiles returned %+v, want nil", name, project)
+		}
+	}
+}
+
+func TestListProjectsWithStatistics(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/projects?statistics=true")
+		fmt.Fprint(w, `[{
+			"id": 1,
+			"open_issues_count": 3,
+			"forks_count": 5,
+			"star_count": 8,
+			"statistics": {
+				"commit_count": 37,
+				"storage_size": 2048,
+				"repository_size": 1024,
+				"wiki_size": 64,
+				"packages_size": 128,
+				"snippets_size": 16,
+				"uploads_size": 32,
+				"container_registry_size": 512
+			}
+		}]`)
+	})
+
+	projects, _, err := client.Projects.ListProjects(&ListProjectsOptions{Statistics: Bool(true)})
+	if err != nil {
+		t.Fatalf("Projects.ListProjects returned error: %v", err)
+	}
+
+	want := []*Project{{
+		ID:              1,
+		OpenIssuesCount: 3,
+		ForksCount:      5,
+		StarCount:       8,
+		Statistics: &ProjectStatistics{
+			CommitCount: 37,
+			StorageStatistics: StorageStatistics{
+				StorageSize:           2048,
+				RepositorySize:        1024,
+				WikiSize:              64,
+				PackagesSize:          128,
+				SnippetsSize:          16,
+				UploadsSize:           32,
+				ContainerRegistrySize: 512,
+			},
+		},
+	}}
+	if !reflect.DeepEqual(want, projects) {
+		t.Errorf("Projects.ListProjects returned %+v, want %+v", projects, want)
+	}
+}
+
+func TestDeleteProjectPermanently(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testURL(t, r, "/api/v4/projects/1?full_path=group%2Fproject&permanently_remove=true")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	opt := &DeleteProjectOptions{
+		FullPath:          String("group/project"),
+		PermanentlyRemove: Bool(true),
+	}
+
+	_, err := client.Projects.DeleteProject(1, opt)
+	if err != nil {
+		t.Errorf("Projects.DeleteProject returned error: %v", err)
+	}
+}
+
+func TestRestoreProject(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/restore", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"id":1,"name":"p"}`)
+	})
+
+	project, _, err := client.Projects.RestoreProject(1)
+	if err != nil {
+		t.Errorf("Projects.RestoreProject returned error: %v", err)
+	}
+
+	want := &Project{ID: 1, Name: "p"}
+	if !reflect.DeepEqual(want, project) {
+		t.Errorf("Projects.RestoreProject returned %+v, want %+v", project, want)
+	}
+}
+
+func TestListProjectsPendingDeletion(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.Query().Get("page") {
+		case "":
+			testURL(t, r, "/api/v4/projects?active=false&per_page=100")
+			w.Header().Set("X-Next-Page", "2")
+			fmt.Fprint(w, `[{"id":1,"archived":true},{"id":2,"marked_for_deletion_at":"2021-01-02"}]`)
+		case "2":
+			testURL(t, r, "/api/v4/projects?active=false&page=2&per_page=100")
+			fmt.Fprint(w, `[{"id":3,"marked_for_deletion_on":"2021-01-03"}]`)
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+
+	projects, err := client.Projects.ListProjectsPendingDeletion(nil)
+	if err != nil {
+		t.Errorf("Projects.ListProjectsPendingDeletion returned error: %v", err)
+	}
+
+	markedAt := ISOTime(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC))
+	markedOn := ISOTime(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC))
+	want := []*Project{{ID: 2, MarkedForDeletionAt: &markedAt}, {ID: 3, MarkedForDeletionOn: &markedOn}}
+	if !reflect.DeepEqual(want, projects) {
+		t.Errorf("Projects.ListProjectsPendingDeletion returned %+v, want %+v", projects, want)
+	}
+}
+
+func TestListProjectHookDeliveries(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/hooks/2/events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/projects/1/hooks/2/events?status=server_failure")
+		fmt.Fprint(w, `[
+			{"id": 3, "url": "https://example.com/hook", "trigger": "push_hooks", "execution_duration": 1.5, "response_status": "500"},
+			{"id": 4, "url": "https://example.com/hook", "trigger": "push_hooks", "response_status": "internal error"}
+		]`)
+	})
+
+	deliveries, _, err := client.Projects.ListProjectHookDeliveries(1, 2, &ListHookDeliveriesOptions{Status: String("server_failure")})
+	if err != nil {
+		t.Fatalf("Projects.ListProjectHookDeliveries returned error: %v", err)
+	}
+
+	want := []*HookDelivery{
+		{ID: 3, URL: "https://example.com/hook", Trigger: "push_hooks", ExecutionDuration: 1.5, ResponseStatus: "500"},
+		{ID: 4, URL: "https://example.com/hook", Trigger: "push_hooks", ResponseStatus: "internal error"},
+	}
+	if !reflect.DeepEqual(want, deliveries) {
+		t.Errorf("Projects.ListProjectHookDeliveries returned %+v, want %+v", deliveries, want)
+	}
+
+	if deliveries[0].StatusCode() != 500 || !deliveries[0].Failed() {
+		t.Errorf("expected delivery 3 to have failed with status 500")
+	}
+	if deliveries[1].StatusCode() != 0 || !deliveries[1].Failed() {
+		t.Errorf("expected delivery 4 to have failed without status")
+	}
+}
+
+func TestResendProjectHookDelivery(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/hooks/2/events/3/resend", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	_, err := client.Projects.ResendProjectHookDelivery(1, 2, 3)
+	if err != nil {
+		t.Errorf("Projects.ResendProjectHookDelivery returned error: %v", err)
+	}
+}
+
+func TestListProjectsGroups(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/groups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/projects/1/groups?shared_min_access_level=30&with_shared=true")
+		fmt.Fprint(w, `[{"id":1,"name":"Foobar Group","full_path":"foo-bar"}]`)
+	})
+
+	opt := &ListProjectGroupOptions{
+		SharedMinAccessLevel: AccessLevel(DeveloperPermissions),
+		WithShared:           Bool(true),
+	}
+	groups, _, err := client.Projects.ListProjectsGroups(1, opt)
+	if err != nil {
+		t.Errorf("Projects.ListProjectsGroups returned error: %v", err)
+	}
+
+	want := []*ProjectGroup{{ID: 1, Name: "Foobar Group", FullPath: "foo-bar"}}
+	if !reflect.DeepEqual(want, groups) {
+		t.Errorf("Projects.ListProjectsGroups returned %+v, want %+v", groups, want)
+	}
+}
+
+func TestListProjectInvitedGroups(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/invited_groups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/projects/1/invited_groups?relation%5B%5D=direct")
+		fmt.Fprint(w, `[{"id":2,"name":"Invited Group"}]`)
+	})
+
+	groups, _, err := client.Projects.ListProjectInvitedGroups(1, &ListProjectInvitedGroupOptions{Relation: []string{"direct"}})
+	if err != nil {
+		t.Errorf("Projects.ListProjectInvitedGroups returned error: %v", err)
+	}
+
+	want := []*Group{{ID: 2, Name: "Invited Group"}}
+	if !reflect.DeepEqual(want, groups) {
+		t.Errorf("Projects.ListProjectInvitedGroups returned %+v, want %+v", groups, want)
+	}
+}
+
+func TestGetAndSetDefaultBranch(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"id":1,"default_branch":"master"}`)
+		case http.MethodPut:
+			testBody(t, r, `{"default_branch":"main"}`)
+			fmt.Fprint(w, `{"id":1,"default_branch":"main"}`)
+		default:
+			t.Errorf("unexpected request method %s", r.Method)
+		}
+	})
+
+	branch, _, err := client.Projects.GetDefaultBranch(1)
+	if err != nil {
+		t.Fatalf("Projects.GetDefaultBranch returned error: %v", err)
+	}
+	if branch != "master" {
+		t.Errorf("Projects.GetDefaultBranch returned %s, want master", branch)
+	}
+
+	project, _, err := client.Projects.SetDefaultBranch(1, "main")
+	if err != nil {
+		t.Fatalf("Projects.SetDefaultBranch returned error: %v", err)
+	}
+	if project.DefaultBranch != "main" {
+		t.Errorf("Projects.SetDefaultBranch returned default branch %s, want main", project.DefaultBranch)
+	}
+}
@@ -17,9 +17,11 @@
 package gitlab
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
 // ProtectedBranchesService handles communication with the protected branch
@@ -212,3 +214,105 @@ func (s *ProtectedBranchesService) RequireCodeOwnerApprovals(pid interface{}, br
 
 	return s.client.Do(req, nil)
 }
+
+// ErrAlreadyProtected is the error of a ProtectionFailure for a project in
+// which the branch or tag is already protected. The existing protection is
+// left unchanged, as it may differ from the protection template.
+var ErrAlreadyProtected = errors.New("already protected, the existing protection was left unchanged")
+
+// ProtectionFailure represents a project for which applying a protection
+// template failed.
+type ProtectionFailure struct {
+	Project interface{}
+	Err     error
+}
+
+// BulkProtectionError is returned by the bulk protection helpers when the
+// protection template could not be applied to one or more projects. The
+// protection is still applied to all other projects.
+type BulkProtectionError struct {
+	Failures []*ProtectionFailure
+}
+
+func (e *BulkProtectionError) Error() string {
+	errs := make([]string, 0, len(e.Failures))
+	for _, f := range e.Failures {
+		errs = append(errs, fmt.Sprintf("project %v: %v", f.Project, f.Err))
+	}
+	return fmt.Sprintf("failed to apply protection to %d project(s): %s", len(e.Failures), strings.Join(errs, "; "))
+}
+
+// BulkProtectRepositoryBranches applies the given protection template to each
+// of the given projects. When a branch with the same name is already
+// protected, the existing protection is never removed or replaced; instead
+// the project is reported as a failure with ErrAlreadyProtected, so the
+// drift can be reviewed. If the template could not be applied to all
+// projects, a *BulkProtectionError is returned describing each failure.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/protected_branches.html#protect-repository-branches
+func (s *ProtectedBranchesService) BulkProtectRepositoryBranches(pids []interface{}, opt *ProtectRepositoryBranchesOptions, options ...RequestOptionFunc) error {
+	if opt == nil || opt.Name == nil {
+		return fmt.Errorf("a protection template with a branch name is required")
+	}
+
+	bulkErr := new(BulkProtectionError)
+	for _, pid := range pids {
+		_, resp, err := s.ProtectRepositoryBranches(pid, opt, options...)
+		if err != nil && resp != nil && resp.StatusCode == http.StatusConflict {
+			err = ErrAlreadyProtected
+		}
+		if err != nil {
+			bulkErr.Failures = append(bulkErr.Failures, &ProtectionFailure{Project: pid, Err: err})
+		}
+	}
+
+	if len(bulkErr.Failures) > 0 {
+		return bulkErr
+	}
+
+	return nil
+}
+
+// ProtectGroupRepositoryBranches applies the given protection template to all
+// non-archived projects of a group, including the projects of its subgroups.
+// See BulkProtectRepositoryBranches for details.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/protected_branches.html#protect-repository-branches
+func (s *ProtectedBranchesService) ProtectGroupRepositoryBranches(gid interface{}, opt *ProtectRepositoryBranchesOptions, options ...RequestOptionFunc) error {
+	pids, err := listGroupProjectIDs(s.client, gid, options...)
+	if err != nil {
+		return err
+	}
+
+	return s.BulkProtectRepositoryBranches(pids, opt, options...)
+}
+
+// listGroupProjectIDs returns the IDs of all non-archived projects of a group
+// and its subgroups.
+func listGroupProjectIDs(c *Client, gid interface{}, options ...RequestOptionFunc) ([]interface{}, error) {
+	opt := &ListGroupProjectsOptions{
+		ListOptions:      ListOptions{PerPage: 100},
+		Archived:         Bool(false),
+		IncludeSubgroups: Bool(true),
+		Simple:           Bool(true),
+	}
+
+	var pids []interface{}
+	for {
+		ps, resp, err := c.Groups.ListGroupProjects(gid, opt, options...)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range ps {
+			pids = append(pids, p.ID)
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	return pids, nil
+}
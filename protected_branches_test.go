@@ -196,3 +196,44 @@ func TestUpdateRepositoryBranches(t *testing.T) {
 		t.Errorf("ProtectedBranches.UpdateRepositoryBranchesOptions returned error: %v", err)
 	}
 }
+
+func TestBulkProtectRepositoryBranches(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/protected_branches", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"name":"main"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/2/protected_branches", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"message":"Protected branch 'main' already exists"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/2/protected_branches/main", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("ProtectedBranches.BulkProtectRepositoryBranches changed the existing protection with %s", r.Method)
+	})
+	mux.HandleFunc("/api/v4/projects/3/protected_branches", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"403 Forbidden"}`)
+	})
+
+	opt := &ProtectRepositoryBranchesOptions{
+		Name:             String("main"),
+		PushAccessLevel:  AccessLevel(NoPermissions),
+		MergeAccessLevel: AccessLevel(MaintainerPermissions),
+	}
+
+	err := client.ProtectedBranches.BulkProtectRepositoryBranches([]interface{}{1, 2, 3}, opt)
+
+	bulkErr, ok := err.(*BulkProtectionError)
+	if !ok {
+		t.Fatalf("ProtectedBranches.BulkProtectRepositoryBranches returned %v, want *BulkProtectionError", err)
+	}
+	if len(bulkErr.Failures) != 2 || bulkErr.Failures[0].Project != 2 || bulkErr.Failures[1].Project != 3 {
+		t.Fatalf("ProtectedBranches.BulkProtectRepositoryBranches returned failures %+v, want projects 2 and 3", bulkErr.Failures)
+	}
+	if bulkErr.Failures[0].Err != ErrAlreadyProtected {
+		t.Errorf("ProtectedBranches.BulkProtectRepositoryBranches returned %v for project 2, want %v", bulkErr.Failures[0].Err, ErrAlreadyProtected)
+	}
+}
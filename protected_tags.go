@@ -111,8 +111,19 @@ func (s *ProtectedTagsService) GetProtectedTag(pid interface{}, tag string, opti
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/protected_tags.html#protect-repository-tags
 type ProtectRepositoryTagsOptions struct {
-	Name              *string           `url:"name" json:"name"`
-	CreateAccessLevel *AccessLevelValue `url:"create_access_level,omitempty" json:"create_access_level,omitempty"`
+	Name              *string                  `url:"name" json:"name"`
+	CreateAccessLevel *AccessLevelValue        `url:"create_access_level,omitempty" json:"create_access_level,omitempty"`
+	AllowedToCreate   []*TagsPermissionOptions `url:"allowed_to_create,omitempty" json:"allowed_to_create,omitempty"`
+}
+
+// TagsPermissionOptions represents a protected tag permission option.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/protected_tags.html#protect-repository-tags
+type TagsPermissionOptions struct {
+	UserID      *int              `url:"user_id,omitempty" json:"user_id,omitempty"`
+	GroupID     *int              `url:"group_id,omitempty" json:"group_id,omitempty"`
+	AccessLevel *AccessLevelValue `url:"access_level,omitempty" json:"access_level,omitempty"`
 }
 
 // ProtectRepositoryTags protects a single repository tag or several project
@@ -160,3 +171,50 @@ func (s *ProtectedTagsService) UnprotectRepositoryTags(pid interface{}, tag stri
 
 	return s.client.Do(req, nil)
 }
+
+// BulkProtectRepositoryTags applies the given protection template to each of
+// the given projects. When a tag with the same name is already protected, the
+// existing protection is never removed or replaced; instead the project is
+// reported as a failure with ErrAlreadyProtected, so the drift can be
+// reviewed. If the template could not be applied to all projects, a
+// *BulkProtectionError is returned describing each failure.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/protected_tags.html#protect-repository-tags
+func (s *ProtectedTagsService) BulkProtectRepositoryTags(pids []interface{}, opt *ProtectRepositoryTagsOptions, options ...RequestOptionFunc) error {
+	if opt == nil || opt.Name == nil {
+		return fmt.Errorf("a protection template with a tag name is required")
+	}
+
+	bulkErr := new(BulkProtectionError)
+	for _, pid := range pids {
+		_, resp, err := s.ProtectRepositoryTags(pid, opt, options...)
+		if err != nil && resp != nil && resp.StatusCode == http.StatusConflict {
+			err = ErrAlreadyProtected
+		}
+		if err != nil {
+			bulkErr.Failures = append(bulkErr.Failures, &ProtectionFailure{Project: pid, Err: err})
+		}
+	}
+
+	if len(bulkErr.Failures) > 0 {
+		return bulkErr
+	}
+
+	return nil
+}
+
+// ProtectGroupRepositoryTags applies the given protection template to all
+// non-archived projects of a group, including the projects of its subgroups.
+// See BulkProtectRepositoryTags for details.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/protected_tags.html#protect-repository-tags
+func (s *ProtectedTagsService) ProtectGroupRepositoryTags(gid interface{}, opt *ProtectRepositoryTagsOptions, options ...RequestOptionFunc) error {
+	pids, err := listGroupProjectIDs(s.client, gid, options...)
+	if err != nil {
+		return err
+	}
+
+	return s.BulkProtectRepositoryTags(pids, opt, options...)
+}
@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestListProtectedTags(t *testing.T) {
@@ -125,3 +126,61 @@ func TestUnprotectRepositoryTags(t *testing.T) {
 	assert.NoError(t, err, "failed to get response")
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
 }
+
+func TestProtectGroupRepositoryTags(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/groups/1/projects?archived=false&include_subgroups=true&per_page=100&simple=true")
+		fmt.Fprint(w, `[{"id":2},{"id":3}]`)
+	})
+
+	var protected []string
+	for _, id := range []string{"2", "3"} {
+		id := id
+		mux.HandleFunc("/api/v4/projects/"+id+"/protected_tags", func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodPost)
+			testBody(t, r, `{"name":"v*","allowed_to_create":[{"access_level":40}]}`)
+			protected = append(protected, id)
+			fmt.Fprint(w, `{"name":"v*"}`)
+		})
+	}
+
+	opt := &ProtectRepositoryTagsOptions{
+		Name:            String("v*"),
+		AllowedToCreate: []*TagsPermissionOptions{{AccessLevel: AccessLevel(MaintainerPermissions)}},
+	}
+
+	err := client.ProtectedTags.ProtectGroupRepositoryTags(1, opt)
+	if err != nil {
+		t.Fatalf("ProtectedTags.ProtectGroupRepositoryTags returned error: %v", err)
+	}
+
+	want := []string{"2", "3"}
+	assert.Equal(t, want, protected)
+}
+
+func TestBulkProtectRepositoryTagsAlreadyProtected(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/protected_tags", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"message":"Protected tag 'v*' already exists"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/protected_tags/v*", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("ProtectedTags.BulkProtectRepositoryTags changed the existing protection with %s", r.Method)
+	})
+
+	opt := &ProtectRepositoryTagsOptions{Name: String("v*")}
+
+	err := client.ProtectedTags.BulkProtectRepositoryTags([]interface{}{1}, opt)
+
+	bulkErr, ok := err.(*BulkProtectionError)
+	require.True(t, ok, "ProtectedTags.BulkProtectRepositoryTags returned %v, want *BulkProtectionError", err)
+	require.Len(t, bulkErr.Failures, 1)
+	assert.Equal(t, ErrAlreadyProtected, bulkErr.Failures[0].Err)
+}
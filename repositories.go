@@ -264,13 +264,34 @@ func (c Contributor) String() string {
 	return Stringify(c)
 }
 
+// ContributorOrderByValue represents a column contributors can be ordered by.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/repositories.html#contributors
+type ContributorOrderByValue string
+
+// These constants represent all valid columns to order contributors by.
+const (
+	ContributorOrderByName    ContributorOrderByValue = "name"
+	ContributorOrderByEmail   ContributorOrderByValue = "email"
+	ContributorOrderByCommits ContributorOrderByValue = "commits"
+)
+
+// ContributorOrderBy is a helper routine that allocates a new ContributorOrderByValue
+// to store v and returns a pointer to it.
+func ContributorOrderBy(v ContributorOrderByValue) *ContributorOrderByValue {
+	p := new(ContributorOrderByValue)
+	*p = v
+	return p
+}
+
 // ListContributorsOptions represents the available ListContributors() options.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/repositories.html#contributors
 type ListContributorsOptions struct {
 	ListOptions
-	OrderBy *string `url:"order_by,omitempty" json:"order_by,omitempty"`
-	Sort    *string `url:"sort,omitempty" json:"sort,omitempty"`
+	OrderBy *ContributorOrderByValue `url:"order_by,omitempty" json:"order_by,omitempty"`
+	Sort    *SortValue               `url:"sort,omitempty" json:"sort,omitempty"`
 }
 
 // Contributors gets the repository contributors list.
@@ -330,3 +351,91 @@ func (s *RepositoriesService) MergeBase(pid interface{}, opt *MergeBaseOptions,
 
 	return c, resp, err
 }
+
+// AddChangelogOptions represents the available AddChangelog() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/repositories.html#add-changelog-data-to-a-changelog-file
+type AddChangelogOptions struct {
+	Version    *string  `url:"version,omitempty" json:"version,omitempty"`
+	Branch     *string  `url:"branch,omitempty" json:"branch,omitempty"`
+	ConfigFile *string  `url:"config_file,omitempty" json:"config_file,omitempty"`
+	Date       *ISOTime `url:"date,omitempty" json:"date,omitempty"`
+	File       *string  `url:"file,omitempty" json:"file,omitempty"`
+	From       *string  `url:"from,omitempty" json:"from,omitempty"`
+	Message    *string  `url:"message,omitempty" json:"message,omitempty"`
+	To         *string  `url:"to,omitempty" json:"to,omitempty"`
+	Trailer    *string  `url:"trailer,omitempty" json:"trailer,omitempty"`
+}
+
+// AddChangelog generates changelog data based on commits in a repository
+// and commits it to a changelog file.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/repositories.html#add-changelog-data-to-a-changelog-file
+func (s *RepositoriesService) AddChangelog(pid interface{}, opt *AddChangelogOptions, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/repository/changelog", pathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodPost, u, opt, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// ChangelogData represents the generated changelog data.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/repositories.html#generate-changelog-data
+type ChangelogData struct {
+	Notes string `json:"notes"`
+}
+
+func (c ChangelogData) String() string {
+	return Stringify(c)
+}
+
+// GenerateChangelogDataOptions represents the available
+// GenerateChangelogData() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/repositories.html#generate-changelog-data
+type GenerateChangelogDataOptions struct {
+	Version    *string  `url:"version,omitempty" json:"version,omitempty"`
+	ConfigFile *string  `url:"config_file,omitempty" json:"config_file,omitempty"`
+	Date       *ISOTime `url:"date,omitempty" json:"date,omitempty"`
+	From       *string  `url:"from,omitempty" json:"from,omitempty"`
+	To         *string  `url:"to,omitempty" json:"to,omitempty"`
+	Trailer    *string  `url:"trailer,omitempty" json:"trailer,omitempty"`
+}
+
+// GenerateChangelogData generates changelog data based on commits in a
+// repository, without committing it to a changelog file.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/repositories.html#generate-changelog-data
+func (s *RepositoriesService) GenerateChangelogData(pid interface{}, opt *GenerateChangelogDataOptions, options ...RequestOptionFunc) (*ChangelogData, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/repository/changelog", pathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cd := new(ChangelogData)
+	resp, err := s.client.Do(req, cd)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return cd, resp, err
+}
@@ -0,0 +1,73 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAddChangelog(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/changelog", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"version":"1.0.0","branch":"main","date":"2021-06-01"}`)
+	})
+
+	date := ISOTime(time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC))
+	opt := &AddChangelogOptions{
+		Version: String("1.0.0"),
+		Branch:  String("main"),
+		Date:    &date,
+	}
+
+	_, err := client.Repositories.AddChangelog(1, opt)
+	if err != nil {
+		t.Errorf("Repositories.AddChangelog returned error: %v", err)
+	}
+}
+
+func TestGenerateChangelogData(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/changelog", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/projects/1/repository/changelog?trailer=Type&version=1.0.0")
+		fmt.Fprint(w, `{"notes":"## 1.0.0 (2021-06-01)\n\n### fixed (1 change)\n"}`)
+	})
+
+	opt := &GenerateChangelogDataOptions{
+		Version: String("1.0.0"),
+		Trailer: String("Type"),
+	}
+
+	data, _, err := client.Repositories.GenerateChangelogData(1, opt)
+	if err != nil {
+		t.Errorf("Repositories.GenerateChangelogData returned error: %v", err)
+	}
+
+	want := &ChangelogData{Notes: "## 1.0.0 (2021-06-01)\n\n### fixed (1 change)\n"}
+	if !reflect.DeepEqual(want, data) {
+		t.Errorf("Repositories.GenerateChangelogData returned %+v, want %+v", data, want)
+	}
+}
@@ -0,0 +1,210 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RepositoryHealthFile represents a file that should exist in a repository.
+// The file is found when any of its paths exists on the default branch.
+type RepositoryHealthFile struct {
+	Name  string
+	Paths []string
+}
+
+// DefaultRepositoryHealthFiles are the files checked by GetRepositoryHealth
+// when no files are given in the options.
+var DefaultRepositoryHealthFiles = []*RepositoryHealthFile{
+	{Name: "README", Paths: []string{"README.md", "README", "README.rst", "README.txt"}},
+	{Name: "CODEOWNERS", Paths: []string{"CODEOWNERS", "docs/CODEOWNERS", ".gitlab/CODEOWNERS"}},
+	{Name: ".gitlab-ci.yml", Paths: []string{".gitlab-ci.yml"}},
+}
+
+// RepositoryHealthOptions represents the available GetRepositoryHealth()
+// options.
+type RepositoryHealthOptions struct {
+	// StaleAfter is the time without commits after which a branch is
+	// considered stale. Defaults to 90 days.
+	StaleAfter time.Duration
+
+	// Files are the files that should exist in the repository. Defaults to
+	// DefaultRepositoryHealthFiles.
+	Files []*RepositoryHealthFile
+}
+
+// RepositoryHealth represents a hygiene report of a project repository.
+type RepositoryHealth struct {
+	ProjectID     int
+	DefaultBranch string
+	Statistics    *ProjectStatistics
+
+	// Branches is the total number of branches, ProtectedBranches the number
+	// of branches covered by a protection rule.
+	Branches               int
+	ProtectedBranches      int
+	DefaultBranchProtected bool
+
+	// StaleBranches are the branches, other than the default branch, without
+	// commits in the configured period.
+	StaleBranches []*Branch
+
+	// MissingFiles are the names of the configured files that were not found
+	// on the default branch.
+	MissingFiles []string
+}
+
+func (h RepositoryHealth) String() string {
+	return Stringify(h)
+}
+
+// ProtectedBranchCoverage returns the fraction of branches covered by a
+// protection rule, or 0 when the repository has no branches.
+func (h *RepositoryHealth) ProtectedBranchCoverage() float64 {
+	if h.Branches == 0 {
+		return 0
+	}
+	return float64(h.ProtectedBranches) / float64(h.Branches)
+}
+
+// GetRepositoryHealth collects the repository statistics, the protected
+// branch coverage, the stale branches and the missing default files of a
+// project into a single report. The branches and files are retrieved
+// concurrently; the first error stops the report.
+func (s *RepositoriesService) GetRepositoryHealth(ctx context.Context, pid interface{}, opt *RepositoryHealthOptions) (*RepositoryHealth, error) {
+	o := RepositoryHealthOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	if o.StaleAfter <= 0 {
+		o.StaleAfter = 90 * 24 * time.Hour
+	}
+	if o.Files == nil {
+		o.Files = DefaultRepositoryHealthFiles
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	p, _, err := s.client.Projects.GetProject(pid, &GetProjectOptions{Statistics: Bool(true)}, WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	h := &RepositoryHealth{
+		ProjectID:     p.ID,
+		DefaultBranch: p.DefaultBranch,
+		Statistics:    p.Statistics,
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	fail := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		if err := s.collectBranchHealth(ctx, p.ID, o.StaleAfter, h); err != nil {
+			fail(err)
+		}
+	}()
+
+	found := make([]bool, len(o.Files))
+	for i, f := range o.Files {
+		if p.DefaultBranch == "" {
+			break
+		}
+		wg.Add(1)
+		go func(i int, f *RepositoryHealthFile) {
+			defer wg.Done()
+			ok, err := s.repositoryFileExists(ctx, p.ID, p.DefaultBranch, f.Paths)
+			if err != nil {
+				fail(err)
+				return
+			}
+			found[i] = ok
+		}(i, f)
+	}
+
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	for i, f := range o.Files {
+		if !found[i] {
+			h.MissingFiles = append(h.MissingFiles, f.Name)
+		}
+	}
+
+	return h, nil
+}
+
+// collectBranchHealth adds the branch related details of a repository to h.
+func (s *RepositoriesService) collectBranchHealth(ctx context.Context, pid int, staleAfter time.Duration, h *RepositoryHealth) error {
+	staleBefore := time.Now().Add(-staleAfter)
+	opt := &ListBranchesOptions{ListOptions: ListOptions{PerPage: 100}}
+
+	return ForEachPage(func(options ...RequestOptionFunc) (*Response, error) {
+		branches, resp, err := s.client.Branches.ListBranches(pid, opt, options...)
+		if err != nil {
+			return resp, err
+		}
+
+		for _, b := range branches {
+			h.Branches++
+			if b.Protected {
+				h.ProtectedBranches++
+			}
+			if b.Default || b.Name == h.DefaultBranch {
+				h.DefaultBranchProtected = b.Protected
+				continue
+			}
+			if b.Commit != nil && b.Commit.CommittedDate != nil && b.Commit.CommittedDate.Before(staleBefore) {
+				h.StaleBranches = append(h.StaleBranches, b)
+			}
+		}
+
+		return resp, nil
+	}, WithContext(ctx))
+}
+
+// repositoryFileExists reports whether any of the given paths exists on ref.
+func (s *RepositoriesService) repositoryFileExists(ctx context.Context, pid int, ref string, paths []string) (bool, error) {
+	for _, path := range paths {
+		_, _, err := s.client.RepositoryFiles.GetFileMetaData(pid, path, &GetFileMetaDataOptions{Ref: String(ref)}, WithContext(ctx))
+		if err == nil {
+			return true, nil
+		}
+		if !IsNotFound(err) {
+			return false, err
+		}
+	}
+	return false, nil
+}
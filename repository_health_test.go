@@ -0,0 +1,78 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRepositoryHealth(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/projects/1?statistics=true")
+		fmt.Fprint(w, `{"id":1,"default_branch":"main","statistics":{"commit_count":37,"repository_size":1024}}`)
+	})
+
+	recent := time.Now().Add(-24 * time.Hour).Format(time.RFC3339)
+	old := time.Now().Add(-365 * 24 * time.Hour).Format(time.RFC3339)
+	mux.HandleFunc("/api/v4/projects/1/repository/branches", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprintf(w, `[
+			{"name":"main","default":true,"protected":true,"commit":{"committed_date":%q}},
+			{"name":"release","protected":true,"commit":{"committed_date":%q}},
+			{"name":"feature","commit":{"committed_date":%q}},
+			{"name":"abandoned","commit":{"committed_date":%q}}
+		]`, old, recent, recent, old)
+	})
+
+	mux.HandleFunc("/api/v4/projects/1/repository/files/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodHead)
+		if r.URL.Query().Get("ref") != "main" {
+			t.Errorf("Request ref is %q, want main", r.URL.Query().Get("ref"))
+		}
+		switch strings.TrimPrefix(r.URL.Path, "/api/v4/projects/1/repository/files/") {
+		case "README.md", ".gitlab/CODEOWNERS":
+			w.Header().Set("X-Gitlab-File-Name", "found")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	h, err := client.Repositories.GetRepositoryHealth(context.Background(), 1, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "main", h.DefaultBranch)
+	assert.Equal(t, 37, h.Statistics.CommitCount)
+	assert.Equal(t, 4, h.Branches)
+	assert.Equal(t, 2, h.ProtectedBranches)
+	assert.True(t, h.DefaultBranchProtected)
+	assert.Equal(t, 0.5, h.ProtectedBranchCoverage())
+	require.Len(t, h.StaleBranches, 1)
+	assert.Equal(t, "abandoned", h.StaleBranches[0].Name)
+	assert.Equal(t, []string{".gitlab-ci.yml"}, h.MissingFiles)
+}
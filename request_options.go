@@ -18,6 +18,8 @@ package gitlab
 
 import (
 	"context"
+	"net/url"
+	"strconv"
 
 	retryablehttp "github.com/hashicorp/go-retryablehttp"
 )
@@ -25,6 +27,27 @@ import (
 // RequestOptionFunc can be passed to all API requests to customize the API request.
 type RequestOptionFunc func(*retryablehttp.Request) error
 
+// RequestHookFunc is called with a request before it is sent, see
+// WithRequestHook.
+type RequestHookFunc func(req *retryablehttp.Request)
+
+// ResponseHookFunc is called with the result of a request, see
+// WithResponseHook and WithResponseCallback.
+type ResponseHookFunc func(resp *Response, err error)
+
+// responseCallbackKey is the context key of the callback set using
+// WithResponseCallback.
+type responseCallbackKey struct{}
+
+// WithResponseCallback calls fn with the result of the request, after the
+// response hooks of the client.
+func WithResponseCallback(fn ResponseHookFunc) RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		*req = *req.WithContext(context.WithValue(req.Context(), responseCallbackKey{}, fn))
+		return nil
+	}
+}
+
 // WithSudo takes either a username or user ID and sets the SUDO request header
 func WithSudo(uid interface{}) RequestOptionFunc {
 	return func(req *retryablehttp.Request) error {
@@ -37,10 +60,78 @@ func WithSudo(uid interface{}) RequestOptionFunc {
 	}
 }
 
-// WithContext runs the request with the provided context
+// WithRequestID sets the X-Request-Id header of the request. GitLab uses the
+// ID as correlation ID, if it is configured to trust it, so the request can
+// be traced in the GitLab logs using an ID known to the caller.
+func WithRequestID(id string) RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		req.Header.Set(xRequestID, id)
+		return nil
+	}
+}
+
+// WithContext runs the request with the provided context. The context is
+// used for the whole request, including waiting for the rate limiter and any
+// retries, so it can be used to cancel a request or to set a deadline. Values
+// set by earlier options, such as WithEndpoint and WithResponseCallback, are
+// carried over to the new context.
 func WithContext(ctx context.Context) RequestOptionFunc {
 	return func(req *retryablehttp.Request) error {
-		*req = *req.WithContext(ctx)
+		c := ctx
+		if endpoint := EndpointFromContext(req.Context()); endpoint != "" && EndpointFromContext(c) == "" {
+			c = withEndpoint(c, endpoint)
+		}
+		if fn := req.Context().Value(responseCallbackKey{}); fn != nil && c.Value(responseCallbackKey{}) == nil {
+			c = context.WithValue(c, responseCallbackKey{}, fn)
+		}
+		*req = *req.WithContext(c)
+		return nil
+	}
+}
+
+// WithOffsetPaginationParameters requests the given page of a paginated list,
+// overriding the page set in the options of the list method.
+func WithOffsetPaginationParameters(page int) RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		q := req.URL.Query()
+		q.Set("page", strconv.Itoa(page))
+		req.URL.RawQuery = q.Encode()
+		return nil
+	}
+}
+
+// WithKeysetPagination requests keyset-based pagination instead of
+// offset-based pagination, for list endpoints that support it. Responses of
+// keyset-paginated lists only contain a link to the next page, see
+// Response.NextLink and WithKeysetPaginationParameters.
+func WithKeysetPagination() RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		q := req.URL.Query()
+		q.Set("pagination", "keyset")
+		req.URL.RawQuery = q.Encode()
+		return nil
+	}
+}
+
+// WithKeysetPaginationParameters requests the page of a keyset-paginated list
+// that the given link points to, usually Response.NextLink. The query of the
+// link replaces the query of the request.
+func WithKeysetPaginationParameters(nextLink string) RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		u, err := url.Parse(nextLink)
+		if err != nil {
+			return err
+		}
+		req.URL.RawQuery = u.RawQuery
+		return nil
+	}
+}
+
+// WithEndpoint sets the endpoint label of the request, overriding the label
+// derived from the registered endpoint templates.
+func WithEndpoint(endpoint string) RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		*req = *req.WithContext(withEndpoint(req.Context(), endpoint))
 		return nil
 	}
 }
@@ -0,0 +1,191 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"sync"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+)
+
+// ResponseCache stores the responses of GET requests, so they can be
+// revalidated using conditional requests. When a cached response has an
+// ETag or Last-Modified header, the request is sent with an If-None-Match
+// or If-Modified-Since header and the cached body is returned when GitLab
+// responds with 304 Not Modified. Requests answered with 304 don't count
+// against most GitLab rate limits, which makes this useful for polling.
+//
+// Implementations must be safe for concurrent use.
+type ResponseCache interface {
+	Get(key string) (*CachedResponse, bool)
+	Set(key string, resp *CachedResponse)
+}
+
+// CachedResponse is a response stored in a ResponseCache.
+type CachedResponse struct {
+	Header http.Header
+	Body   []byte
+}
+
+// WithResponseCache enables conditional requests, using the given cache to
+// store the responses of GET requests. Entries are keyed on the request URL
+// and the credentials used, so a cache can be shared between clients.
+// Streamed downloads are never cached.
+func WithResponseCache(cache ResponseCache) ClientOptionFunc {
+	return func(c *Client) error {
+		c.responseCache = cache
+		return nil
+	}
+}
+
+// MemoryResponseCache is an in-memory ResponseCache that evicts the least
+// recently used entry once it holds the maximum number of entries.
+type MemoryResponseCache struct {
+	maxEntries int
+
+	mu      sync.Mutex
+	ll      *list.List
+	entries map[string]*list.Element
+}
+
+type memoryCacheEntry struct {
+	key  string
+	resp *CachedResponse
+}
+
+// NewMemoryResponseCache returns an in-memory ResponseCache holding at most
+// maxEntries responses. A maxEntries of zero or less means no limit.
+func NewMemoryResponseCache(maxEntries int) *MemoryResponseCache {
+	return &MemoryResponseCache{
+		maxEntries: maxEntries,
+		ll:         list.New(),
+		entries:    make(map[string]*list.Element),
+	}
+}
+
+// Get returns the response stored under key.
+func (m *MemoryResponseCache) Get(key string) (*CachedResponse, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	m.ll.MoveToFront(e)
+	return e.Value.(*memoryCacheEntry).resp, true
+}
+
+// Set stores resp under key.
+func (m *MemoryResponseCache) Set(key string, resp *CachedResponse) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if e, ok := m.entries[key]; ok {
+		m.ll.MoveToFront(e)
+		e.Value.(*memoryCacheEntry).resp = resp
+		return
+	}
+	m.entries[key] = m.ll.PushFront(&memoryCacheEntry{key: key, resp: resp})
+
+	if m.maxEntries > 0 && m.ll.Len() > m.maxEntries {
+		oldest := m.ll.Back()
+		m.ll.Remove(oldest)
+		delete(m.entries, oldest.Value.(*memoryCacheEntry).key)
+	}
+}
+
+// Len returns the number of cached responses.
+func (m *MemoryResponseCache) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.ll.Len()
+}
+
+// responseCacheKey returns the cache key of req, which is a hash of the URL
+// and the credentials, so responses are never shared between users.
+func responseCacheKey(req *retryablehttp.Request) string {
+	h := sha256.New()
+	h.Write([]byte(req.URL.String()))
+	for _, name := range []string{"Authorization", "PRIVATE-TOKEN", "JOB-TOKEN", "SUDO"} {
+		h.Write([]byte{0})
+		h.Write([]byte(req.Header.Get(name)))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// prepareConditionalRequest looks up the cached response of req and adds
+// the matching conditional headers. It returns the cache key, or an empty
+// key when the response of req must not be cached.
+func (c *Client) prepareConditionalRequest(req *retryablehttp.Request) (string, *CachedResponse) {
+	if req.Method != http.MethodGet {
+		return "", nil
+	}
+	// Leave conditional requests made by the caller alone.
+	if req.Header.Get("If-None-Match") != "" || req.Header.Get("If-Modified-Since") != "" {
+		return "", nil
+	}
+
+	key := responseCacheKey(req)
+	cached, ok := c.responseCache.Get(key)
+	if !ok {
+		return key, nil
+	}
+	if etag := cached.Header.Get("ETag"); etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+	if lastModified := cached.Header.Get("Last-Modified"); lastModified != "" {
+		req.Header.Set("If-Modified-Since", lastModified)
+	}
+	return key, cached
+}
+
+// useCachedResponse replaces the body of a 304 Not Modified response with
+// the cached body. Headers missing from resp, like the pagination headers,
+// are copied from the cached response.
+func useCachedResponse(resp *http.Response, cached *CachedResponse) {
+	for k, v := range cached.Header {
+		if _, ok := resp.Header[k]; !ok {
+			resp.Header[k] = v
+		}
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(cached.Body))
+	resp.ContentLength = int64(len(cached.Body))
+}
+
+// storeResponse stores the body of resp in the cache when GitLab returned
+// a validator for it. The body of resp is replaced, so it can still be read.
+func (c *Client) storeResponse(key string, resp *http.Response) error {
+	if resp.Header.Get("ETag") == "" && resp.Header.Get("Last-Modified") == "" {
+		return nil
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	c.responseCache.Set(key, &CachedResponse{Header: resp.Header.Clone(), Body: body})
+	return nil
+}
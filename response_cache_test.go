@@ -0,0 +1,91 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponseCache(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	var requests, notModified int
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		requests++
+		if r.Header.Get("If-None-Match") == `"v1"` && r.Header.Get("PRIVATE-TOKEN") == "" {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, `{"id":1,"name":"project"}`)
+	})
+
+	cache := NewMemoryResponseCache(10)
+	require.NoError(t, WithResponseCache(cache)(client))
+
+	want := &Project{ID: 1, Name: "project"}
+
+	project, resp, err := client.Projects.GetProject(1, nil)
+	require.NoError(t, err)
+	assert.Equal(t, want, project)
+	assert.False(t, resp.FromCache)
+	assert.Equal(t, 1, cache.Len())
+
+	project, resp, err = client.Projects.GetProject(1, nil)
+	require.NoError(t, err)
+	assert.Equal(t, want, project)
+	assert.True(t, resp.FromCache)
+	assert.Equal(t, http.StatusNotModified, resp.StatusCode)
+
+	// Responses are not shared between credentials.
+	other, err := NewClient("token", WithBaseURL(server.URL), WithResponseCache(cache))
+	require.NoError(t, err)
+	project, resp, err = other.Projects.GetProject(1, nil)
+	require.NoError(t, err)
+	assert.Equal(t, want, project)
+	assert.False(t, resp.FromCache)
+	assert.Equal(t, 2, cache.Len())
+
+	assert.Equal(t, 3, requests)
+	assert.Equal(t, 1, notModified)
+}
+
+func TestMemoryResponseCacheEviction(t *testing.T) {
+	cache := NewMemoryResponseCache(2)
+	cache.Set("a", &CachedResponse{Body: []byte("a")})
+	cache.Set("b", &CachedResponse{Body: []byte("b")})
+
+	// Using a makes b the least recently used entry.
+	_, ok := cache.Get("a")
+	require.True(t, ok)
+	cache.Set("c", &CachedResponse{Body: []byte("c")})
+
+	assert.Equal(t, 2, cache.Len())
+	_, ok = cache.Get("b")
+	assert.False(t, ok)
+	resp, ok := cache.Get("a")
+	require.True(t, ok)
+	assert.Equal(t, []byte("a"), resp.Body)
+}
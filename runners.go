@@ -17,11 +17,18 @@
 package gitlab
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"sync"
 	"time"
 )
 
+// ErrRunnerAlreadyCreated is returned by RegisterNewRunner when called with a
+// runner authentication token, which belongs to a runner that already exists.
+var ErrRunnerAlreadyCreated = errors.New("runner authentication tokens belong to an existing runner and can't be registered, use VerifyRegisteredRunner instead")
+
 // RunnersService handles communication with the runner related methods of the
 // GitLab API.
 //
@@ -80,6 +87,8 @@ type RunnerDetails struct {
 		Name   string `json:"name"`
 		WebURL string `json:"web_url"`
 	} `json:"groups"`
+	PublicProjectsMinutesCostFactor  float64 `json:"public_projects_minutes_cost_factor"`
+	PrivateProjectsMinutesCostFactor float64 `json:"private_projects_minutes_cost_factor"`
 }
 
 // ListRunnersOptions represents the available ListRunners() options.
@@ -170,6 +179,10 @@ type UpdateRunnerDetailsOptions struct {
 	Locked         *bool    `url:"locked,omitempty" json:"locked,omitempty"`
 	AccessLevel    *string  `url:"access_level,omitempty" json:"access_level,omitempty"`
 	MaximumTimeout *int     `url:"maximum_timeout,omitempty" json:"maximum_timeout,omitempty"`
+
+	// The options below are only available for admins.
+	PublicProjectsMinutesCostFactor  *float64 `url:"public_projects_minutes_cost_factor,omitempty" json:"public_projects_minutes_cost_factor,omitempty"`
+	PrivateProjectsMinutesCostFactor *float64 `url:"private_projects_minutes_cost_factor,omitempty" json:"private_projects_minutes_cost_factor,omitempty"`
 }
 
 // UpdateRunnerDetails updates details for a given runner.
@@ -216,6 +229,25 @@ func (s *RunnersService) RemoveRunner(rid interface{}, options ...RequestOptionF
 	return s.client.Do(req, nil)
 }
 
+// RunnerJobOrderByValue represents a column runner jobs can be ordered by.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/runners.html#list-runners-jobs
+type RunnerJobOrderByValue string
+
+// These constants represent all valid columns to order runner jobs by.
+const (
+	RunnerJobOrderByID RunnerJobOrderByValue = "id"
+)
+
+// RunnerJobOrderBy is a helper routine that allocates a new RunnerJobOrderByValue
+// to store v and returns a pointer to it.
+func RunnerJobOrderBy(v RunnerJobOrderByValue) *RunnerJobOrderByValue {
+	p := new(RunnerJobOrderByValue)
+	*p = v
+	return p
+}
+
 // ListRunnerJobsOptions represents the available ListRunnerJobs()
 // options. Status can be one of: running, success, failed, canceled.
 //
@@ -223,9 +255,9 @@ func (s *RunnersService) RemoveRunner(rid interface{}, options ...RequestOptionF
 // https://docs.gitlab.com/ce/api/runners.html#list-runners-jobs
 type ListRunnerJobsOptions struct {
 	ListOptions
-	Status  *string `url:"status,omitempty" json:"status,omitempty"`
-	OrderBy *string `url:"order_by,omitempty" json:"order_by,omitempty"`
-	Sort    *string `url:"sort,omitempty" json:"sort,omitempty"`
+	Status  *string                `url:"status,omitempty" json:"status,omitempty"`
+	OrderBy *RunnerJobOrderByValue `url:"order_by,omitempty" json:"order_by,omitempty"`
+	Sort    *SortValue             `url:"sort,omitempty" json:"sort,omitempty"`
 }
 
 // ListRunnerJobs gets a list of jobs that are being processed or were processed by specified Runner.
@@ -407,9 +439,19 @@ type RegisterNewRunnerInfoOptions struct {
 
 // RegisterNewRunner registers a new Runner for the instance.
 //
+// Deprecated: Runner registration tokens are deprecated since GitLab 15.6 and
+// will be removed. Create runners with UsersService.CreateUserRunner instead,
+// which returns a runner authentication token. Runners created that way
+// don't need to be registered, so passing a runner authentication token
+// returns ErrRunnerAlreadyCreated.
+//
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/runners.html#register-a-new-runner
 func (s *RunnersService) RegisterNewRunner(opt *RegisterNewRunnerOptions, options ...RequestOptionFunc) (*Runner, *Response, error) {
+	if opt != nil && opt.Token != nil && DetectTokenKind(*opt.Token) == RunnerAuthenticationTokenKind {
+		return nil, nil, ErrRunnerAlreadyCreated
+	}
+
 	req, err := s.client.NewRequest(http.MethodPost, "runners", opt, options)
 	if err != nil {
 		return nil, nil, err
@@ -480,3 +522,265 @@ func (s *RunnersService) VerifyRegisteredRunner(opt *VerifyRegisteredRunnerOptio
 
 	return s.client.Do(req, nil)
 }
+
+// RunnerRegistrationToken represents a runner registration token.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/runners.html#reset-instances-runner-registration-token
+type RunnerRegistrationToken struct {
+	Token          string     `json:"token"`
+	TokenExpiresAt *time.Time `json:"token_expires_at"`
+}
+
+// ResetInstanceRunnerRegistrationToken resets the instance's runner
+// registration token. Available only for admins.
+//
+// Deprecated: Runner registration tokens are deprecated since GitLab 15.6,
+// create runners with UsersService.CreateUserRunner instead.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/runners.html#reset-instances-runner-registration-token
+func (s *RunnersService) ResetInstanceRunnerRegistrationToken(options ...RequestOptionFunc) (*RunnerRegistrationToken, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodPost, "runners/reset_registration_token", nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(RunnerRegistrationToken)
+	resp, err := s.client.Do(req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, err
+}
+
+// ResetGroupRunnerRegistrationToken resets a group's runner registration
+// token.
+//
+// Deprecated: Runner registration tokens are deprecated since GitLab 15.6,
+// create runners with UsersService.CreateUserRunner instead.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/runners.html#reset-groups-runner-registration-token
+func (s *RunnersService) ResetGroupRunnerRegistrationToken(gid interface{}, options ...RequestOptionFunc) (*RunnerRegistrationToken, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/runners/reset_registration_token", pathEscape(group))
+
+	req, err := s.client.NewRequest(http.MethodPost, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(RunnerRegistrationToken)
+	resp, err := s.client.Do(req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, err
+}
+
+// ResetProjectRunnerRegistrationToken resets a project's runner registration
+// token.
+//
+// Deprecated: Runner registration tokens are deprecated since GitLab 15.6,
+// create runners with UsersService.CreateUserRunner instead.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/runners.html#reset-projects-runner-registration-token
+func (s *RunnersService) ResetProjectRunnerRegistrationToken(pid interface{}, options ...RequestOptionFunc) (*RunnerRegistrationToken, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/runners/reset_registration_token", pathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodPost, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(RunnerRegistrationToken)
+	resp, err := s.client.Do(req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, err
+}
+
+// RemoveRunners removes the runners with the given IDs, using ExecuteBulk. A
+// result is returned for every runner, in the same order as the IDs.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/runners.html#remove-a-runner
+func (s *RunnersService) RemoveRunners(ctx context.Context, runners []int, opt *BulkOptions) []*BulkResult {
+	ops := make([]BulkOperation, len(runners))
+	for i, rid := range runners {
+		rid := rid
+		ops[i] = func(options ...RequestOptionFunc) (*Response, error) {
+			return s.RemoveRunner(rid, options...)
+		}
+	}
+
+	return s.client.ExecuteBulk(ctx, ops, opt)
+}
+
+// CleanupStaleRunnersOptions represents the available CleanupStaleRunners()
+// options.
+type CleanupStaleRunnersOptions struct {
+	// ListRunnersOptions filters the runners that are considered for removal.
+	ListRunnersOptions *ListRunnersOptions
+
+	// StaleAfter is the duration after which a runner that did not contact
+	// GitLab is considered stale. It must be positive.
+	StaleAfter time.Duration
+
+	// IncludeNeverContacted also considers runners that never contacted
+	// GitLab as stale.
+	IncludeNeverContacted bool
+
+	// Remove removes the stale runners. By default, the stale runners are
+	// only reported.
+	Remove bool
+
+	// Concurrency is the number of runners whose details are retrieved
+	// concurrently. Defaults to 4.
+	Concurrency int
+
+	// BulkOptions are the options used to remove the stale runners.
+	BulkOptions *BulkOptions
+}
+
+// StaleRunner represents a runner found by CleanupStaleRunners.
+type StaleRunner struct {
+	// Runner contains the details of the stale runner.
+	Runner *RunnerDetails
+
+	// Result is the result of removing the runner, or nil when the runner
+	// was only reported.
+	Result *BulkResult
+}
+
+// CleanupStaleRunners finds all runners in the GitLab instance that did not
+// contact GitLab within the given duration. The stale runners are only
+// reported, unless Remove is set in which case they are removed and
+// returned together with the results of removing them, so failures can be
+// reported. Available only for admins.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/runners.html#list-all-runners
+func (s *RunnersService) CleanupStaleRunners(ctx context.Context, opt *CleanupStaleRunnersOptions) ([]*StaleRunner, error) {
+	if opt == nil || opt.StaleAfter <= 0 {
+		return nil, errors.New("StaleAfter must be a positive duration")
+	}
+	concurrency := opt.Concurrency
+	if concurrency <= 0 {
+		concurrency = 4
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	lopt := ListRunnersOptions{}
+	if opt.ListRunnersOptions != nil {
+		lopt = *opt.ListRunnersOptions
+	}
+
+	var runners []*Runner
+	for {
+		rs, resp, err := s.ListAllRunners(&lopt, WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		runners = append(runners, rs...)
+		if resp.NextPage == 0 {
+			break
+		}
+		lopt.Page = resp.NextPage
+	}
+
+	// The list of runners doesn't contain the time a runner last contacted
+	// GitLab, so the details of every runner are retrieved.
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+		details  = make([]*RunnerDetails, len(runners))
+	)
+
+	fail := func(err error) {
+		mu.Lock()
+		if firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+		mu.Unlock()
+	}
+
+	work := make(chan int)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range work {
+				d, _, err := s.GetRunnerDetails(runners[i].ID, WithContext(ctx))
+				if err != nil {
+					fail(err)
+					continue
+				}
+				details[i] = d
+			}
+		}()
+	}
+
+feed:
+	for i := range runners {
+		select {
+		case work <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(work)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	staleBefore := time.Now().Add(-opt.StaleAfter)
+
+	var stale []*StaleRunner
+	for _, d := range details {
+		switch {
+		case d.ContactedAt == nil && !opt.IncludeNeverContacted:
+			continue
+		case d.ContactedAt != nil && !d.ContactedAt.Before(staleBefore):
+			continue
+		}
+
+		stale = append(stale, &StaleRunner{Runner: d})
+	}
+
+	if !opt.Remove || len(stale) == 0 {
+		return stale, nil
+	}
+
+	ids := make([]int, len(stale))
+	for i, r := range stale {
+		ids[i] = r.Runner.ID
+	}
+	for i, result := range s.RemoveRunners(ctx, ids, opt.BulkOptions) {
+		stale[i].Result = result
+	}
+
+	return stale, nil
+}
@@ -17,10 +17,13 @@
 package gitlab
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
+	"sync"
 	"testing"
 	"time"
 )
@@ -327,3 +330,163 @@ func TestVerifyRegisteredRunner(t *testing.T) {
 		t.Errorf("Runners.VerifyRegisteredRunner returned returned status code  %+v, want %+v", resp.StatusCode, want)
 	}
 }
+
+func TestResetRunnerRegistrationTokens(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	for _, path := range []string{
+		"/api/v4/runners/reset_registration_token",
+		"/api/v4/groups/1/runners/reset_registration_token",
+		"/api/v4/projects/1/runners/reset_registration_token",
+	} {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodPost)
+			fmt.Fprint(w, `{"token":"6337ff461c94fd3fa32ba3b1ff4125","token_expires_at":"2021-09-27T21:05:03.203Z"}`)
+		})
+	}
+
+	expiresAt := time.Date(2021, time.September, 27, 21, 5, 3, 203000000, time.UTC)
+	want := &RunnerRegistrationToken{Token: "6337ff461c94fd3fa32ba3b1ff4125", TokenExpiresAt: &expiresAt}
+
+	token, _, err := client.Runners.ResetInstanceRunnerRegistrationToken()
+	if err != nil {
+		t.Fatalf("Runners.ResetInstanceRunnerRegistrationToken returned error: %v", err)
+	}
+	if !reflect.DeepEqual(want, token) {
+		t.Errorf("Runners.ResetInstanceRunnerRegistrationToken returned %+v, want %+v", token, want)
+	}
+
+	token, _, err = client.Runners.ResetGroupRunnerRegistrationToken(1)
+	if err != nil {
+		t.Fatalf("Runners.ResetGroupRunnerRegistrationToken returned error: %v", err)
+	}
+	if !reflect.DeepEqual(want, token) {
+		t.Errorf("Runners.ResetGroupRunnerRegistrationToken returned %+v, want %+v", token, want)
+	}
+
+	token, _, err = client.Runners.ResetProjectRunnerRegistrationToken(1)
+	if err != nil {
+		t.Fatalf("Runners.ResetProjectRunnerRegistrationToken returned error: %v", err)
+	}
+	if !reflect.DeepEqual(want, token) {
+		t.Errorf("Runners.ResetProjectRunnerRegistrationToken returned %+v, want %+v", token, want)
+	}
+}
+
+func TestRemoveRunners(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/runners/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/api/v4/runners/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	results := client.Runners.RemoveRunners(context.Background(), []int{1, 2}, nil)
+	if len(results) != 2 {
+		t.Fatalf("Runners.RemoveRunners returned %d results, want 2", len(results))
+	}
+	if results[0].Err != nil {
+		t.Errorf("Runners.RemoveRunners returned an error for runner 1: %v", results[0].Err)
+	}
+	if results[1].Err == nil {
+		t.Errorf("Runners.RemoveRunners returned no error for runner 2")
+	}
+}
+
+func TestCleanupStaleRunners(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	recent := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	old := time.Now().Add(-100 * 24 * time.Hour).UTC().Format(time.RFC3339)
+
+	mux.HandleFunc("/api/v4/runners/all", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"id":1},{"id":2},{"id":3}]`)
+	})
+	mux.HandleFunc("/api/v4/runners/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprintf(w, `{"id":1,"contacted_at":%q}`, recent)
+	})
+
+	var mu sync.Mutex
+	removed := map[string]bool{}
+	stale := func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			if r.URL.Path == "/api/v4/runners/2" {
+				fmt.Fprintf(w, `{"id":2,"contacted_at":%q}`, old)
+			} else {
+				fmt.Fprint(w, `{"id":3,"contacted_at":null}`)
+			}
+		case http.MethodDelete:
+			mu.Lock()
+			removed[r.URL.Path] = true
+			mu.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}
+	mux.HandleFunc("/api/v4/runners/2", stale)
+	mux.HandleFunc("/api/v4/runners/3", stale)
+
+	opt := &CleanupStaleRunnersOptions{
+		StaleAfter: 90 * 24 * time.Hour,
+	}
+
+	runners, err := client.Runners.CleanupStaleRunners(context.Background(), opt)
+	if err != nil {
+		t.Fatalf("Runners.CleanupStaleRunners returns an error: %v", err)
+	}
+	if len(runners) != 1 || runners[0].Runner.ID != 2 || runners[0].Result != nil {
+		t.Fatalf("Runners.CleanupStaleRunners returned %+v, want only runner 2", runners)
+	}
+	if len(removed) != 0 {
+		t.Fatalf("Runners.CleanupStaleRunners removed %v without Remove set", removed)
+	}
+
+	opt.Remove = true
+	opt.IncludeNeverContacted = true
+
+	runners, err = client.Runners.CleanupStaleRunners(context.Background(), opt)
+	if err != nil {
+		t.Fatalf("Runners.CleanupStaleRunners returns an error: %v", err)
+	}
+	if len(runners) != 2 || runners[0].Runner.ID != 2 || runners[1].Runner.ID != 3 {
+		t.Fatalf("Runners.CleanupStaleRunners returned %+v, want runners 2 and 3", runners)
+	}
+	for _, r := range runners {
+		if r.Result == nil || r.Result.Err != nil {
+			t.Errorf("Runners.CleanupStaleRunners failed to remove runner %d: %+v", r.Runner.ID, r.Result)
+		}
+	}
+	if !removed["/api/v4/runners/2"] || !removed["/api/v4/runners/3"] {
+		t.Errorf("Runners.CleanupStaleRunners removed %v, want runners 2 and 3", removed)
+	}
+}
+
+func TestCleanupStaleRunnersRequiresStaleAfter(t *testing.T) {
+	_, server, client := setup(t)
+	defer teardown(server)
+
+	for _, opt := range []*CleanupStaleRunnersOptions{nil, {Remove: true}, {StaleAfter: -time.Hour}} {
+		if _, err := client.Runners.CleanupStaleRunners(context.Background(), opt); err == nil {
+			t.Errorf("Runners.CleanupStaleRunners(%+v) returned no error", opt)
+		}
+	}
+}
+
+func TestRegisterNewRunnerWithAuthenticationToken(t *testing.T) {
+	_, server, client := setup(t)
+	defer teardown(server)
+
+	_, _, err := client.Runners.RegisterNewRunner(&RegisterNewRunnerOptions{Token: String("glrt-kyahzxLaj4Dc1jQf4xjX")})
+	if !errors.Is(err, ErrRunnerAlreadyCreated) {
+		t.Errorf("Runners.RegisterNewRunner returned error %v, want %v", err, ErrRunnerAlreadyCreated)
+	}
+}
@@ -0,0 +1,215 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+)
+
+// SavedRepliesService handles communication with the saved replies (comment
+// templates) related methods of the GitLab API. Saved replies are only
+// exposed by the GraphQL API, so all methods of this service use the GraphQL
+// endpoint.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/profile/comment_templates.html
+type SavedRepliesService struct {
+	client *Client
+}
+
+// SavedReply represents a saved reply of the authenticated user.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#savedreply
+type SavedReply struct {
+	ID      int
+	Name    string
+	Content string
+}
+
+func (r SavedReply) String() string {
+	return Stringify(r)
+}
+
+// graphQLSavedReply is the GraphQL representation of a SavedReply.
+type graphQLSavedReply struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Content string `json:"content"`
+}
+
+func (r *graphQLSavedReply) savedReply() (*SavedReply, error) {
+	if r == nil {
+		return nil, nil
+	}
+	id, err := ParseGlobalID(r.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &SavedReply{ID: id, Name: r.Name, Content: r.Content}, nil
+}
+
+// ListSavedReplies lists all saved replies of the authenticated user.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#currentusersavedreplies
+func (s *SavedRepliesService) ListSavedReplies(options ...RequestOptionFunc) ([]*SavedReply, error) {
+	query := `
+query($after: String) {
+  currentUser {
+    savedReplies(after: $after) {
+      nodes {
+        id
+        name
+        content
+      }
+      pageInfo {
+        hasNextPage
+        endCursor
+      }
+    }
+  }
+}`
+
+	variables := map[string]interface{}{}
+
+	var replies []*SavedReply
+	for {
+		var data struct {
+			CurrentUser *struct {
+				SavedReplies struct {
+					Nodes    []*graphQLSavedReply `json:"nodes"`
+					PageInfo struct {
+						HasNextPage bool   `json:"hasNextPage"`
+						EndCursor   string `json:"endCursor"`
+					} `json:"pageInfo"`
+				} `json:"savedReplies"`
+			} `json:"currentUser"`
+		}
+		if _, err := s.client.doGraphQL(query, variables, &data, options); err != nil {
+			return nil, err
+		}
+		if data.CurrentUser == nil {
+			return nil, fmt.Errorf("saved replies are only available for authenticated users")
+		}
+
+		for _, node := range data.CurrentUser.SavedReplies.Nodes {
+			r, err := node.savedReply()
+			if err != nil {
+				return nil, err
+			}
+			replies = append(replies, r)
+		}
+
+		if !data.CurrentUser.SavedReplies.PageInfo.HasNextPage {
+			break
+		}
+		variables["after"] = data.CurrentUser.SavedReplies.PageInfo.EndCursor
+	}
+
+	return replies, nil
+}
+
+// SavedReplyOptions represents the available CreateSavedReply() and
+// UpdateSavedReply() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationsavedreplycreate
+type SavedReplyOptions struct {
+	Name    *string `json:"name,omitempty"`
+	Content *string `json:"content,omitempty"`
+}
+
+// savedReplyMutation executes one of the saved reply mutations, all of which
+// return the saved reply and a list of errors.
+func (s *SavedRepliesService) savedReplyMutation(mutation, inputType string, input map[string]interface{}, options []RequestOptionFunc) (*SavedReply, *Response, error) {
+	query := fmt.Sprintf(`
+mutation($input: %s!) {
+  %s(input: $input) {
+    errors
+    savedReply {
+      id
+      name
+      content
+    }
+  }
+}`, inputType, mutation)
+
+	var data map[string]*struct {
+		Errors     []string           `json:"errors"`
+		SavedReply *graphQLSavedReply `json:"savedReply"`
+	}
+	resp, err := s.client.doGraphQL(query, map[string]interface{}{"input": input}, &data, options)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	result := data[mutation]
+	if result == nil {
+		return nil, resp, fmt.Errorf("%s: no result returned", mutation)
+	}
+	if err := mutationError(mutation, result.Errors); err != nil {
+		return nil, resp, err
+	}
+
+	r, err := result.SavedReply.savedReply()
+	return r, resp, err
+}
+
+// savedReplyInput returns the mutation input for the given options.
+func savedReplyInput(opt *SavedReplyOptions) map[string]interface{} {
+	input := make(map[string]interface{})
+	if opt != nil {
+		if opt.Name != nil {
+			input["name"] = *opt.Name
+		}
+		if opt.Content != nil {
+			input["content"] = *opt.Content
+		}
+	}
+	return input
+}
+
+// CreateSavedReply creates a new saved reply for the authenticated user.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationsavedreplycreate
+func (s *SavedRepliesService) CreateSavedReply(opt *SavedReplyOptions, options ...RequestOptionFunc) (*SavedReply, *Response, error) {
+	return s.savedReplyMutation("savedReplyCreate", "SavedReplyCreateInput", savedReplyInput(opt), options)
+}
+
+// UpdateSavedReply updates a saved reply of the authenticated user.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationsavedreplyupdate
+func (s *SavedRepliesService) UpdateSavedReply(reply int, opt *SavedReplyOptions, options ...RequestOptionFunc) (*SavedReply, *Response, error) {
+	input := savedReplyInput(opt)
+	input["id"] = GlobalID("Users::SavedReply", reply)
+
+	return s.savedReplyMutation("savedReplyUpdate", "SavedReplyUpdateInput", input, options)
+}
+
+// DeleteSavedReply deletes a saved reply of the authenticated user.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationsavedreplydestroy
+func (s *SavedRepliesService) DeleteSavedReply(reply int, options ...RequestOptionFunc) (*Response, error) {
+	input := map[string]interface{}{"id": GlobalID("Users::SavedReply", reply)}
+
+	_, resp, err := s.savedReplyMutation("savedReplyDestroy", "SavedReplyDestroyInput", input, options)
+	return resp, err
+}
@@ -0,0 +1,115 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListSavedReplies(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var req graphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		if req.Variables["after"] == nil {
+			fmt.Fprint(w, `{"data": {"currentUser": {"savedReplies": {
+				"nodes": [{"id": "gid://gitlab/Users::SavedReply/1", "name": "thanks", "content": "Thanks for the contribution!"}],
+				"pageInfo": {"hasNextPage": true, "endCursor": "next"}
+			}}}}`)
+			return
+		}
+
+		assert.Equal(t, "next", req.Variables["after"])
+		fmt.Fprint(w, `{"data": {"currentUser": {"savedReplies": {
+			"nodes": [{"id": "gid://gitlab/Users::SavedReply/2", "name": "dupe", "content": "Duplicate of"}],
+			"pageInfo": {"hasNextPage": false, "endCursor": ""}
+		}}}}`)
+	})
+
+	replies, err := client.SavedReplies.ListSavedReplies()
+	require.NoError(t, err)
+
+	want := []*SavedReply{
+		{ID: 1, Name: "thanks", Content: "Thanks for the contribution!"},
+		{ID: 2, Name: "dupe", Content: "Duplicate of"},
+	}
+	assert.Equal(t, want, replies)
+}
+
+func TestUpdateSavedReply(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		var req graphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Contains(t, req.Query, "savedReplyUpdate(input: $input)")
+		assert.Equal(t, map[string]interface{}{
+			"id":      "gid://gitlab/Users::SavedReply/1",
+			"content": "Thank you!",
+		}, req.Variables["input"])
+
+		fmt.Fprint(w, `{"data": {"savedReplyUpdate": {"errors": [], "savedReply": {
+			"id": "gid://gitlab/Users::SavedReply/1", "name": "thanks", "content": "Thank you!"
+		}}}}`)
+	})
+
+	reply, _, err := client.SavedReplies.UpdateSavedReply(1, &SavedReplyOptions{Content: String("Thank you!")})
+	require.NoError(t, err)
+	assert.Equal(t, &SavedReply{ID: 1, Name: "thanks", Content: "Thank you!"}, reply)
+}
+
+func TestCreateSavedReplyMutationError(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": {"savedReplyCreate": {"errors": ["Name has already been taken"], "savedReply": null}}}`)
+	})
+
+	_, _, err := client.SavedReplies.CreateSavedReply(&SavedReplyOptions{Name: String("thanks"), Content: String("Thanks!")})
+	assert.EqualError(t, err, "savedReplyCreate: [Name has already been taken]")
+}
+
+func TestDeleteSavedReply(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		var req graphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Contains(t, req.Query, "savedReplyDestroy(input: $input)")
+
+		fmt.Fprint(w, `{"data": {"savedReplyDestroy": {"errors": [], "savedReply": {
+			"id": "gid://gitlab/Users::SavedReply/1", "name": "thanks", "content": "Thanks!"
+		}}}}`)
+	})
+
+	_, err := client.SavedReplies.DeleteSavedReply(1)
+	require.NoError(t, err)
+}
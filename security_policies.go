@@ -0,0 +1,267 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"time"
+)
+
+// SecurityPoliciesService handles communication with the security policy
+// related methods of the GitLab API. Security policies are only exposed by
+// the GraphQL API, so all methods of this service use the GraphQL endpoint.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/application_security/policies/
+type SecurityPoliciesService struct {
+	client *Client
+}
+
+// SecurityPolicyProject represents the project that stores the security
+// policies of a project or group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/application_security/policies/#security-policy-project
+type SecurityPolicyProject struct {
+	ID       int
+	Name     string
+	FullPath string
+	WebURL   string
+}
+
+func (p SecurityPolicyProject) String() string {
+	return Stringify(p)
+}
+
+// SecurityPolicy represents a scan execution or scan result policy.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/application_security/policies/
+type SecurityPolicy struct {
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Enabled     bool       `json:"enabled"`
+	YAML        string     `json:"yaml"`
+	UpdatedAt   *time.Time `json:"updatedAt"`
+}
+
+func (p SecurityPolicy) String() string {
+	return Stringify(p)
+}
+
+// GetSecurityPolicyProject gets the security policy project linked to the
+// given project. It returns nil if no security policy project is linked.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#projectsecuritypolicyproject
+func (s *SecurityPoliciesService) GetSecurityPolicyProject(projectPath string, options ...RequestOptionFunc) (*SecurityPolicyProject, *Response, error) {
+	query := `
+query($fullPath: ID!) {
+  project(fullPath: $fullPath) {
+    securityPolicyProject {
+      id
+      name
+      fullPath
+      webUrl
+    }
+  }
+}`
+
+	var data struct {
+		Project *struct {
+			SecurityPolicyProject *struct {
+				ID       string `json:"id"`
+				Name     string `json:"name"`
+				FullPath string `json:"fullPath"`
+				WebURL   string `json:"webUrl"`
+			} `json:"securityPolicyProject"`
+		} `json:"project"`
+	}
+	resp, err := s.client.doGraphQL(query, map[string]interface{}{"fullPath": projectPath}, &data, options)
+	if err != nil {
+		return nil, resp, err
+	}
+	if data.Project == nil {
+		return nil, resp, fmt.Errorf("project %q not found", projectPath)
+	}
+	if data.Project.SecurityPolicyProject == nil {
+		return nil, resp, nil
+	}
+
+	p := data.Project.SecurityPolicyProject
+	id, err := ParseGlobalID(p.ID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &SecurityPolicyProject{
+		ID:       id,
+		Name:     p.Name,
+		FullPath: p.FullPath,
+		WebURL:   p.WebURL,
+	}, resp, nil
+}
+
+// AssignSecurityPolicyProject links the given security policy project to a
+// project, replacing any previously linked security policy project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationsecuritypolicyprojectassign
+func (s *SecurityPoliciesService) AssignSecurityPolicyProject(projectPath string, policyProject int, options ...RequestOptionFunc) (*Response, error) {
+	query := `
+mutation($input: SecurityPolicyProjectAssignInput!) {
+  securityPolicyProjectAssign(input: $input) {
+    errors
+  }
+}`
+
+	input := map[string]interface{}{
+		"fullPath":                projectPath,
+		"securityPolicyProjectId": GlobalID("Project", policyProject),
+	}
+
+	var data struct {
+		SecurityPolicyProjectAssign struct {
+			Errors []string `json:"errors"`
+		} `json:"securityPolicyProjectAssign"`
+	}
+	resp, err := s.client.doGraphQL(query, map[string]interface{}{"input": input}, &data, options)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, mutationError("securityPolicyProjectAssign", data.SecurityPolicyProjectAssign.Errors)
+}
+
+// UnassignSecurityPolicyProject unlinks the security policy project from a
+// project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationsecuritypolicyprojectunassign
+func (s *SecurityPoliciesService) UnassignSecurityPolicyProject(projectPath string, options ...RequestOptionFunc) (*Response, error) {
+	query := `
+mutation($input: SecurityPolicyProjectUnassignInput!) {
+  securityPolicyProjectUnassign(input: $input) {
+    errors
+  }
+}`
+
+	input := map[string]interface{}{
+		"fullPath": projectPath,
+	}
+
+	var data struct {
+		SecurityPolicyProjectUnassign struct {
+			Errors []string `json:"errors"`
+		} `json:"securityPolicyProjectUnassign"`
+	}
+	resp, err := s.client.doGraphQL(query, map[string]interface{}{"input": input}, &data, options)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, mutationError("securityPolicyProjectUnassign", data.SecurityPolicyProjectUnassign.Errors)
+}
+
+// ListSecurityPoliciesOptions represents the available
+// ListScanExecutionPolicies() and ListScanResultPolicies() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#securitypolicyrelationtype
+type ListSecurityPoliciesOptions struct {
+	// DirectOnly only lists the policies of the security policy project
+	// linked to the project itself. By default, the effective policies are
+	// listed, including the policies inherited from the parent groups.
+	DirectOnly bool
+}
+
+// ListScanExecutionPolicies lists the scan execution policies that apply to
+// a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#projectscanexecutionpolicies
+func (s *SecurityPoliciesService) ListScanExecutionPolicies(projectPath string, opt *ListSecurityPoliciesOptions, options ...RequestOptionFunc) ([]*SecurityPolicy, *Response, error) {
+	return s.listSecurityPolicies(projectPath, "scanExecutionPolicies", opt, options)
+}
+
+// ListScanResultPolicies lists the scan result policies that apply to a
+// project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#projectscanresultpolicies
+func (s *SecurityPoliciesService) ListScanResultPolicies(projectPath string, opt *ListSecurityPoliciesOptions, options ...RequestOptionFunc) ([]*SecurityPolicy, *Response, error) {
+	return s.listSecurityPolicies(projectPath, "scanResultPolicies", opt, options)
+}
+
+func (s *SecurityPoliciesService) listSecurityPolicies(projectPath, field string, opt *ListSecurityPoliciesOptions, options []RequestOptionFunc) ([]*SecurityPolicy, *Response, error) {
+	query := `
+query($fullPath: ID!, $relationship: SecurityPolicyRelationType, $after: String) {
+  project(fullPath: $fullPath) {
+    policies: ` + field + `(relationship: $relationship, after: $after) {
+      nodes {
+        name
+        description
+        enabled
+        yaml
+        updatedAt
+      }
+      pageInfo {
+        hasNextPage
+        endCursor
+      }
+    }
+  }
+}`
+
+	relationship := "INHERITED"
+	if opt != nil && opt.DirectOnly {
+		relationship = "DIRECT"
+	}
+	variables := map[string]interface{}{
+		"fullPath":     projectPath,
+		"relationship": relationship,
+	}
+
+	var policies []*SecurityPolicy
+	for {
+		var data struct {
+			Project *struct {
+				Policies struct {
+					Nodes    []*SecurityPolicy `json:"nodes"`
+					PageInfo struct {
+						HasNextPage bool   `json:"hasNextPage"`
+						EndCursor   string `json:"endCursor"`
+					} `json:"pageInfo"`
+				} `json:"policies"`
+			} `json:"project"`
+		}
+		resp, err := s.client.doGraphQL(query, variables, &data, options)
+		if err != nil {
+			return nil, resp, err
+		}
+		if data.Project == nil {
+			return nil, resp, fmt.Errorf("project %q not found", projectPath)
+		}
+
+		policies = append(policies, data.Project.Policies.Nodes...)
+
+		if !data.Project.Policies.PageInfo.HasNextPage {
+			return policies, resp, nil
+		}
+		variables["after"] = data.Project.Policies.PageInfo.EndCursor
+	}
+}
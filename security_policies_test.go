@@ -0,0 +1,120 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSecurityPolicyProject(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var req graphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "group/project", req.Variables["fullPath"])
+
+		fmt.Fprint(w, `{"data": {"project": {"securityPolicyProject": {
+			"id": "gid://gitlab/Project/42",
+			"name": "project - Security policy project",
+			"fullPath": "group/project-security-policy-project",
+			"webUrl": "https://gitlab.example.com/group/project-security-policy-project"
+		}}}}`)
+	})
+
+	project, _, err := client.SecurityPolicies.GetSecurityPolicyProject("group/project")
+	require.NoError(t, err)
+
+	want := &SecurityPolicyProject{
+		ID:       42,
+		Name:     "project - Security policy project",
+		FullPath: "group/project-security-policy-project",
+		WebURL:   "https://gitlab.example.com/group/project-security-policy-project",
+	}
+	assert.Equal(t, want, project)
+}
+
+func TestAssignSecurityPolicyProject(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		var req graphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		input := req.Variables["input"].(map[string]interface{})
+		assert.Equal(t, "group/project", input["fullPath"])
+
+		if _, ok := input["securityPolicyProjectId"]; ok {
+			assert.Equal(t, "gid://gitlab/Project/42", input["securityPolicyProjectId"])
+			fmt.Fprint(w, `{"data": {"securityPolicyProjectAssign": {"errors": []}}}`)
+			return
+		}
+		fmt.Fprint(w, `{"data": {"securityPolicyProjectUnassign": {"errors": ["Policy project doesn't exist"]}}}`)
+	})
+
+	_, err := client.SecurityPolicies.AssignSecurityPolicyProject("group/project", 42)
+	require.NoError(t, err)
+
+	_, err = client.SecurityPolicies.UnassignSecurityPolicyProject("group/project")
+	assert.EqualError(t, err, "securityPolicyProjectUnassign: [Policy project doesn't exist]")
+}
+
+func TestListScanExecutionPolicies(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		var req graphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Contains(t, req.Query, "scanExecutionPolicies")
+		assert.Equal(t, "INHERITED", req.Variables["relationship"])
+
+		switch req.Variables["after"] {
+		case nil:
+			fmt.Fprint(w, `{"data": {"project": {"policies": {
+				"nodes": [{"name": "Run DAST", "enabled": true, "yaml": "name: Run DAST\n"}],
+				"pageInfo": {"hasNextPage": true, "endCursor": "abc"}
+			}}}}`)
+		case "abc":
+			fmt.Fprint(w, `{"data": {"project": {"policies": {
+				"nodes": [{"name": "Run SAST", "enabled": false}],
+				"pageInfo": {"hasNextPage": false}
+			}}}}`)
+		default:
+			t.Errorf("unexpected cursor %v", req.Variables["after"])
+		}
+	})
+
+	policies, _, err := client.SecurityPolicies.ListScanExecutionPolicies("group/project", nil)
+	require.NoError(t, err)
+
+	want := []*SecurityPolicy{
+		{Name: "Run DAST", Enabled: true, YAML: "name: Run DAST\n"},
+		{Name: "Run SAST"},
+	}
+	assert.Equal(t, want, policies)
+}
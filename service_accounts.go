@@ -0,0 +1,298 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// ServiceAccountsService handles communication with the service accounts
+// related methods of the GitLab API. Service accounts are users for
+// automation, which don't use a seat.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/user_service_accounts.html
+type ServiceAccountsService struct {
+	client *Client
+}
+
+// ServiceAccount represents a GitLab service account.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/user_service_accounts.html
+type ServiceAccount struct {
+	ID       int    `json:"id"`
+	Username string `json:"username"`
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+}
+
+func (a ServiceAccount) String() string {
+	return Stringify(a)
+}
+
+// ServiceAccountOrderByValue represents a column service accounts can be
+// ordered by.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/user_service_accounts.html#list-all-instance-service-accounts
+type ServiceAccountOrderByValue string
+
+// These constants represent all valid columns to order service accounts by.
+const (
+	ServiceAccountOrderByID       ServiceAccountOrderByValue = "id"
+	ServiceAccountOrderByUsername ServiceAccountOrderByValue = "username"
+)
+
+// ServiceAccountOrderBy is a helper routine that allocates a new
+// ServiceAccountOrderByValue to store v and returns a pointer to it.
+func ServiceAccountOrderBy(v ServiceAccountOrderByValue) *ServiceAccountOrderByValue {
+	p := new(ServiceAccountOrderByValue)
+	*p = v
+	return p
+}
+
+// ListServiceAccountsOptions represents the available ListServiceAccounts()
+// and ListGroupServiceAccounts() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/user_service_accounts.html#list-all-instance-service-accounts
+type ListServiceAccountsOptions struct {
+	ListOptions
+	OrderBy *ServiceAccountOrderByValue `url:"order_by,omitempty" json:"order_by,omitempty"`
+	Sort    *SortValue                  `url:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// ListServiceAccounts lists all instance service accounts. This requires
+// administrator access.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/user_service_accounts.html#list-all-instance-service-accounts
+func (s *ServiceAccountsService) ListServiceAccounts(opt *ListServiceAccountsOptions, options ...RequestOptionFunc) ([]*ServiceAccount, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "service_accounts", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var sas []*ServiceAccount
+	resp, err := s.client.Do(req, &sas)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return sas, resp, err
+}
+
+// CreateServiceAccountOptions represents the available
+// CreateServiceAccount() and CreateGroupServiceAccount() options. GitLab
+// generates the name and username when they are not set.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/user_service_accounts.html#create-an-instance-service-account
+type CreateServiceAccountOptions struct {
+	Name     *string `url:"name,omitempty" json:"name,omitempty"`
+	Username *string `url:"username,omitempty" json:"username,omitempty"`
+	Email    *string `url:"email,omitempty" json:"email,omitempty"`
+}
+
+// CreateServiceAccount creates an instance service account. This requires
+// administrator access.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/user_service_accounts.html#create-an-instance-service-account
+func (s *ServiceAccountsService) CreateServiceAccount(opt *CreateServiceAccountOptions, options ...RequestOptionFunc) (*ServiceAccount, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodPost, "service_accounts", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sa := new(ServiceAccount)
+	resp, err := s.client.Do(req, sa)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return sa, resp, err
+}
+
+// CreateServiceAccountPersonalAccessToken creates a personal access token for
+// an instance service account. This requires administrator access.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/users.html#create-a-personal-access-token
+func (s *ServiceAccountsService) CreateServiceAccountPersonalAccessToken(user int, opt *CreatePersonalAccessTokenOptions, options ...RequestOptionFunc) (*PersonalAccessToken, *Response, error) {
+	return s.client.Users.CreatePersonalAccessToken(user, opt, options...)
+}
+
+// ListGroupServiceAccounts lists the service accounts of a top-level group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_service_accounts.html#list-all-service-account-users
+func (s *ServiceAccountsService) ListGroupServiceAccounts(gid interface{}, opt *ListServiceAccountsOptions, options ...RequestOptionFunc) ([]*ServiceAccount, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/service_accounts", pathEscape(group))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var sas []*ServiceAccount
+	resp, err := s.client.Do(req, &sas)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return sas, resp, err
+}
+
+// CreateGroupServiceAccount creates a service account in a top-level group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_service_accounts.html#create-a-service-account-user
+func (s *ServiceAccountsService) CreateGroupServiceAccount(gid interface{}, opt *CreateServiceAccountOptions, options ...RequestOptionFunc) (*ServiceAccount, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/service_accounts", pathEscape(group))
+
+	req, err := s.client.NewRequest(http.MethodPost, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sa := new(ServiceAccount)
+	resp, err := s.client.Do(req, sa)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return sa, resp, err
+}
+
+// DeleteGroupServiceAccountOptions represents the available
+// DeleteGroupServiceAccount() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_service_accounts.html#delete-a-service-account-user
+type DeleteGroupServiceAccountOptions struct {
+	HardDelete *bool `url:"hard_delete,omitempty" json:"hard_delete,omitempty"`
+}
+
+// DeleteGroupServiceAccount deletes a service account of a top-level group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_service_accounts.html#delete-a-service-account-user
+func (s *ServiceAccountsService) DeleteGroupServiceAccount(gid interface{}, user int, opt *DeleteGroupServiceAccountOptions, options ...RequestOptionFunc) (*Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("groups/%s/service_accounts/%d", pathEscape(group), user)
+
+	req, err := s.client.NewRequest(http.MethodDelete, u, opt, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// CreateGroupServiceAccountPersonalAccessToken creates a personal access
+// token for a service account of a top-level group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_service_accounts.html#create-a-personal-access-token-for-a-service-account-user
+func (s *ServiceAccountsService) CreateGroupServiceAccountPersonalAccessToken(gid interface{}, user int, opt *CreatePersonalAccessTokenOptions, options ...RequestOptionFunc) (*PersonalAccessToken, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/service_accounts/%d/personal_access_tokens", pathEscape(group), user)
+
+	req, err := s.client.NewRequest(http.MethodPost, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pat := new(PersonalAccessToken)
+	resp, err := s.client.Do(req, pat)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pat, resp, err
+}
+
+// RotateServiceAccountPersonalAccessTokenOptions represents the available
+// RotateServiceAccountPersonalAccessToken() and
+// RotateGroupServiceAccountPersonalAccessToken() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_service_accounts.html#rotate-a-personal-access-token-for-a-service-account-user
+type RotateServiceAccountPersonalAccessTokenOptions struct {
+	ExpiresAt *ISOTime `url:"expires_at,omitempty" json:"expires_at,omitempty"`
+}
+
+// RotateServiceAccountPersonalAccessToken rotates a personal access token of
+// an instance service account. The token is revoked and a new token is
+// returned, which expires at the given date or after a week. GitLab has no
+// dedicated endpoint for instance service accounts, so this uses
+// PersonalAccessTokens.RotatePersonalAccessToken, which requires
+// administrator access to rotate tokens of other users.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/personal_access_tokens.html#rotate-a-personal-access-token
+func (s *ServiceAccountsService) RotateServiceAccountPersonalAccessToken(token int, opt *RotateServiceAccountPersonalAccessTokenOptions, options ...RequestOptionFunc) (*PersonalAccessToken, *Response, error) {
+	var o *RotatePersonalAccessTokenOptions
+	if opt != nil {
+		o = &RotatePersonalAccessTokenOptions{ExpiresAt: opt.ExpiresAt}
+	}
+	return s.client.PersonalAccessTokens.RotatePersonalAccessToken(token, o, options...)
+}
+
+// RotateGroupServiceAccountPersonalAccessToken rotates a personal access
+// token of a service account of a top-level group. The token is revoked and
+// a new token is returned, which expires at the given date or after a week.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_service_accounts.html#rotate-a-personal-access-token-for-a-service-account-user
+func (s *ServiceAccountsService) RotateGroupServiceAccountPersonalAccessToken(gid interface{}, user, token int, opt *RotateServiceAccountPersonalAccessTokenOptions, options ...RequestOptionFunc) (*PersonalAccessToken, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/service_accounts/%d/personal_access_tokens/%d/rotate", pathEscape(group), user, token)
+
+	req, err := s.client.NewRequest(http.MethodPost, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pat := new(PersonalAccessToken)
+	resp, err := s.client.Do(req, pat)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pat, resp, err
+}
@@ -0,0 +1,211 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestListServiceAccounts(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/service_accounts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/service_accounts?order_by=username&sort=asc")
+		fmt.Fprint(w, `[{"id":114,"username":"service_account_33","name":"Service account user"}]`)
+	})
+
+	sas, _, err := client.ServiceAccounts.ListServiceAccounts(&ListServiceAccountsOptions{OrderBy: ServiceAccountOrderBy(ServiceAccountOrderByUsername), Sort: Sort(SortAsc)})
+	if err != nil {
+		t.Fatalf("ServiceAccounts.ListServiceAccounts returned error: %v", err)
+	}
+
+	want := []*ServiceAccount{{ID: 114, Username: "service_account_33", Name: "Service account user"}}
+	if !reflect.DeepEqual(want, sas) {
+		t.Errorf("ServiceAccounts.ListServiceAccounts returned %+v, want %+v", sas, want)
+	}
+}
+
+func TestCreateServiceAccount(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/service_accounts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"name":"Deploy bot","username":"deploy-bot"}`)
+		fmt.Fprint(w, `{"id":57,"username":"deploy-bot","name":"Deploy bot","email":"deploy-bot@noreply.gitlab.example.com"}`)
+	})
+
+	sa, _, err := client.ServiceAccounts.CreateServiceAccount(&CreateServiceAccountOptions{Name: String("Deploy bot"), Username: String("deploy-bot")})
+	if err != nil {
+		t.Fatalf("ServiceAccounts.CreateServiceAccount returned error: %v", err)
+	}
+
+	want := &ServiceAccount{ID: 57, Username: "deploy-bot", Name: "Deploy bot", Email: "deploy-bot@noreply.gitlab.example.com"}
+	if !reflect.DeepEqual(want, sa) {
+		t.Errorf("ServiceAccounts.CreateServiceAccount returned %+v, want %+v", sa, want)
+	}
+}
+
+func TestCreateServiceAccountPersonalAccessToken(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/users/57/personal_access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"name":"deploy","scopes":["api"]}`)
+		fmt.Fprint(w, `{"id":6,"name":"deploy","scopes":["api"],"active":true,"user_id":57,"token":"glpat-abc"}`)
+	})
+
+	pat, _, err := client.ServiceAccounts.CreateServiceAccountPersonalAccessToken(57, &CreatePersonalAccessTokenOptions{Name: String("deploy"), Scopes: []string{"api"}})
+	if err != nil {
+		t.Fatalf("ServiceAccounts.CreateServiceAccountPersonalAccessToken returned error: %v", err)
+	}
+
+	want := &PersonalAccessToken{ID: 6, Name: "deploy", Scopes: []string{"api"}, Active: true, UserID: 57, Token: "glpat-abc"}
+	if !reflect.DeepEqual(want, pat) {
+		t.Errorf("ServiceAccounts.CreateServiceAccountPersonalAccessToken returned %+v, want %+v", pat, want)
+	}
+}
+
+func TestListGroupServiceAccounts(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/service_accounts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/groups/1/service_accounts?page=2")
+		fmt.Fprint(w, `[{"id":57,"username":"service_account_group_1_abc","name":"Service account user"}]`)
+	})
+
+	sas, _, err := client.ServiceAccounts.ListGroupServiceAccounts(1, &ListServiceAccountsOptions{ListOptions: ListOptions{Page: 2}})
+	if err != nil {
+		t.Fatalf("ServiceAccounts.ListGroupServiceAccounts returned error: %v", err)
+	}
+
+	want := []*ServiceAccount{{ID: 57, Username: "service_account_group_1_abc", Name: "Service account user"}}
+	if !reflect.DeepEqual(want, sas) {
+		t.Errorf("ServiceAccounts.ListGroupServiceAccounts returned %+v, want %+v", sas, want)
+	}
+}
+
+func TestCreateGroupServiceAccount(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/service_accounts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"name":"CI bot"}`)
+		fmt.Fprint(w, `{"id":58,"username":"service_account_group_1_def","name":"CI bot"}`)
+	})
+
+	sa, _, err := client.ServiceAccounts.CreateGroupServiceAccount(1, &CreateServiceAccountOptions{Name: String("CI bot")})
+	if err != nil {
+		t.Fatalf("ServiceAccounts.CreateGroupServiceAccount returned error: %v", err)
+	}
+
+	want := &ServiceAccount{ID: 58, Username: "service_account_group_1_def", Name: "CI bot"}
+	if !reflect.DeepEqual(want, sa) {
+		t.Errorf("ServiceAccounts.CreateGroupServiceAccount returned %+v, want %+v", sa, want)
+	}
+}
+
+func TestDeleteGroupServiceAccount(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/service_accounts/58", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testURL(t, r, "/api/v4/groups/1/service_accounts/58?hard_delete=true")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.ServiceAccounts.DeleteGroupServiceAccount(1, 58, &DeleteGroupServiceAccountOptions{HardDelete: Bool(true)})
+	if err != nil {
+		t.Errorf("ServiceAccounts.DeleteGroupServiceAccount returned error: %v", err)
+	}
+}
+
+func TestCreateGroupServiceAccountPersonalAccessToken(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/service_accounts/58/personal_access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"name":"ci","scopes":["read_api"]}`)
+		fmt.Fprint(w, `{"id":7,"name":"ci","scopes":["read_api"],"active":true,"user_id":58,"token":"glpat-def"}`)
+	})
+
+	pat, _, err := client.ServiceAccounts.CreateGroupServiceAccountPersonalAccessToken(1, 58, &CreatePersonalAccessTokenOptions{Name: String("ci"), Scopes: []string{"read_api"}})
+	if err != nil {
+		t.Fatalf("ServiceAccounts.CreateGroupServiceAccountPersonalAccessToken returned error: %v", err)
+	}
+
+	want := &PersonalAccessToken{ID: 7, Name: "ci", Scopes: []string{"read_api"}, Active: true, UserID: 58, Token: "glpat-def"}
+	if !reflect.DeepEqual(want, pat) {
+		t.Errorf("ServiceAccounts.CreateGroupServiceAccountPersonalAccessToken returned %+v, want %+v", pat, want)
+	}
+}
+
+func TestRotateServiceAccountPersonalAccessToken(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/personal_access_tokens/7/rotate", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"expires_at":"2024-06-01"}`)
+		fmt.Fprint(w, `{"id":8,"name":"ci","scopes":["read_api"],"active":true,"user_id":57,"expires_at":"2024-06-01","token":"glpat-ghi"}`)
+	})
+
+	expiresAt := ISOTime(time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC))
+	pat, _, err := client.ServiceAccounts.RotateServiceAccountPersonalAccessToken(7, &RotateServiceAccountPersonalAccessTokenOptions{ExpiresAt: &expiresAt})
+	if err != nil {
+		t.Fatalf("ServiceAccounts.RotateServiceAccountPersonalAccessToken returned error: %v", err)
+	}
+
+	want := &PersonalAccessToken{ID: 8, Name: "ci", Scopes: []string{"read_api"}, Active: true, UserID: 57, ExpiresAt: &expiresAt, Token: "glpat-ghi"}
+	if !reflect.DeepEqual(want, pat) {
+		t.Errorf("ServiceAccounts.RotateServiceAccountPersonalAccessToken returned %+v, want %+v", pat, want)
+	}
+}
+
+func TestRotateGroupServiceAccountPersonalAccessToken(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/service_accounts/58/personal_access_tokens/7/rotate", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"expires_at":"2024-06-01"}`)
+		fmt.Fprint(w, `{"id":8,"name":"ci","scopes":["read_api"],"active":true,"user_id":58,"expires_at":"2024-06-01","token":"glpat-ghi"}`)
+	})
+
+	expiresAt := ISOTime(time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC))
+	pat, _, err := client.ServiceAccounts.RotateGroupServiceAccountPersonalAccessToken(1, 58, 7, &RotateServiceAccountPersonalAccessTokenOptions{ExpiresAt: &expiresAt})
+	if err != nil {
+		t.Fatalf("ServiceAccounts.RotateGroupServiceAccountPersonalAccessToken returned error: %v", err)
+	}
+
+	want := &PersonalAccessToken{ID: 8, Name: "ci", Scopes: []string{"read_api"}, Active: true, UserID: 58, ExpiresAt: &expiresAt, Token: "glpat-ghi"}
+	if !reflect.DeepEqual(want, pat) {
+		t.Errorf("ServiceAccounts.RotateGroupServiceAccountPersonalAccessToken returned %+v, want %+v", pat, want)
+	}
+}
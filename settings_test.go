@@ -65,3 +65,47 @@ func TestUpdateSettings(t *testing.T) {
 		t.Errorf("Settings.UpdateSettings returned %+v, want %+v", settings, want)
 	}
 }
+
+func TestUpdateSettingsDefaultBranch(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/application/settings", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"default_branch_name":"main","default_branch_protection_defaults":{"allowed_to_push":[{"access_level":40}],"allow_force_push":false,"developer_can_initial_push":true}}`)
+		fmt.Fprint(w, `{
+			"default_branch_name": "main",
+			"default_branch_protection_defaults": {
+				"allowed_to_push": [{"access_level": 40}],
+				"allow_force_push": false,
+				"allowed_to_merge": [{"access_level": 40}],
+				"developer_can_initial_push": true
+			}
+		}`)
+	})
+
+	options := &UpdateSettingsOptions{
+		DefaultBranchName: String("main"),
+		DefaultBranchProtectionDefaults: &BranchProtectionDefaultsOptions{
+			AllowedToPush:           &[]*GroupAccessLevel{{AccessLevel: AccessLevel(MaintainerPermissions)}},
+			AllowForcePush:          Bool(false),
+			DeveloperCanInitialPush: Bool(true),
+		},
+	}
+	settings, _, err := client.Settings.UpdateSettings(options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Settings{
+		DefaultBranchName: "main",
+		DefaultBranchProtectionDefaults: &BranchProtectionDefaults{
+			AllowedToPush:           []*GroupAccessLevel{{AccessLevel: AccessLevel(MaintainerPermissions)}},
+			AllowedToMerge:          []*GroupAccessLevel{{AccessLevel: AccessLevel(MaintainerPermissions)}},
+			DeveloperCanInitialPush: true,
+		},
+	}
+	if !reflect.DeepEqual(settings, want) {
+		t.Errorf("Settings.UpdateSettings returned %+v, want %+v", settings, want)
+	}
+}
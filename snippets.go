@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 )
 
@@ -51,6 +52,10 @@ type Snippet struct {
 	CreatedAt *time.Time `json:"created_at"`
 	WebURL    string     `json:"web_url"`
 	RawURL    string     `json:"raw_url"`
+	Files     []struct {
+		Path   string `json:"path"`
+		RawURL string `json:"raw_url"`
+	} `json:"files"`
 }
 
 func (s Snippet) String() string {
@@ -205,6 +210,64 @@ func (s *SnippetsService) SnippetContent(snippet int, options ...RequestOptionFu
 	return b.Bytes(), resp, err
 }
 
+// SnippetFileContent returns the raw content of a single file of a snippet
+// at the given ref (a commit SHA or branch name of the snippet repository).
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/snippets.html#snippet-repository-file-content
+func (s *SnippetsService) SnippetFileContent(snippet int, ref, filename string, options ...RequestOptionFunc) ([]byte, *Response, error) {
+	u := fmt.Sprintf("snippets/%d/files/%s/%s/raw", snippet, url.PathEscape(ref), url.PathEscape(filename))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var b bytes.Buffer
+	resp, err := s.client.Do(req, &b)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return b.Bytes(), resp, err
+}
+
+// SnippetUserAgentDetails represents the user agent details of a snippet.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/snippets.html#get-user-agent-details
+type SnippetUserAgentDetails struct {
+	UserAgent        string `json:"user_agent"`
+	IPAddress        string `json:"ip_address"`
+	AkismetSubmitted bool   `json:"akismet_submitted"`
+}
+
+func (s SnippetUserAgentDetails) String() string {
+	return Stringify(s)
+}
+
+// GetSnippetUserAgentDetails gets the user agent details of a snippet.
+// Available only for admins.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/snippets.html#get-user-agent-details
+func (s *SnippetsService) GetSnippetUserAgentDetails(snippet int, options ...RequestOptionFunc) (*SnippetUserAgentDetails, *Response, error) {
+	u := fmt.Sprintf("snippets/%d/user_agent_detail", snippet)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	d := new(SnippetUserAgentDetails)
+	resp, err := s.client.Do(req, d)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return d, resp, err
+}
+
 // ExploreSnippetsOptions represents the available ExploreSnippets() options.
 //
 // GitLab API docs:
@@ -52,15 +52,36 @@ func (t Tag) String() string {
 	return Stringify(t)
 }
 
+// TagOrderByValue represents a column tags can be ordered by.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/tags.html#list-project-repository-tags
+type TagOrderByValue string
+
+// These constants represent all valid columns to order tags by.
+const (
+	TagOrderByName    TagOrderByValue = "name"
+	TagOrderByUpdated TagOrderByValue = "updated"
+	TagOrderByVersion TagOrderByValue = "version"
+)
+
+// TagOrderBy is a helper routine that allocates a new TagOrderByValue
+// to store v and returns a pointer to it.
+func TagOrderBy(v TagOrderByValue) *TagOrderByValue {
+	p := new(TagOrderByValue)
+	*p = v
+	return p
+}
+
 // ListTagsOptions represents the available ListTags() options.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/tags.html#list-project-repository-tags
 type ListTagsOptions struct {
 	ListOptions
-	OrderBy *string `url:"order_by,omitempty" json:"order_by,omitempty"`
-	Search  *string `url:"search,omitempty" json:"search,omitempty"`
-	Sort    *string `url:"sort,omitempty" json:"sort,omitempty"`
+	OrderBy *TagOrderByValue `url:"order_by,omitempty" json:"order_by,omitempty"`
+	Search  *string          `url:"search,omitempty" json:"search,omitempty"`
+	Sort    *SortValue       `url:"sort,omitempty" json:"sort,omitempty"`
 }
 
 // ListTags gets a list of tags from a project, sorted by name in reverse
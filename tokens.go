@@ -0,0 +1,306 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// TokenKindValue represents a kind of GitLab token, as identified by the
+// prefix of the token.
+type TokenKindValue string
+
+// List of available token kinds.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/security/token_overview.html#token-prefixes
+const (
+	UnknownTokenKind              TokenKindValue = ""
+	PersonalAccessTokenKind       TokenKindValue = "personal_access_token"
+	DeployTokenKind               TokenKindValue = "deploy_token"
+	FeedTokenKind                 TokenKindValue = "feed_token"
+	RunnerAuthenticationTokenKind TokenKindValue = "runner_authentication_token"
+	RunnerRegistrationTokenKind   TokenKindValue = "runner_registration_token"
+	CIJobTokenKind                TokenKindValue = "ci_job_token"
+	PipelineTriggerTokenKind      TokenKindValue = "pipeline_trigger_token"
+	OAuthApplicationSecretKind    TokenKindValue = "oauth_application_secret"
+	IncomingMailTokenKind         TokenKindValue = "incoming_mail_token"
+	FeatureFlagsClientTokenKind   TokenKindValue = "feature_flags_client_token"
+	SCIMTokenKind                 TokenKindValue = "scim_token"
+	AgentTokenKind                TokenKindValue = "agent_token"
+
+	// OAuth access tokens don't have a prefix, so DetectTokenKind never
+	// returns this kind. It is returned by Client.TokenInfo.
+	OAuthAccessTokenKind TokenKindValue = "oauth_access_token"
+)
+
+// tokenPrefixes maps the known token prefixes to their token kind.
+var tokenPrefixes = map[string]TokenKindValue{
+	"glpat-":    PersonalAccessTokenKind,
+	"gldt-":     DeployTokenKind,
+	"glft-":     FeedTokenKind,
+	"glrt-":     RunnerAuthenticationTokenKind,
+	"GR1348941": RunnerRegistrationTokenKind,
+	"glcbt-":    CIJobTokenKind,
+	"glptt-":    PipelineTriggerTokenKind,
+	"gloas-":    OAuthApplicationSecretKind,
+	"glimt-":    IncomingMailTokenKind,
+	"glffct-":   FeatureFlagsClientTokenKind,
+	"glsoat-":   SCIMTokenKind,
+	"glagent-":  AgentTokenKind,
+}
+
+// tokenPattern matches all tokens with one of the known token prefixes.
+// Routable tokens contain dots separating their parts, but a token never
+// ends with a dot, so a trailing full stop is not included in the match.
+var tokenPattern = regexp.MustCompile(`(?:glpat-|gldt-|glft-|glrt-|GR1348941|glcbt-|glptt-|gloas-|glimt-|glffct-|glsoat-|glagent-)[0-9A-Za-z_\-.]{19,}[0-9A-Za-z_\-]`)
+
+// DetectTokenKind returns the kind of the given token based on its prefix,
+// or UnknownTokenKind if the token does not have a known prefix.
+func DetectTokenKind(token string) TokenKindValue {
+	for prefix, kind := range tokenPrefixes {
+		if strings.HasPrefix(token, prefix) {
+			return kind
+		}
+	}
+	return UnknownTokenKind
+}
+
+// DetectedToken represents a GitLab token found by ScanForTokens.
+type DetectedToken struct {
+	Kind   TokenKindValue
+	Token  string
+	Offset int
+}
+
+// ScanForTokens scans the given text for GitLab tokens with a known prefix
+// and returns the found tokens in the order they appear in the text.
+func ScanForTokens(text string) []*DetectedToken {
+	var tokens []*DetectedToken
+	for _, loc := range tokenPattern.FindAllStringIndex(text, -1) {
+		token := text[loc[0]:loc[1]]
+		tokens = append(tokens, &DetectedToken{
+			Kind:   DetectTokenKind(token),
+			Token:  token,
+			Offset: loc[0],
+		})
+	}
+	return tokens
+}
+
+// resetRunnerAuthenticationTokenOptions represents the options used to reset
+// a runner authentication token using the token itself.
+type resetRunnerAuthenticationTokenOptions struct {
+	Token *string `url:"token" json:"token"`
+}
+
+// revokeAdminTokenOptions represents the options used to revoke a token
+// using the admin token API.
+type revokeAdminTokenOptions struct {
+	Token *string `url:"token" json:"token"`
+}
+
+// RevokeToken revokes a leaked token using the revocation endpoint matching
+// the kind of the token:
+//
+// Personal, project and group access tokens are revoked by authenticating
+// with the leaked token itself, so no additional permissions are needed.
+// Runner authentication tokens are reset, which invalidates the leaked token
+// without unregistering the runner.
+//
+// Deploy tokens and feed tokens are revoked using the admin token API, so
+// the client must be authenticated as an administrator.
+//
+// Other kinds of tokens cannot be revoked by value using the GitLab API and
+// result in an error, so they have to be revoked by other means.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/personal_access_tokens.html#using-a-request-header
+// https://docs.gitlab.com/ee/api/admin/token.html#revoke-a-token
+func (c *Client) RevokeToken(token string, options ...RequestOptionFunc) (*Response, error) {
+	switch kind := DetectTokenKind(token); kind {
+	case PersonalAccessTokenKind:
+		tc, err := NewClient(token, WithBaseURL(c.baseURL.String()), WithHTTPClient(c.client.HTTPClient))
+		if err != nil {
+			return nil, err
+		}
+		tc.UserAgent = c.UserAgent
+
+		return tc.PersonalAccessTokens.RevokePersonalAccessTokenSelf(options...)
+
+	case RunnerAuthenticationTokenKind:
+		opt := &resetRunnerAuthenticationTokenOptions{Token: &token}
+
+		req, err := c.NewRequest(http.MethodPost, "runners/reset_authentication_token", opt, options)
+		if err != nil {
+			return nil, err
+		}
+
+		return c.Do(req, nil)
+
+	case DeployTokenKind, FeedTokenKind:
+		req, err := c.NewRequest(http.MethodDelete, "admin/token", nil, options)
+		if err != nil {
+			return nil, err
+		}
+
+		// Send the token in the body, as query parameters of DELETE
+		// requests are likely to end up in access logs.
+		body, err := json.Marshal(&revokeAdminTokenOptions{Token: &token})
+		if err != nil {
+			return nil, err
+		}
+		if err := req.SetBody(body); err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		return c.Do(req, nil)
+
+	case UnknownTokenKind:
+		return nil, fmt.Errorf("unknown token kind")
+
+	default:
+		return nil, fmt.Errorf("revoking a %s by value is not supported", strings.ReplaceAll(string(kind), "_", " "))
+	}
+}
+
+// TokenInfo represents the token a client authenticates with, as returned
+// by Client.TokenInfo.
+type TokenInfo struct {
+	// Kind is the kind of the token. Project and group access tokens are
+	// reported as personal access tokens of their bot users.
+	Kind TokenKindValue
+
+	// ID is the ID of a personal access token, or the ID of the job of a
+	// CI/CD job token.
+	ID int
+
+	// Name is the name of a personal access token.
+	Name string
+
+	// UserID is the ID of the user the token belongs to, if known.
+	UserID int
+
+	// Scopes are the scopes of a personal access token or OAuth access
+	// token. CI/CD job tokens and deploy tokens don't report scopes.
+	Scopes []string
+
+	// ExpiresAt is the expiry of the token, or nil if it doesn't expire or
+	// the expiry is unknown.
+	ExpiresAt *time.Time
+}
+
+func (i TokenInfo) String() string {
+	return Stringify(i)
+}
+
+// MissingScopes returns the given scopes the token doesn't have, for
+// example to fail fast when a token can't be used for what a tool needs.
+// Scopes are compared literally, so "api" doesn't imply "read_api".
+func (i *TokenInfo) MissingScopes(scopes ...string) []string {
+	var missing []string
+	for _, scope := range scopes {
+		if !containsString(i.Scopes, scope) {
+			missing = append(missing, scope)
+		}
+	}
+	return missing
+}
+
+// oauthTokenInfo is the response of the OAuth token introspection endpoint.
+type oauthTokenInfo struct {
+	ResourceOwnerID int      `json:"resource_owner_id"`
+	Scope           []string `json:"scope"`
+	ExpiresIn       *int     `json:"expires_in"`
+	CreatedAt       int64    `json:"created_at"`
+}
+
+// TokenInfo returns information about the token the client authenticates
+// with: its kind, scopes and expiry. Personal, project and group access
+// tokens are introspected using personal_access_tokens/self, OAuth access
+// tokens using /oauth/token/info and CI/CD job tokens using the job endpoint.
+// Deploy tokens can't be introspected, so only their kind is returned.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/personal_access_tokens.html#using-a-request-header
+func (c *Client) TokenInfo(ctx context.Context, options ...RequestOptionFunc) (*TokenInfo, error) {
+	// Copy the options, so the caller's backing array is never modified.
+	options = append(options[:len(options):len(options)], WithContext(ctx))
+
+	switch c.authType {
+	case jobToken:
+		job, _, err := c.Jobs.GetJobTokensJob(nil, options...)
+		if err != nil {
+			return nil, err
+		}
+		info := &TokenInfo{Kind: CIJobTokenKind, ID: job.ID}
+		if job.User != nil {
+			info.UserID = job.User.ID
+		}
+		return info, nil
+
+	case oAuthToken, basicAuth:
+		req, err := c.NewRequest(http.MethodGet, "", nil, options)
+		if err != nil {
+			return nil, err
+		}
+		// The introspection endpoint is not part of the API.
+		req.URL.Path = strings.TrimSuffix(c.baseURL.Path, apiVersionPath) + "oauth/token/info"
+		req.URL.RawPath = ""
+
+		ti := new(oauthTokenInfo)
+		if _, err := c.Do(req, ti); err != nil {
+			return nil, err
+		}
+
+		info := &TokenInfo{Kind: OAuthAccessTokenKind, UserID: ti.ResourceOwnerID, Scopes: ti.Scope}
+		if ti.ExpiresIn != nil {
+			expiresAt := time.Now().Add(time.Duration(*ti.ExpiresIn) * time.Second)
+			info.ExpiresAt = &expiresAt
+		}
+		return info, nil
+	}
+
+	if DetectTokenKind(c.token) == DeployTokenKind {
+		return &TokenInfo{Kind: DeployTokenKind}, nil
+	}
+
+	pat, _, err := c.PersonalAccessTokens.GetSinglePersonalAccessToken(options...)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &TokenInfo{
+		Kind:   PersonalAccessTokenKind,
+		ID:     pat.ID,
+		Name:   pat.Name,
+		UserID: pat.UserID,
+		Scopes: pat.Scopes,
+	}
+	if pat.ExpiresAt != nil {
+		expiresAt := time.Time(*pat.ExpiresAt)
+		info.ExpiresAt = &expiresAt
+	}
+	return info, nil
+}
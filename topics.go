@@ -0,0 +1,293 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"sort"
+
+	"github.com/google/go-querystring/query"
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+)
+
+// TopicsService handles communication with the topics related methods
+// of the GitLab API.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/topics.html
+type TopicsService struct {
+	client *Client
+}
+
+// Topic represents a GitLab project topic.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/topics.html
+type Topic struct {
+	ID                 int    `json:"id"`
+	Name               string `json:"name"`
+	Title              string `json:"title"`
+	Description        string `json:"description"`
+	TotalProjectsCount int    `json:"total_projects_count"`
+	AvatarURL          string `json:"avatar_url"`
+}
+
+func (t Topic) String() string {
+	return Stringify(t)
+}
+
+// ListTopicsOptions represents the available ListTopics() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/topics.html#list-topics
+type ListTopicsOptions struct {
+	ListOptions
+	Search          *string `url:"search,omitempty" json:"search,omitempty"`
+	WithoutProjects *bool   `url:"without_projects,omitempty" json:"without_projects,omitempty"`
+	OrganizationID  *int    `url:"organization_id,omitempty" json:"organization_id,omitempty"`
+}
+
+// ListTopics returns a list of the project topics of the GitLab instance,
+// ordered by the number of associated projects.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/topics.html#list-topics
+func (s *TopicsService) ListTopics(opt *ListTopicsOptions, options ...RequestOptionFunc) ([]*Topic, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "topics", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var t []*Topic
+	resp, err := s.client.Do(req, &t)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return t, resp, err
+}
+
+// GetTopic gets a project topic by ID.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/topics.html#get-a-topic
+func (s *TopicsService) GetTopic(topic int, options ...RequestOptionFunc) (*Topic, *Response, error) {
+	u := fmt.Sprintf("topics/%d", topic)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	t := new(Topic)
+	resp, err := s.client.Do(req, t)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return t, resp, err
+}
+
+// TopicAvatar represents a topic avatar. When Image is set the avatar is
+// uploaded as a multipart request, an empty TopicAvatar removes the avatar.
+type TopicAvatar struct {
+	Filename string
+	Image    io.Reader
+}
+
+// MarshalJSON implements the json.Marshaler interface. An avatar is only sent
+// as JSON to remove it.
+func (a *TopicAvatar) MarshalJSON() ([]byte, error) {
+	return []byte(`""`), nil
+}
+
+// CreateTopicOptions represents the available CreateTopic() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/topics.html#create-a-project-topic
+type CreateTopicOptions struct {
+	Name        *string      `url:"name,omitempty" json:"name,omitempty"`
+	Title       *string      `url:"title,omitempty" json:"title,omitempty"`
+	Description *string      `url:"description,omitempty" json:"description,omitempty"`
+	Avatar      *TopicAvatar `url:"-" json:"-"`
+}
+
+// CreateTopic creates a new project topic. This requires administrator
+// access.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/topics.html#create-a-project-topic
+func (s *TopicsService) CreateTopic(opt *CreateTopicOptions, options ...RequestOptionFunc) (*Topic, *Response, error) {
+	var avatar *TopicAvatar
+	if opt != nil {
+		avatar = opt.Avatar
+	}
+
+	req, err := s.newTopicRequest(http.MethodPost, "topics", opt, avatar, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	t := new(Topic)
+	resp, err := s.client.Do(req, t)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return t, resp, err
+}
+
+// UpdateTopicOptions represents the available UpdateTopic() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/topics.html#update-a-project-topic
+type UpdateTopicOptions struct {
+	Name        *string      `url:"name,omitempty" json:"name,omitempty"`
+	Title       *string      `url:"title,omitempty" json:"title,omitempty"`
+	Description *string      `url:"description,omitempty" json:"description,omitempty"`
+	Avatar      *TopicAvatar `url:"-" json:"avatar,omitempty"`
+}
+
+// UpdateTopic updates a project topic. This requires administrator access.
+// To remove the avatar of a topic, set Avatar to an empty TopicAvatar.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/topics.html#update-a-project-topic
+func (s *TopicsService) UpdateTopic(topic int, opt *UpdateTopicOptions, options ...RequestOptionFunc) (*Topic, *Response, error) {
+	u := fmt.Sprintf("topics/%d", topic)
+
+	var avatar *TopicAvatar
+	if opt != nil {
+		avatar = opt.Avatar
+	}
+
+	req, err := s.newTopicRequest(http.MethodPut, u, opt, avatar, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	t := new(Topic)
+	resp, err := s.client.Do(req, t)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return t, resp, err
+}
+
+// newTopicRequest returns a JSON request, or a multipart request when an
+// avatar image is uploaded.
+func (s *TopicsService) newTopicRequest(method, u string, opt interface{}, avatar *TopicAvatar, options []RequestOptionFunc) (*retryablehttp.Request, error) {
+	if avatar == nil || avatar.Image == nil {
+		return s.client.NewRequest(method, u, opt, options)
+	}
+
+	fields, err := query.Values(opt)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &bytes.Buffer{}
+	w := multipart.NewWriter(b)
+
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		for _, v := range fields[k] {
+			if err := w.WriteField(k, v); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	fw, err := w.CreateFormFile("avatar", avatar.Filename)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = io.Copy(fw, avatar.Image); err != nil {
+		return nil, err
+	}
+	w.Close()
+
+	req, err := s.client.NewRequest(method, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set the buffer as the request body.
+	if err = req.SetBody(b); err != nil {
+		return nil, err
+	}
+
+	// Overwrite the default content type.
+	req.Header.Set("Content-Type", w.FormDataContentType())
+
+	return req, nil
+}
+
+// DeleteTopic deletes a project topic. This requires administrator access.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/topics.html#delete-a-project-topic
+func (s *TopicsService) DeleteTopic(topic int, options ...RequestOptionFunc) (*Response, error) {
+	u := fmt.Sprintf("topics/%d", topic)
+
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// MergeTopicsOptions represents the available MergeTopics() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/topics.html#merge-topics
+type MergeTopicsOptions struct {
+	SourceTopicID *int `url:"source_topic_id,omitempty" json:"source_topic_id,omitempty"`
+	TargetTopicID *int `url:"target_topic_id,omitempty" json:"target_topic_id,omitempty"`
+}
+
+// MergeTopics merges the source topic into the target topic. The projects of
+// the source topic are assigned to the target topic and the source topic is
+// deleted. This requires administrator access.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/topics.html#merge-topics
+func (s *TopicsService) MergeTopics(opt *MergeTopicsOptions, options ...RequestOptionFunc) (*Topic, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodPost, "topics/merge", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	t := new(Topic)
+	resp, err := s.client.Do(req, t)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return t, resp, err
+}
@@ -0,0 +1,196 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestListTopics(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/topics", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/topics?page=1&search=git")
+		fmt.Fprint(w, `[{"id":1,"name":"gitlab","title":"GitLab","total_projects_count":1000},{"id":3,"name":"git","title":"Git","total_projects_count":900}]`)
+	})
+
+	topics, _, err := client.Topics.ListTopics(&ListTopicsOptions{ListOptions: ListOptions{Page: 1}, Search: String("git")})
+	if err != nil {
+		t.Fatalf("Topics.ListTopics returned error: %v", err)
+	}
+
+	want := []*Topic{
+		{ID: 1, Name: "gitlab", Title: "GitLab", TotalProjectsCount: 1000},
+		{ID: 3, Name: "git", Title: "Git", TotalProjectsCount: 900},
+	}
+	if !reflect.DeepEqual(want, topics) {
+		t.Errorf("Topics.ListTopics returned %+v, want %+v", topics, want)
+	}
+}
+
+func TestGetTopic(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/topics/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":1,"name":"gitlab","title":"GitLab","description":"GitLab is an open source end-to-end software development platform.","total_projects_count":1000,"avatar_url":"http://www.gravatar.com/avatar/a0d477b3ea21970ce6ffcbb817b0b435?s=80&d=identicon"}`)
+	})
+
+	topic, _, err := client.Topics.GetTopic(1)
+	if err != nil {
+		t.Fatalf("Topics.GetTopic returned error: %v", err)
+	}
+
+	want := &Topic{
+		ID:                 1,
+		Name:               "gitlab",
+		Title:              "GitLab",
+		Description:        "GitLab is an open source end-to-end software development platform.",
+		TotalProjectsCount: 1000,
+		AvatarURL:          "http://www.gravatar.com/avatar/a0d477b3ea21970ce6ffcbb817b0b435?s=80&d=identicon",
+	}
+	if !reflect.DeepEqual(want, topic) {
+		t.Errorf("Topics.GetTopic returned %+v, want %+v", topic, want)
+	}
+}
+
+func TestCreateTopic(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/topics", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"name":"topic1","title":"Topic 1"}`)
+		fmt.Fprint(w, `{"id":1,"name":"topic1","title":"Topic 1"}`)
+	})
+
+	topic, _, err := client.Topics.CreateTopic(&CreateTopicOptions{Name: String("topic1"), Title: String("Topic 1")})
+	if err != nil {
+		t.Fatalf("Topics.CreateTopic returned error: %v", err)
+	}
+
+	want := &Topic{ID: 1, Name: "topic1", Title: "Topic 1"}
+	if !reflect.DeepEqual(want, topic) {
+		t.Errorf("Topics.CreateTopic returned %+v, want %+v", topic, want)
+	}
+}
+
+func TestCreateTopicWithAvatar(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/topics", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		if !strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data;") {
+			t.Fatalf("Expected a multipart request, got Content-Type %q", r.Header.Get("Content-Type"))
+		}
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Fatalf("Failed to parse multipart form: %v", err)
+		}
+		if got := r.FormValue("name"); got != "topic1" {
+			t.Errorf("Form field name is %q, want %q", got, "topic1")
+		}
+		f, h, err := r.FormFile("avatar")
+		if err != nil {
+			t.Fatalf("Failed to read avatar: %v", err)
+		}
+		defer f.Close()
+		data, _ := ioutil.ReadAll(f)
+		if h.Filename != "avatar.png" || string(data) != "png" {
+			t.Errorf("Avatar is %q with content %q, want %q with content %q", h.Filename, data, "avatar.png", "png")
+		}
+		fmt.Fprint(w, `{"id":1,"name":"topic1","avatar_url":"https://gitlab.example.com/uploads/-/system/projects/topic/avatar/1/avatar.png"}`)
+	})
+
+	topic, _, err := client.Topics.CreateTopic(&CreateTopicOptions{
+		Name:   String("topic1"),
+		Avatar: &TopicAvatar{Filename: "avatar.png", Image: strings.NewReader("png")},
+	})
+	if err != nil {
+		t.Fatalf("Topics.CreateTopic returned error: %v", err)
+	}
+
+	want := &Topic{ID: 1, Name: "topic1", AvatarURL: "https://gitlab.example.com/uploads/-/system/projects/topic/avatar/1/avatar.png"}
+	if !reflect.DeepEqual(want, topic) {
+		t.Errorf("Topics.CreateTopic returned %+v, want %+v", topic, want)
+	}
+}
+
+func TestUpdateTopicRemoveAvatar(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/topics/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"title":"Topic 1","avatar":""}`)
+		fmt.Fprint(w, `{"id":1,"name":"topic1","title":"Topic 1"}`)
+	})
+
+	topic, _, err := client.Topics.UpdateTopic(1, &UpdateTopicOptions{Title: String("Topic 1"), Avatar: &TopicAvatar{}})
+	if err != nil {
+		t.Fatalf("Topics.UpdateTopic returned error: %v", err)
+	}
+
+	want := &Topic{ID: 1, Name: "topic1", Title: "Topic 1"}
+	if !reflect.DeepEqual(want, topic) {
+		t.Errorf("Topics.UpdateTopic returned %+v, want %+v", topic, want)
+	}
+}
+
+func TestDeleteTopic(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/topics/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Topics.DeleteTopic(1)
+	if err != nil {
+		t.Errorf("Topics.DeleteTopic returned error: %v", err)
+	}
+}
+
+func TestMergeTopics(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/topics/merge", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"source_topic_id":2,"target_topic_id":1}`)
+		fmt.Fprint(w, `{"id":1,"name":"topic1","total_projects_count":3}`)
+	})
+
+	topic, _, err := client.Topics.MergeTopics(&MergeTopicsOptions{SourceTopicID: Int(2), TargetTopicID: Int(1)})
+	if err != nil {
+		t.Fatalf("Topics.MergeTopics returned error: %v", err)
+	}
+
+	want := &Topic{ID: 1, Name: "topic1", TotalProjectsCount: 3}
+	if !reflect.DeepEqual(want, topic) {
+		t.Errorf("Topics.MergeTopics returned %+v, want %+v", topic, want)
+	}
+}
@@ -0,0 +1,232 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"sort"
+)
+
+// TreeChangeTypeValue represents the type of a change between two trees.
+type TreeChangeTypeValue string
+
+// List of available tree change types.
+const (
+	TreeChangeAdded    TreeChangeTypeValue = "added"
+	TreeChangeModified TreeChangeTypeValue = "modified"
+	TreeChangeDeleted  TreeChangeTypeValue = "deleted"
+	TreeChangeRenamed  TreeChangeTypeValue = "renamed"
+)
+
+// TreeChange represents a changed path between two refs. OldPath and OldMode
+// are empty for added paths, Path is the old path of deleted paths.
+type TreeChange struct {
+	Type    TreeChangeTypeValue
+	Path    string
+	OldPath string
+	OldMode string
+	NewMode string
+}
+
+func (c TreeChange) String() string {
+	return Stringify(c)
+}
+
+// DiffTreesOptions represents the available DiffTrees() options.
+type DiffTreesOptions struct {
+	// From and To are the refs to compare.
+	From string
+	To   string
+
+	// Straight compares From and To directly, instead of comparing To with
+	// the merge base of both refs, see CompareOptions.
+	Straight bool
+
+	// DisableRenames reports renamed paths as a deleted and an added path.
+	DisableRenames bool
+}
+
+// DiffTrees returns the paths that were added, modified, deleted or renamed
+// between two refs, ordered by path, for example to synchronize a copy of a
+// repository. The changes are taken from the compare API. When GitLab times
+// out comparing large refs, both trees are listed page by page and compared
+// by blob ID instead, in which case only renames without changes are
+// detected.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/repositories.html#compare-branches-tags-or-commits
+func (s *RepositoriesService) DiffTrees(ctx context.Context, pid interface{}, opt *DiffTreesOptions) ([]*TreeChange, error) {
+	if opt == nil || opt.From == "" || opt.To == "" {
+		return nil, errors.New("both From and To must be set")
+	}
+
+	compareOpt := &CompareOptions{From: String(opt.From), To: String(opt.To)}
+	if opt.Straight {
+		compareOpt.Straight = Bool(true)
+	}
+	c, _, err := s.Compare(pid, compareOpt, WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []*TreeChange
+	if c.CompareTimeout {
+		from := opt.From
+		if !opt.Straight {
+			// The tree listings are compared directly, so compare with the
+			// merge base to match the compare API.
+			base, _, err := s.MergeBase(pid, &MergeBaseOptions{Ref: []string{opt.From, opt.To}}, WithContext(ctx))
+			if err != nil {
+				return nil, err
+			}
+			from = base.ID
+		}
+		changes, err = s.diffTreeListings(ctx, pid, from, opt.To)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		for _, d := range c.Diffs {
+			changes = append(changes, treeChangeOfDiff(d))
+		}
+	}
+
+	if opt.DisableRenames {
+		changes = splitRenames(changes)
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+
+	return changes, nil
+}
+
+func treeChangeOfDiff(d *Diff) *TreeChange {
+	c := &TreeChange{Path: d.NewPath, OldPath: d.OldPath, OldMode: d.AMode, NewMode: d.BMode}
+	switch {
+	case d.NewFile:
+		c.Type = TreeChangeAdded
+		c.OldPath = ""
+		c.OldMode = ""
+	case d.DeletedFile:
+		c.Type = TreeChangeDeleted
+		c.Path = d.OldPath
+		c.NewMode = ""
+	case d.RenamedFile:
+		c.Type = TreeChangeRenamed
+	default:
+		c.Type = TreeChangeModified
+	}
+	return c
+}
+
+// splitRenames replaces every rename by a deletion and an addition.
+func splitRenames(changes []*TreeChange) []*TreeChange {
+	var split []*TreeChange
+	for _, c := range changes {
+		if c.Type != TreeChangeRenamed {
+			split = append(split, c)
+			continue
+		}
+		split = append(split,
+			&TreeChange{Type: TreeChangeDeleted, Path: c.OldPath, OldPath: c.OldPath, OldMode: c.OldMode},
+			&TreeChange{Type: TreeChangeAdded, Path: c.Path, NewMode: c.NewMode},
+		)
+	}
+	return split
+}
+
+// diffTreeListings compares the recursive tree listings of two refs.
+func (s *RepositoriesService) diffTreeListings(ctx context.Context, pid interface{}, from, to string) ([]*TreeChange, error) {
+	oldTree, err := s.listBlobs(ctx, pid, from)
+	if err != nil {
+		return nil, err
+	}
+	newTree, err := s.listBlobs(ctx, pid, to)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes, added, deleted []*TreeChange
+	for path, n := range newTree {
+		o, ok := oldTree[path]
+		switch {
+		case !ok:
+			added = append(added, &TreeChange{Type: TreeChangeAdded, Path: path, NewMode: n.Mode})
+		case o.ID != n.ID || o.Mode != n.Mode:
+			changes = append(changes, &TreeChange{Type: TreeChangeModified, Path: path, OldPath: path, OldMode: o.Mode, NewMode: n.Mode})
+		}
+	}
+	for path, o := range oldTree {
+		if _, ok := newTree[path]; !ok {
+			deleted = append(deleted, &TreeChange{Type: TreeChangeDeleted, Path: path, OldPath: path, OldMode: o.Mode})
+		}
+	}
+
+	// Pair deleted and added paths with the same blob as renames. Sort
+	// them first, so the pairing doesn't depend on map ordering.
+	sort.Slice(added, func(i, j int) bool { return added[i].Path < added[j].Path })
+	sort.Slice(deleted, func(i, j int) bool { return deleted[i].Path < deleted[j].Path })
+
+	deletedByBlob := make(map[string][]*TreeChange)
+	for _, d := range deleted {
+		id := oldTree[d.Path].ID
+		deletedByBlob[id] = append(deletedByBlob[id], d)
+	}
+	renamed := make(map[*TreeChange]bool)
+	for _, a := range added {
+		id := newTree[a.Path].ID
+		if ds := deletedByBlob[id]; len(ds) > 0 {
+			d := ds[0]
+			deletedByBlob[id] = ds[1:]
+			renamed[d] = true
+			changes = append(changes, &TreeChange{Type: TreeChangeRenamed, Path: a.Path, OldPath: d.Path, OldMode: d.OldMode, NewMode: a.NewMode})
+			continue
+		}
+		changes = append(changes, a)
+	}
+	for _, d := range deleted {
+		if !renamed[d] {
+			changes = append(changes, d)
+		}
+	}
+
+	return changes, nil
+}
+
+// listBlobs lists all blobs of the tree of ref by path.
+func (s *RepositoriesService) listBlobs(ctx context.Context, pid interface{}, ref string) (map[string]*TreeNode, error) {
+	blobs := make(map[string]*TreeNode)
+	opt := &ListTreeOptions{
+		ListOptions: ListOptions{PerPage: 100},
+		Ref:         String(ref),
+		Recursive:   Bool(true),
+	}
+	err := ForEachPage(func(options ...RequestOptionFunc) (*Response, error) {
+		nodes, resp, err := s.ListTree(pid, opt, options...)
+		for _, n := range nodes {
+			// Submodules are compared by the commit they point to.
+			if n.Type == "blob" || n.Type == "commit" {
+				blobs[n.Path] = n
+			}
+		}
+		return resp, err
+	}, WithContext(ctx))
+	return blobs, err
+}
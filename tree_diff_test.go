@@ -0,0 +1,118 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffTrees(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/compare", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/projects/1/repository/compare?from=v1.0&to=main")
+		fmt.Fprint(w, `{"diffs":[
+			{"old_path":"b.go","new_path":"b.go","a_mode":"100644","b_mode":"100644"},
+			{"old_path":"a.go","new_path":"a.go","a_mode":"0","b_mode":"100644","new_file":true},
+			{"old_path":"old.go","new_path":"new.go","a_mode":"100644","b_mode":"100644","renamed_file":true},
+			{"old_path":"c.go","new_path":"c.go","a_mode":"100644","b_mode":"0","deleted_file":true}
+		]}`)
+	})
+
+	changes, err := client.Repositories.DiffTrees(context.Background(), 1, &DiffTreesOptions{From: "v1.0", To: "main"})
+	require.NoError(t, err)
+
+	want := []*TreeChange{
+		{Type: TreeChangeAdded, Path: "a.go", NewMode: "100644"},
+		{Type: TreeChangeModified, Path: "b.go", OldPath: "b.go", OldMode: "100644", NewMode: "100644"},
+		{Type: TreeChangeDeleted, Path: "c.go", OldPath: "c.go", OldMode: "100644"},
+		{Type: TreeChangeRenamed, Path: "new.go", OldPath: "old.go", OldMode: "100644", NewMode: "100644"},
+	}
+	assert.Equal(t, want, changes)
+
+	changes, err = client.Repositories.DiffTrees(context.Background(), 1, &DiffTreesOptions{From: "v1.0", To: "main", DisableRenames: true})
+	require.NoError(t, err)
+
+	want = []*TreeChange{
+		{Type: TreeChangeAdded, Path: "a.go", NewMode: "100644"},
+		{Type: TreeChangeModified, Path: "b.go", OldPath: "b.go", OldMode: "100644", NewMode: "100644"},
+		{Type: TreeChangeDeleted, Path: "c.go", OldPath: "c.go", OldMode: "100644"},
+		{Type: TreeChangeAdded, Path: "new.go", NewMode: "100644"},
+		{Type: TreeChangeDeleted, Path: "old.go", OldPath: "old.go", OldMode: "100644"},
+	}
+	assert.Equal(t, want, changes)
+}
+
+func TestDiffTreesCompareTimeout(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/compare", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"compare_timeout":true,"diffs":[]}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/repository/merge_base", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/projects/1/repository/merge_base?refs%5B%5D=v1.0&refs%5B%5D=main")
+		fmt.Fprint(w, `{"id":"base"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/repository/tree", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch ref, page := r.URL.Query().Get("ref"), r.URL.Query().Get("page"); {
+		case ref == "base" && page == "":
+			w.Header().Set("X-Next-Page", "2")
+			fmt.Fprint(w, `[
+				{"id":"t1","name":"dir","type":"tree","path":"dir","mode":"040000"},
+				{"id":"b1","name":"same.go","type":"blob","path":"dir/same.go","mode":"100644"},
+				{"id":"b2","name":"changed.go","type":"blob","path":"changed.go","mode":"100644"}
+			]`)
+		case ref == "base" && page == "2":
+			fmt.Fprint(w, `[
+				{"id":"b3","name":"moved.go","type":"blob","path":"moved.go","mode":"100644"},
+				{"id":"b4","name":"gone.go","type":"blob","path":"gone.go","mode":"100644"}
+			]`)
+		case ref == "main":
+			fmt.Fprint(w, `[
+				{"id":"b1","name":"same.go","type":"blob","path":"dir/same.go","mode":"100644"},
+				{"id":"b5","name":"changed.go","type":"blob","path":"changed.go","mode":"100644"},
+				{"id":"b3","name":"moved.go","type":"blob","path":"dir/moved.go","mode":"100644"},
+				{"id":"b6","name":"new.go","type":"blob","path":"new.go","mode":"100755"}
+			]`)
+		default:
+			t.Errorf("Unexpected tree request %s", r.URL)
+		}
+	})
+
+	changes, err := client.Repositories.DiffTrees(context.Background(), 1, &DiffTreesOptions{From: "v1.0", To: "main"})
+	require.NoError(t, err)
+
+	want := []*TreeChange{
+		{Type: TreeChangeModified, Path: "changed.go", OldPath: "changed.go", OldMode: "100644", NewMode: "100644"},
+		{Type: TreeChangeRenamed, Path: "dir/moved.go", OldPath: "moved.go", OldMode: "100644", NewMode: "100644"},
+		{Type: TreeChangeDeleted, Path: "gone.go", OldPath: "gone.go", OldMode: "100644"},
+		{Type: TreeChangeAdded, Path: "new.go", NewMode: "100755"},
+	}
+	assert.Equal(t, want, changes)
+}
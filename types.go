@@ -121,6 +121,81 @@ func DeploymentStatus(v DeploymentStatusValue) *DeploymentStatusValue {
 	return p
 }
 
+// DetailedMergeStatusValue represents the detailed merge status of a merge
+// request, which tells why a merge request can or cannot be merged.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#merge-status
+type DetailedMergeStatusValue string
+
+// These constants represent all valid detailed merge statuses.
+const (
+	MergeStatusApprovalsSyncing         DetailedMergeStatusValue = "approvals_syncing"
+	MergeStatusBlockedStatus            DetailedMergeStatusValue = "blocked_status"
+	MergeStatusBrokenStatus             DetailedMergeStatusValue = "broken_status"
+	MergeStatusChecking                 DetailedMergeStatusValue = "checking"
+	MergeStatusCIMustPass               DetailedMergeStatusValue = "ci_must_pass"
+	MergeStatusCIStillRunning           DetailedMergeStatusValue = "ci_still_running"
+	MergeStatusCommitsStatus            DetailedMergeStatusValue = "commits_status"
+	MergeStatusConflict                 DetailedMergeStatusValue = "conflict"
+	MergeStatusDiscussionsNotResolved   DetailedMergeStatusValue = "discussions_not_resolved"
+	MergeStatusDraftStatus              DetailedMergeStatusValue = "draft_status"
+	MergeStatusExternalStatusChecks     DetailedMergeStatusValue = "external_status_checks"
+	MergeStatusJiraAssociationMissing   DetailedMergeStatusValue = "jira_association_missing"
+	MergeStatusMergeable                DetailedMergeStatusValue = "mergeable"
+	MergeStatusMergeRequestBlocked      DetailedMergeStatusValue = "merge_request_blocked"
+	MergeStatusMergeTime                DetailedMergeStatusValue = "merge_time"
+	MergeStatusNeedRebase               DetailedMergeStatusValue = "need_rebase"
+	MergeStatusNotApproved              DetailedMergeStatusValue = "not_approved"
+	MergeStatusNotOpen                  DetailedMergeStatusValue = "not_open"
+	MergeStatusPreparing                DetailedMergeStatusValue = "preparing"
+	MergeStatusRequestedChanges         DetailedMergeStatusValue = "requested_changes"
+	MergeStatusSecurityPolicyViolations DetailedMergeStatusValue = "security_policy_violations"
+	MergeStatusUnchecked                DetailedMergeStatusValue = "unchecked"
+)
+
+// IsMergeable reports whether the merge request can be merged.
+func (s DetailedMergeStatusValue) IsMergeable() bool {
+	return s == MergeStatusMergeable
+}
+
+// IsPending reports whether GitLab is still determining if the merge request
+// can be merged, in which case the status should be checked again later.
+func (s DetailedMergeStatusValue) IsPending() bool {
+	switch s {
+	case MergeStatusApprovalsSyncing, MergeStatusChecking, MergeStatusPreparing, MergeStatusUnchecked:
+		return true
+	}
+	return false
+}
+
+// ExportStatusValue represents the status of a project export.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_import_export.html#export-status
+type ExportStatusValue string
+
+// These constants represent all valid export statuses.
+const (
+	ExportNone                   ExportStatusValue = "none"
+	ExportQueued                 ExportStatusValue = "queued"
+	ExportStarted                ExportStatusValue = "started"
+	ExportFinished               ExportStatusValue = "finished"
+	ExportRegenerationInProgress ExportStatusValue = "regeneration_in_progress"
+)
+
+// IsTerminal reports whether the export is no longer in progress. GitLab
+// reports the none status both when no export was ever scheduled and when a
+// scheduled export failed.
+func (s ExportStatusValue) IsTerminal() bool {
+	return s == ExportNone || s == ExportFinished
+}
+
+// IsFinished reports whether the export finished and can be downloaded.
+func (s ExportStatusValue) IsFinished() bool {
+	return s == ExportFinished
+}
+
 // FileActionValue represents the available actions that can be performed on a file.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/commits.html#create-a-commit-with-multiple-files-and-actions
@@ -143,6 +218,74 @@ func FileAction(v FileActionValue) *FileActionValue {
 	return p
 }
 
+// HealthStatusValue represents the health status of an issue or epic.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/project/issues/managing_issues.html#health-status
+type HealthStatusValue string
+
+// These constants represent all valid health statuses. When filtering, the
+// values "None" and "Any" can be used as well.
+const (
+	HealthStatusOnTrack        HealthStatusValue = "on_track"
+	HealthStatusNeedsAttention HealthStatusValue = "needs_attention"
+	HealthStatusAtRisk         HealthStatusValue = "at_risk"
+)
+
+// HealthStatus is a helper routine that allocates a new HealthStatusValue
+// to store v and returns a pointer to it.
+func HealthStatus(v HealthStatusValue) *HealthStatusValue {
+	p := new(HealthStatusValue)
+	*p = v
+	return p
+}
+
+// ImportStatusValue represents the status of a project import.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_import_export.html#import-status
+type ImportStatusValue string
+
+// These constants represent all valid import statuses.
+const (
+	ImportNone      ImportStatusValue = "none"
+	ImportScheduled ImportStatusValue = "scheduled"
+	ImportStarted   ImportStatusValue = "started"
+	ImportFinished  ImportStatusValue = "finished"
+	ImportFailed    ImportStatusValue = "failed"
+)
+
+// IsTerminal reports whether the import is no longer in progress.
+func (s ImportStatusValue) IsTerminal() bool {
+	return s == ImportFinished || s == ImportFailed
+}
+
+// IsFinished reports whether the import finished successfully.
+func (s ImportStatusValue) IsFinished() bool {
+	return s == ImportFinished
+}
+
+// MilestoneIDValue represents a milestone wildcard used to filter issues.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/issues.html#list-issues
+type MilestoneIDValue string
+
+// These constants represent all valid milestone wildcards.
+const (
+	MilestoneIDNone     MilestoneIDValue = "None"
+	MilestoneIDAny      MilestoneIDValue = "Any"
+	MilestoneIDUpcoming MilestoneIDValue = "Upcoming"
+	MilestoneIDStarted  MilestoneIDValue = "Started"
+)
+
+// MilestoneID is a helper routine that allocates a new MilestoneIDValue
+// to store v and returns a pointer to it.
+func MilestoneID(v MilestoneIDValue) *MilestoneIDValue {
+	p := new(MilestoneIDValue)
+	*p = v
+	return p
+}
+
 // ISOTime represents an ISO 8601 formatted date
 type ISOTime time.Time
 
@@ -305,6 +448,25 @@ func ProjectCreationLevel(v ProjectCreationLevelValue) *ProjectCreationLevelValu
 	return p
 }
 
+// SortValue represents the order in which a list is sorted.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/
+type SortValue string
+
+// These constants represent all valid sort orders.
+const (
+	SortAsc  SortValue = "asc"
+	SortDesc SortValue = "desc"
+)
+
+// Sort is a helper routine that allocates a new SortValue to store v and
+// returns a pointer to it.
+func Sort(v SortValue) *SortValue {
+	p := new(SortValue)
+	*p = v
+	return p
+}
+
 // SubGroupCreationLevelValue represents a sub group creation level within GitLab.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/
@@ -326,6 +488,80 @@ func SubGroupCreationLevel(v SubGroupCreationLevelValue) *SubGroupCreationLevelV
 	return p
 }
 
+// ProtectionRuleAccessLevelValue represents the minimum access level required
+// by a package or container registry protection rule.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_packages_protection_rules.html
+type ProtectionRuleAccessLevelValue string
+
+// List of available protection rule access levels.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_packages_protection_rules.html
+const (
+	MaintainerProtectionRuleAccessLevel ProtectionRuleAccessLevelValue = "maintainer"
+	OwnerProtectionRuleAccessLevel      ProtectionRuleAccessLevelValue = "owner"
+	AdminProtectionRuleAccessLevel      ProtectionRuleAccessLevelValue = "admin"
+)
+
+// ProtectionRuleAccessLevel is a helper routine that allocates a new
+// ProtectionRuleAccessLevelValue to store v and returns a pointer to it.
+func ProtectionRuleAccessLevel(v ProtectionRuleAccessLevelValue) *ProtectionRuleAccessLevelValue {
+	p := new(ProtectionRuleAccessLevelValue)
+	*p = v
+	return p
+}
+
+// RunnerTypeValue represents the type of a runner.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/users.html#create-a-runner-linked-to-a-user
+type RunnerTypeValue string
+
+// List of available runner types.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/users.html#create-a-runner-linked-to-a-user
+const (
+	InstanceRunnerType RunnerTypeValue = "instance_type"
+	GroupRunnerType    RunnerTypeValue = "group_type"
+	ProjectRunnerType  RunnerTypeValue = "project_type"
+)
+
+// RunnerType is a helper routine that allocates a new RunnerTypeValue
+// to store v and returns a pointer to it.
+func RunnerType(v RunnerTypeValue) *RunnerTypeValue {
+	p := new(RunnerTypeValue)
+	*p = v
+	return p
+}
+
+// SharedRunnersSettingValue determines whether shared runners are enabled
+// for a group's subgroups and projects.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/groups.html#options-for-shared_runners_setting
+type SharedRunnersSettingValue string
+
+// List of available shared runner setting levels.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/groups.html#options-for-shared_runners_setting
+const (
+	EnabledSharedRunnersSettingValue                  SharedRunnersSettingValue = "enabled"
+	DisabledWithOverrideSharedRunnersSettingValue     SharedRunnersSettingValue = "disabled_with_override"
+	DisabledAndUnoverridableSharedRunnersSettingValue SharedRunnersSettingValue = "disabled_and_unoverridable"
+)
+
+// SharedRunnersSetting is a helper routine that allocates a new SharedRunnersSettingValue
+// to store v and returns a pointer to it.
+func SharedRunnersSetting(v SharedRunnersSettingValue) *SharedRunnersSettingValue {
+	p := new(SharedRunnersSettingValue)
+	*p = v
+	return p
+}
+
 // VariableTypeValue represents a variable type within GitLab.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/
@@ -390,6 +626,29 @@ func MergeMethod(v MergeMethodValue) *MergeMethodValue {
 	return p
 }
 
+// SquashOptionValue represents a project squash option within GitLab.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/projects.html#create-project
+type SquashOptionValue string
+
+// List of available squash options.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/projects.html#create-project
+const (
+	SquashOptionNever      SquashOptionValue = "never"
+	SquashOptionAlways     SquashOptionValue = "always"
+	SquashOptionDefaultOn  SquashOptionValue = "default_on"
+	SquashOptionDefaultOff SquashOptionValue = "default_off"
+)
+
+// SquashOption is a helper routine that allocates a new SquashOptionValue
+// to store v and returns a pointer to it.
+func SquashOption(v SquashOptionValue) *SquashOptionValue {
+	p := new(SquashOptionValue)
+	*p = v
+	return p
+}
+
 // EventTypeValue represents actions type for contribution events
 type EventTypeValue string
 
@@ -408,8 +667,18 @@ const (
 	LeftEventType      EventTypeValue = "left"
 	DestroyedEventType EventTypeValue = "destroyed"
 	ExpiredEventType   EventTypeValue = "expired"
+	ApprovedEventType  EventTypeValue = "approved"
 )
 
+// EventAction is a helper routine that allocates a new EventTypeValue
+// to store v and returns a pointer to it. It's not called EventType as that
+// name is already used for the webhook event types.
+func EventAction(v EventTypeValue) *EventTypeValue {
+	p := new(EventTypeValue)
+	*p = v
+	return p
+}
+
 // EventTargetTypeValue represents actions type value for contribution events
 type EventTargetTypeValue string
 
@@ -426,6 +695,81 @@ const (
 	UserEventTargetType         EventTargetTypeValue = "user"
 )
 
+// EventTargetType is a helper routine that allocates a new
+// EventTargetTypeValue to store v and returns a pointer to it.
+func EventTargetType(v EventTargetTypeValue) *EventTargetTypeValue {
+	p := new(EventTargetTypeValue)
+	*p = v
+	return p
+}
+
+// EventScopeValue represents the scope of the events returned for the
+// currently authenticated user.
+type EventScopeValue string
+
+// List of available event scopes.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/events.html#list-currently-authenticated-users-events
+const (
+	// AllEventScope includes the events of all projects the user is a
+	// member of, not just the events the user performed.
+	AllEventScope EventScopeValue = "all"
+)
+
+// EventScope is a helper routine that allocates a new EventScopeValue
+// to store v and returns a pointer to it.
+func EventScope(v EventScopeValue) *EventScopeValue {
+	p := new(EventScopeValue)
+	*p = v
+	return p
+}
+
+// CodeQualitySeverityValue represents the severity of a code quality
+// degradation.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#codequalitydegradationseverity
+type CodeQualitySeverityValue string
+
+// List of available code quality severities.
+const (
+	CodeQualitySeverityBlocker  CodeQualitySeverityValue = "BLOCKER"
+	CodeQualitySeverityCritical CodeQualitySeverityValue = "CRITICAL"
+	CodeQualitySeverityMajor    CodeQualitySeverityValue = "MAJOR"
+	CodeQualitySeverityMinor    CodeQualitySeverityValue = "MINOR"
+	CodeQualitySeverityInfo     CodeQualitySeverityValue = "INFO"
+	CodeQualitySeverityUnknown  CodeQualitySeverityValue = "UNKNOWN"
+)
+
+// CodeQualityReportGenerationStatusValue represents the status of the
+// generation of a code quality reports comparison.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#codequalityreportscomparerreportgenerationstatus
+type CodeQualityReportGenerationStatusValue string
+
+// List of available code quality report generation statuses.
+const (
+	CodeQualityReportParsed  CodeQualityReportGenerationStatusValue = "PARSED"
+	CodeQualityReportParsing CodeQualityReportGenerationStatusValue = "PARSING"
+	CodeQualityReportError   CodeQualityReportGenerationStatusValue = "ERROR"
+)
+
+// CodeQualityReportStatusValue represents the outcome of a code quality
+// reports comparison.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#codequalityreportscomparerstatus
+type CodeQualityReportStatusValue string
+
+// List of available code quality report statuses.
+const (
+	CodeQualityReportSuccess  CodeQualityReportStatusValue = "SUCCESS"
+	CodeQualityReportFailed   CodeQualityReportStatusValue = "FAILED"
+	CodeQualityReportNotFound CodeQualityReportStatusValue = "NOT_FOUND"
+)
+
 // Bool is a helper routine that allocates a new bool value
 // to store v and returns a pointer to it.
 func Bool(v bool) *bool {
@@ -19,17 +19,21 @@ package gitlab
 import (
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
+	"strings"
 	"time"
 )
 
 // List a couple of standard errors.
 var (
-	ErrUserActivatePrevented   = errors.New("Cannot activate a user that is blocked by admin or by LDAP synchronization")
-	ErrUserBlockPrevented      = errors.New("Cannot block a user that is already blocked by LDAP synchronization")
-	ErrUserDeactivatePrevented = errors.New("Cannot deactivate a user that is blocked by admin or by LDAP synchronization, or that has any activity in past 180 days")
-	ErrUserNotFound            = errors.New("User does not exist")
-	ErrUserUnblockPrevented    = errors.New("Cannot unblock a user that is blocked by LDAP synchronization")
+	ErrUserActivatePrevented         = errors.New("Cannot activate a user that is blocked by admin or by LDAP synchronization")
+	ErrUserBlockPrevented            = errors.New("Cannot block a user that is already blocked by LDAP synchronization")
+	ErrUserDeactivatePrevented       = errors.New("Cannot deactivate a user that is blocked by admin or by LDAP synchronization, or that has any activity in past 180 days")
+	ErrUserDisableTwoFactorPrevented = errors.New("Cannot disable two factor authentication if not authenticated as administrator")
+	ErrUserNotFound                  = errors.New("User does not exist")
+	ErrUserTwoFactorNotEnabled       = errors.New("Cannot disable two factor authentication if not enabled")
+	ErrUserUnblockPrevented          = errors.New("Cannot unblock a user that is blocked by LDAP synchronization")
 )
 
 // UsersService handles communication with the user related methods of
@@ -82,6 +86,9 @@ type User struct {
 	ProjectsLimit                  int                `json:"projects_limit"`
 	CurrentSignInAt                *time.Time         `json:"current_sign_in_at"`
 	LastSignInAt                   *time.Time         `json:"last_sign_in_at"`
+	CurrentSignInIP                *net.IP            `json:"current_sign_in_ip"`
+	LastSignInIP                   *net.IP            `json:"last_sign_in_ip"`
+	Locked                         bool               `json:"locked"`
 	ConfirmedAt                    *time.Time         `json:"confirmed_at"`
 	TwoFactorEnabled               bool               `json:"two_factor_enabled"`
 	Note                           string             `json:"note"`
@@ -100,6 +107,29 @@ type UserIdentity struct {
 	ExternUID string `json:"extern_uid"`
 }
 
+// UserOrderByValue represents a column users can be ordered by.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/users.html#list-users
+type UserOrderByValue string
+
+// These constants represent all valid columns to order users by.
+const (
+	UserOrderByID        UserOrderByValue = "id"
+	UserOrderByName      UserOrderByValue = "name"
+	UserOrderByUsername  UserOrderByValue = "username"
+	UserOrderByCreatedAt UserOrderByValue = "created_at"
+	UserOrderByUpdatedAt UserOrderByValue = "updated_at"
+)
+
+// UserOrderBy is a helper routine that allocates a new UserOrderByValue
+// to store v and returns a pointer to it.
+func UserOrderBy(v UserOrderByValue) *UserOrderByValue {
+	p := new(UserOrderByValue)
+	*p = v
+	return p
+}
+
 // ListUsersOptions represents the available ListUsers() options.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/users.html#list-users
@@ -110,19 +140,19 @@ type ListUsersOptions struct {
 	ExcludeInternal *bool `url:"exclude_internal,omitempty" json:"exclude_internal,omitempty"`
 
 	// The options below are only available for admins.
-	Search               *string    `url:"search,omitempty" json:"search,omitempty"`
-	Username             *string    `url:"username,omitempty" json:"username,omitempty"`
-	ExternalUID          *string    `url:"extern_uid,omitempty" json:"extern_uid,omitempty"`
-	Provider             *string    `url:"provider,omitempty" json:"provider,omitempty"`
-	CreatedBefore        *time.Time `url:"created_before,omitempty" json:"created_before,omitempty"`
-	CreatedAfter         *time.Time `url:"created_after,omitempty" json:"created_after,omitempty"`
-	OrderBy              *string    `url:"order_by,omitempty" json:"order_by,omitempty"`
-	Sort                 *string    `url:"sort,omitempty" json:"sort,omitempty"`
-	TwoFactor            *string    `url:"two_factor,omitempty" json:"two_factor,omitempty"`
-	Admins               *bool      `url:"admins,omitempty" json:"admins,omitempty"`
-	External             *bool      `url:"external,omitempty" json:"external,omitempty"`
-	WithoutProjects      *bool      `url:"without_projects,omitempty" json:"without_projects,omitempty"`
-	WithCustomAttributes *bool      `url:"with_custom_attributes,omitempty" json:"with_custom_attributes,omitempty"`
+	Search               *string           `url:"search,omitempty" json:"search,omitempty"`
+	Username             *string           `url:"username,omitempty" json:"username,omitempty"`
+	ExternalUID          *string           `url:"extern_uid,omitempty" json:"extern_uid,omitempty"`
+	Provider             *string           `url:"provider,omitempty" json:"provider,omitempty"`
+	CreatedBefore        *time.Time        `url:"created_before,omitempty" json:"created_before,omitempty"`
+	CreatedAfter         *time.Time        `url:"created_after,omitempty" json:"created_after,omitempty"`
+	OrderBy              *UserOrderByValue `url:"order_by,omitempty" json:"order_by,omitempty"`
+	Sort                 *SortValue        `url:"sort,omitempty" json:"sort,omitempty"`
+	TwoFactor            *string           `url:"two_factor,omitempty" json:"two_factor,omitempty"`
+	Admins               *bool             `url:"admins,omitempty" json:"admins,omitempty"`
+	External             *bool             `url:"external,omitempty" json:"external,omitempty"`
+	WithoutProjects      *bool             `url:"without_projects,omitempty" json:"without_projects,omitempty"`
+	WithCustomAttributes *bool             `url:"with_custom_attributes,omitempty" json:"with_custom_attributes,omitempty"`
 }
 
 // ListUsers gets a list of users.
@@ -163,6 +193,26 @@ func (s *UsersService) GetUser(user int, options ...RequestOptionFunc) (*User, *
 	return usr, resp, err
 }
 
+// UserExists checks whether a user with the given username exists. The
+// lookup is done using the username filter of the list users endpoint, so it
+// does not require admin permissions.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/users.html#for-normal-users
+func (s *UsersService) UserExists(username string, options ...RequestOptionFunc) (bool, *Response, error) {
+	usrs, resp, err := s.ListUsers(&ListUsersOptions{Username: String(username)}, options...)
+	if err != nil {
+		return false, resp, err
+	}
+
+	for _, usr := range usrs {
+		if strings.EqualFold(usr.Username, username) {
+			return true, resp, nil
+		}
+	}
+
+	return false, resp, nil
+}
+
 // CreateUserOptions represents the available CreateUser() options.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/users.html#user-creation
@@ -477,6 +527,35 @@ func (s *UsersService) BlockUser(user int, options ...RequestOptionFunc) error {
 	}
 }
 
+// BlockUserWithNote appends a note to the admin note of the specified user
+// and blocks the user, so the reason for blocking the user is recorded with
+// the user. Any existing admin note is kept, the new note is added on a new
+// line. Available only for admin.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/users.html#block-user
+func (s *UsersService) BlockUserWithNote(user int, note string, options ...RequestOptionFunc) error {
+	u, resp, err := s.GetUser(user, options...)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return ErrUserNotFound
+		}
+		return err
+	}
+	if u.Note != "" {
+		note = u.Note + "\n" + note
+	}
+
+	_, resp, err = s.ModifyUser(user, &ModifyUserOptions{Note: &note}, options...)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return ErrUserNotFound
+		}
+		return err
+	}
+
+	return s.BlockUser(user, options...)
+}
+
 // UnblockUser unblocks the specified user. Available only for admin.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/users.html#unblock-user
@@ -505,6 +584,102 @@ func (s *UsersService) UnblockUser(user int, options ...RequestOptionFunc) error
 	}
 }
 
+// DisableTwoFactor disables two factor authentication for the specified user.
+// Available only for admin.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/users.html#disable-two-factor-authentication
+func (s *UsersService) DisableTwoFactor(user int, options ...RequestOptionFunc) error {
+	u := fmt.Sprintf("users/%d/disable_two_factor", user)
+
+	req, err := s.client.NewRequest(http.MethodPatch, u, nil, options)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil && resp == nil {
+		return err
+	}
+
+	switch resp.StatusCode {
+	case 204:
+		return nil
+	case 400:
+		return ErrUserTwoFactorNotEnabled
+	case 403:
+		return ErrUserDisableTwoFactorPrevented
+	case 404:
+		return ErrUserNotFound
+	default:
+		return fmt.Errorf("Received unexpected result code: %d", resp.StatusCode)
+	}
+}
+
+// UserCredentialAudit represents the credential related attributes of a user,
+// as used to audit the security of user accounts.
+type UserCredentialAudit struct {
+	UserID           int
+	Username         string
+	State            string
+	IsAdmin          bool
+	TwoFactorEnabled bool
+	CurrentSignInAt  *time.Time
+	LastSignInAt     *time.Time
+	LastActivityOn   *ISOTime
+	Identities       []*UserIdentity
+	Note             string
+}
+
+func (a UserCredentialAudit) String() string {
+	return Stringify(a)
+}
+
+// NeverSignedIn reports whether the user never signed in.
+func (a *UserCredentialAudit) NeverSignedIn() bool {
+	return a.LastSignInAt == nil && a.CurrentSignInAt == nil
+}
+
+// InactiveSince reports whether the user did not sign in since t.
+func (a *UserCredentialAudit) InactiveSince(t time.Time) bool {
+	last := a.CurrentSignInAt
+	if last == nil || (a.LastSignInAt != nil && a.LastSignInAt.After(*last)) {
+		last = a.LastSignInAt
+	}
+	return last == nil || last.Before(t)
+}
+
+// ListUserCredentialAudits gets the credential related attributes of the
+// users matching the given options, for instance all users without two factor
+// authentication when using a TwoFactor option of "disabled". The sign-in
+// attributes are only returned to admins.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/users.html#for-admins
+func (s *UsersService) ListUserCredentialAudits(opt *ListUsersOptions, options ...RequestOptionFunc) ([]*UserCredentialAudit, *Response, error) {
+	usrs, resp, err := s.ListUsers(opt, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	audits := make([]*UserCredentialAudit, 0, len(usrs))
+	for _, u := range usrs {
+		audits = append(audits, &UserCredentialAudit{
+			UserID:           u.ID,
+			Username:         u.Username,
+			State:            u.State,
+			IsAdmin:          u.IsAdmin,
+			TwoFactorEnabled: u.TwoFactorEnabled,
+			CurrentSignInAt:  u.CurrentSignInAt,
+			LastSignInAt:     u.LastSignInAt,
+			LastActivityOn:   u.LastActivityOn,
+			Identities:       u.Identities,
+			Note:             u.Note,
+		})
+	}
+
+	return audits, resp, err
+}
+
 // DeactivateUser deactivate the specified user. Available only for admin.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/users.html#deactivate-user
@@ -838,7 +1013,7 @@ type PersonalAccessToken struct {
 	Revoked   bool       `json:"revoked"`
 	CreatedAt *time.Time `json:"created_at"`
 	Scopes    []string   `json:"scopes"`
-	UserID    string     `json:"user_id"`
+	UserID    int        `json:"user_id"`
 	Active    bool       `json:"active"`
 	ExpiresAt *ISOTime   `json:"expires_at"`
 	Token     string     `json:"token"`
@@ -876,6 +1051,59 @@ func (s *UsersService) CreatePersonalAccessToken(user int, opt *CreatePersonalAc
 	return t, resp, err
 }
 
+// UserRunner represents a runner created with CreateUserRunner.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/users.html#create-a-runner-linked-to-a-user
+type UserRunner struct {
+	ID             int        `json:"id"`
+	Token          string     `json:"token"`
+	TokenExpiresAt *time.Time `json:"token_expires_at"`
+}
+
+// CreateUserRunnerOptions represents the available CreateUserRunner()
+// options. GroupID and ProjectID select the group or project of group and
+// project runners.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/users.html#create-a-runner-linked-to-a-user
+type CreateUserRunnerOptions struct {
+	RunnerType      *RunnerTypeValue `url:"runner_type,omitempty" json:"runner_type,omitempty"`
+	GroupID         *int             `url:"group_id,omitempty" json:"group_id,omitempty"`
+	ProjectID       *int             `url:"project_id,omitempty" json:"project_id,omitempty"`
+	Description     *string          `url:"description,omitempty" json:"description,omitempty"`
+	Paused          *bool            `url:"paused,omitempty" json:"paused,omitempty"`
+	Locked          *bool            `url:"locked,omitempty" json:"locked,omitempty"`
+	RunUntagged     *bool            `url:"run_untagged,omitempty" json:"run_untagged,omitempty"`
+	TagList         []string         `url:"tag_list,omitempty" json:"tag_list,omitempty"`
+	AccessLevel     *string          `url:"access_level,omitempty" json:"access_level,omitempty"`
+	MaximumTimeout  *int             `url:"maximum_timeout,omitempty" json:"maximum_timeout,omitempty"`
+	MaintenanceNote *string          `url:"maintenance_note,omitempty" json:"maintenance_note,omitempty"`
+}
+
+// CreateUserRunner creates an instance, group or project runner, using the
+// runner token architecture that replaces runner registration tokens. The
+// returned runner authentication token is used to register the runner with
+// gitlab-runner. This requires the create_runner scope, and administrator
+// access for instance runners.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/users.html#create-a-runner-linked-to-a-user
+func (s *UsersService) CreateUserRunner(opt *CreateUserRunnerOptions, options ...RequestOptionFunc) (*UserRunner, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodPost, "user/runners", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(UserRunner)
+	resp, err := s.client.Do(req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, err
+}
+
 // UserActivity represents an entry in the user/activities response
 //
 // GitLab API docs:
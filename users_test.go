@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -273,3 +274,132 @@ func TestGetMemberships(t *testing.T) {
 	want := []*UserMembership{{SourceID: 1, SourceName: "Project one", SourceType: "Project", AccessLevel: 20}, {SourceID: 3, SourceName: "Group three", SourceType: "Namespace", AccessLevel: 20}}
 	assert.Equal(t, want, memberships)
 }
+
+func TestUserExists(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.URL.Query().Get("username") == "John_Smith" {
+			fmt.Fprint(w, `[{"id":1,"username":"john_smith"}]`)
+			return
+		}
+		fmt.Fprint(w, `[]`)
+	})
+
+	exists, _, err := client.Users.UserExists("John_Smith")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	exists, _, err = client.Users.UserExists("jane_doe")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestBlockUserWithNote(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/users/1", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"id":1,"note":"Contractor until 2021-12-31"}`)
+		case http.MethodPut:
+			testBody(t, r, `{"note":"Contractor until 2021-12-31\nCompromised account"}`)
+			fmt.Fprint(w, `{"id":1,"note":"Contractor until 2021-12-31\nCompromised account"}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+	mux.HandleFunc("/api/v4/users/2", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"id":2}`)
+		case http.MethodPut:
+			testBody(t, r, `{"note":"Compromised account"}`)
+			fmt.Fprint(w, `{"id":2,"note":"Compromised account"}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+	blocked := 0
+	block := func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		blocked++
+		w.WriteHeader(http.StatusCreated)
+	}
+	mux.HandleFunc("/api/v4/users/1/block", block)
+	mux.HandleFunc("/api/v4/users/2/block", block)
+
+	require.NoError(t, client.Users.BlockUserWithNote(1, "Compromised account"))
+	require.NoError(t, client.Users.BlockUserWithNote(2, "Compromised account"))
+	require.Equal(t, 2, blocked)
+}
+
+func TestDisableTwoFactor(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/users/1/disable_two_factor", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPatch)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/api/v4/users/2/disable_two_factor", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPatch)
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	require.NoError(t, client.Users.DisableTwoFactor(1))
+
+	err := client.Users.DisableTwoFactor(2)
+	if !errors.Is(err, ErrUserTwoFactorNotEnabled) {
+		t.Errorf("Users.DisableTwoFactor error.\nExpected: %+v\nGot: %+v", ErrUserTwoFactorNotEnabled, err)
+	}
+}
+
+func TestListUserCredentialAudits(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/users?two_factor=disabled")
+		fmt.Fprint(w, `[
+			{"id":1,"username":"alice","state":"active","two_factor_enabled":false,"last_sign_in_at":"2020-01-01T00:00:00Z","current_sign_in_at":"2020-06-01T00:00:00Z","last_sign_in_ip":"10.0.0.1"},
+			{"id":2,"username":"bob","state":"active","two_factor_enabled":false}
+		]`)
+	})
+
+	audits, _, err := client.Users.ListUserCredentialAudits(&ListUsersOptions{TwoFactor: String("disabled")})
+	require.NoError(t, err)
+	require.Len(t, audits, 2)
+
+	assert.Equal(t, "alice", audits[0].Username)
+	assert.False(t, audits[0].NeverSignedIn())
+	assert.True(t, audits[0].InactiveSince(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)))
+	assert.False(t, audits[0].InactiveSince(time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, audits[1].NeverSignedIn())
+}
+
+func TestCreateUserRunner(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/user/runners", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"runner_type":"group_type","group_id":9,"description":"builder","tag_list":["docker"]}`)
+		fmt.Fprint(w, `{"id":9171,"token":"glrt-kyahzxLaj4Dc1jQf4xjX","token_expires_at":null}`)
+	})
+
+	runner, _, err := client.Users.CreateUserRunner(&CreateUserRunnerOptions{
+		RunnerType:  RunnerType(GroupRunnerType),
+		GroupID:     Int(9),
+		Description: String("builder"),
+		TagList:     []string{"docker"},
+	})
+	require.NoError(t, err)
+
+	want := &UserRunner{ID: 9171, Token: "glrt-kyahzxLaj4Dc1jQf4xjX"}
+	assert.Equal(t, want, runner)
+}
@@ -42,8 +42,8 @@ func (s Version) String() string {
 // authenticated users.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/version.md
-func (s *VersionService) GetVersion() (*Version, *Response, error) {
-	req, err := s.client.NewRequest(http.MethodGet, "version", nil, nil)
+func (s *VersionService) GetVersion(options ...RequestOptionFunc) (*Version, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "version", nil, options)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -56,3 +56,40 @@ func (s *VersionService) GetVersion() (*Version, *Response, error) {
 
 	return v, resp, err
 }
+
+// Metadata represents the metadata of a GitLab instance.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/metadata.html
+type Metadata struct {
+	Version    string `json:"version"`
+	Revision   string `json:"revision"`
+	Enterprise bool   `json:"enterprise"`
+	KAS        struct {
+		Enabled     bool   `json:"enabled"`
+		ExternalURL string `json:"externalUrl"`
+		Version     string `json:"version"`
+	} `json:"kas"`
+}
+
+func (s Metadata) String() string {
+	return Stringify(s)
+}
+
+// GetMetadata gets the metadata of a GitLab instance; it is only available to
+// authenticated users.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/metadata.html
+func (s *VersionService) GetMetadata(options ...RequestOptionFunc) (*Metadata, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "metadata", nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m := new(Metadata)
+	resp, err := s.client.Do(req, m)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return m, resp, err
+}
@@ -17,6 +17,8 @@
 package gitlab
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -43,3 +45,41 @@ func TestGetVersion(t *testing.T) {
 		t.Errorf("Version.GetVersion returned %+v, want %+v", version, want)
 	}
 }
+
+func TestGetVersionWithCanceledContext(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/version",
+		func(w http.ResponseWriter, r *http.Request) {
+			t.Error("Version.GetVersion sent a request with a canceled context")
+		})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, _, err := client.Version.GetVersion(WithContext(ctx))
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Version.GetVersion returned error %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestGetMetadata(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/metadata",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			fmt.Fprint(w, `{"version":"16.4.1-ee","revision":"14d3a1d","enterprise":true,"kas":{"enabled":true,"version":"16.4.0"}}`)
+		})
+
+	m, _, err := client.Version.GetMetadata()
+	if err != nil {
+		t.Fatalf("Version.GetMetadata returned error: %v", err)
+	}
+
+	if m.Version != "16.4.1-ee" || !m.Enterprise || !m.KAS.Enabled || m.KAS.Version != "16.4.0" {
+		t.Errorf("Version.GetMetadata returned %+v", m)
+	}
+}
@@ -0,0 +1,113 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// VulnerabilitiesService handles communication with the vulnerabilities
+// related methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/vulnerabilities.html
+type VulnerabilitiesService struct {
+	client *Client
+}
+
+// Vulnerability represents a project vulnerability.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/vulnerabilities.html
+type Vulnerability struct {
+	ID                      int        `json:"id"`
+	Title                   string     `json:"title"`
+	Description             string     `json:"description"`
+	State                   string     `json:"state"`
+	Severity                string     `json:"severity"`
+	Confidence              string     `json:"confidence"`
+	ReportType              string     `json:"report_type"`
+	Project                 *Project   `json:"project"`
+	FindingID               int        `json:"finding_id"`
+	AuthorID                int        `json:"author_id"`
+	ResolvedByID            int        `json:"resolved_by_id"`
+	DismissedByID           int        `json:"dismissed_by_id"`
+	ConfirmedByID           int        `json:"confirmed_by_id"`
+	ResolvedOnDefaultBranch bool       `json:"resolved_on_default_branch"`
+	CreatedAt               *time.Time `json:"created_at"`
+	UpdatedAt               *time.Time `json:"updated_at"`
+	ResolvedAt              *time.Time `json:"resolved_at"`
+	DismissedAt             *time.Time `json:"dismissed_at"`
+	ConfirmedAt             *time.Time `json:"confirmed_at"`
+}
+
+func (v Vulnerability) String() string {
+	return Stringify(v)
+}
+
+// ListProjectVulnerabilitiesOptions represents the available
+// ListProjectVulnerabilities() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/vulnerabilities.html
+type ListProjectVulnerabilitiesOptions ListOptions
+
+// ListProjectVulnerabilities gets a list of the vulnerabilities of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/vulnerabilities.html
+func (s *VulnerabilitiesService) ListProjectVulnerabilities(pid interface{}, opt *ListProjectVulnerabilitiesOptions, options ...RequestOptionFunc) ([]*Vulnerability, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/vulnerabilities", pathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var vs []*Vulnerability
+	resp, err := s.client.Do(req, &vs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return vs, resp, err
+}
+
+// GetVulnerability gets a single vulnerability.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/vulnerabilities.html#single-vulnerability
+func (s *VulnerabilitiesService) GetVulnerability(vulnerability int, options ...RequestOptionFunc) (*Vulnerability, *Response, error) {
+	u := fmt.Sprintf("vulnerabilities/%d", vulnerability)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(Vulnerability)
+	resp, err := s.client.Do(req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, err
+}
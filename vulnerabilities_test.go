@@ -0,0 +1,73 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestListProjectVulnerabilities(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/vulnerabilities", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/projects/1/vulnerabilities?page=2&per_page=10")
+		fmt.Fprint(w, `[{"id": 2, "title": "Predictable pseudorandom number generator", "state": "detected", "severity": "medium", "confidence": "medium", "report_type": "sast", "finding_id": 3}]`)
+	})
+
+	vs, _, err := client.Vulnerabilities.ListProjectVulnerabilities(1, &ListProjectVulnerabilitiesOptions{Page: 2, PerPage: 10})
+	if err != nil {
+		t.Fatalf("Vulnerabilities.ListProjectVulnerabilities returned error: %v", err)
+	}
+
+	want := []*Vulnerability{{
+		ID:         2,
+		Title:      "Predictable pseudorandom number generator",
+		State:      "detected",
+		Severity:   "medium",
+		Confidence: "medium",
+		ReportType: "sast",
+		FindingID:  3,
+	}}
+	if !reflect.DeepEqual(want, vs) {
+		t.Errorf("Vulnerabilities.ListProjectVulnerabilities returned %+v, want %+v", vs, want)
+	}
+}
+
+func TestGetVulnerability(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/vulnerabilities/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 2, "severity": "high", "project": {"id": 1}}`)
+	})
+
+	v, _, err := client.Vulnerabilities.GetVulnerability(2)
+	if err != nil {
+		t.Fatalf("Vulnerabilities.GetVulnerability returned error: %v", err)
+	}
+
+	want := &Vulnerability{ID: 2, Severity: "high", Project: &Project{ID: 1}}
+	if !reflect.DeepEqual(want, v) {
+		t.Errorf("Vulnerabilities.GetVulnerability returned %+v, want %+v", v, want)
+	}
+}
@@ -0,0 +1,253 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+)
+
+// ErrWebhookEventBusClosed is returned when publishing to a closed
+// WebhookEventBus.
+var ErrWebhookEventBusClosed = errors.New("webhook event bus closed")
+
+// WebhookMessage represents an event published on a WebhookEventBus.
+type WebhookMessage struct {
+	// Type is the event type, as found in the X-Gitlab-Event header.
+	Type EventType
+
+	// Event is the parsed event, see ParseHook.
+	Event interface{}
+}
+
+// WebhookSubscription represents a subscription to a WebhookEventBus.
+type WebhookSubscription struct {
+	// C receives the messages of the subscription. It is closed when the
+	// subscription ends. It is nil for subscriptions created using
+	// SubscribeFunc.
+	C <-chan *WebhookMessage
+
+	bus   *WebhookEventBus
+	c     chan *WebhookMessage
+	types map[EventType]bool
+
+	// done is closed when the subscription ends, to release publishers
+	// blocked on a full buffer before c is closed.
+	done  chan struct{}
+	mu    sync.Mutex
+	ended bool
+	sends sync.WaitGroup
+}
+
+// Unsubscribe ends the subscription. Messages buffered for the subscription
+// are still delivered, messages still being published are dropped.
+func (s *WebhookSubscription) Unsubscribe() {
+	s.bus.unsubscribe(s)
+	s.end()
+}
+
+// send delivers a message, unless the subscription ended.
+func (s *WebhookSubscription) send(ctx context.Context, m *WebhookMessage) error {
+	s.mu.Lock()
+	if s.ended {
+		s.mu.Unlock()
+		return nil
+	}
+	s.sends.Add(1)
+	s.mu.Unlock()
+	defer s.sends.Done()
+
+	select {
+	case s.c <- m:
+		return nil
+	case <-s.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// end ends the subscription and closes its channel once no message is being
+// sent anymore.
+func (s *WebhookSubscription) end() {
+	s.mu.Lock()
+	if s.ended {
+		s.mu.Unlock()
+		return
+	}
+	s.ended = true
+	close(s.done)
+	s.mu.Unlock()
+
+	s.sends.Wait()
+	close(s.c)
+}
+
+// WebhookEventBus distributes webhook events to subscribers, each with their
+// own buffer. Subscribers can receive events from a channel or using a
+// callback, either for all events or for selected event types. Publishing
+// blocks while the buffer of a subscriber is full, so subscribers must keep
+// up with the events or unsubscribe.
+//
+// To publish the events received by a WebhookHandler, register the Handle
+// method of the bus:
+//
+//	bus := gitlab.NewWebhookEventBus()
+//	pushes := bus.Subscribe(100, gitlab.EventTypePush)
+//	h := gitlab.NewWebhookHandler(secret)
+//	h.OnOther(bus.Handle)
+//
+// A WebhookEventBus is safe for concurrent use.
+type WebhookEventBus struct {
+	mu     sync.RWMutex
+	subs   []*WebhookSubscription
+	closed bool
+
+	// callbacks tracks the goroutines of callback subscriptions.
+	callbacks sync.WaitGroup
+}
+
+// NewWebhookEventBus returns a new WebhookEventBus.
+func NewWebhookEventBus() *WebhookEventBus {
+	return &WebhookEventBus{}
+}
+
+// Subscribe returns a subscription receiving the events of the given types
+// from its channel C, or all events when no types are given. The channel
+// buffers up to buffer messages.
+func (b *WebhookEventBus) Subscribe(buffer int, eventTypes ...EventType) *WebhookSubscription {
+	c := make(chan *WebhookMessage, buffer)
+	s := &WebhookSubscription{C: c, bus: b, c: c, done: make(chan struct{})}
+	if len(eventTypes) > 0 {
+		s.types = make(map[EventType]bool, len(eventTypes))
+		for _, t := range eventTypes {
+			s.types[t] = true
+		}
+	}
+
+	b.mu.Lock()
+	closed := b.closed
+	if !closed {
+		b.subs = append(b.subs, s)
+	}
+	b.mu.Unlock()
+
+	if closed {
+		s.end()
+	}
+
+	return s
+}
+
+// SubscribeFunc returns a subscription calling fn for the events of the given
+// types, or all events when no types are given. The function is called from
+// a separate goroutine, one message at a time, and up to buffer messages are
+// buffered while it runs.
+func (b *WebhookEventBus) SubscribeFunc(buffer int, fn func(*WebhookMessage), eventTypes ...EventType) *WebhookSubscription {
+	s := b.Subscribe(buffer, eventTypes...)
+
+	b.callbacks.Add(1)
+	go func(c <-chan *WebhookMessage) {
+		defer b.callbacks.Done()
+		for m := range c {
+			fn(m)
+		}
+	}(s.C)
+	s.C = nil
+
+	return s
+}
+
+// Publish sends an event to all subscriptions for its type. It blocks until
+// all of them accepted the message, ended or ctx is done.
+func (b *WebhookEventBus) Publish(ctx context.Context, eventType EventType, event interface{}) error {
+	b.mu.RLock()
+	if b.closed {
+		b.mu.RUnlock()
+		return ErrWebhookEventBusClosed
+	}
+	subs := make([]*WebhookSubscription, 0, len(b.subs))
+	for _, s := range b.subs {
+		if s.types == nil || s.types[eventType] {
+			subs = append(subs, s)
+		}
+	}
+	b.mu.RUnlock()
+
+	m := &WebhookMessage{Type: eventType, Event: event}
+	for _, s := range subs {
+		if err := s.send(ctx, m); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Handle publishes an event received by a WebhookHandler, using the context
+// of the request. It implements WebhookEventFunc.
+func (b *WebhookEventBus) Handle(r *http.Request, event interface{}) error {
+	return b.Publish(r.Context(), HookEventType(r), event)
+}
+
+// Close stops the bus. Publishing fails with ErrWebhookEventBusClosed
+// afterwards, messages still being published are dropped, and the channels
+// of all subscriptions are closed once their buffered messages are received.
+func (b *WebhookEventBus) Close() {
+	b.mu.Lock()
+	subs := b.subs
+	b.closed = true
+	b.subs = nil
+	b.mu.Unlock()
+
+	for _, s := range subs {
+		s.end()
+	}
+}
+
+// Shutdown closes the bus and waits until the callbacks of all subscriptions
+// created using SubscribeFunc handled their buffered messages, or until ctx
+// is done.
+func (b *WebhookEventBus) Shutdown(ctx context.Context) error {
+	b.Close()
+
+	done := make(chan struct{})
+	go func() {
+		b.callbacks.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (b *WebhookEventBus) unsubscribe(s *WebhookSubscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for i, sub := range b.subs {
+		if sub == s {
+			b.subs = append(b.subs[:i], b.subs[i+1:]...)
+			return
+		}
+	}
+}
@@ -0,0 +1,162 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookEventBus(t *testing.T) {
+	bus := NewWebhookEventBus()
+	ctx := context.Background()
+
+	pushes := bus.Subscribe(2, EventTypePush)
+	all := bus.Subscribe(2)
+
+	require.NoError(t, bus.Publish(ctx, EventTypePush, &PushEvent{Ref: "refs/heads/main"}))
+	require.NoError(t, bus.Publish(ctx, EventTypeTagPush, &TagEvent{Ref: "refs/tags/v1"}))
+
+	m := <-pushes.C
+	assert.Equal(t, EventTypePush, m.Type)
+	assert.Equal(t, "refs/heads/main", m.Event.(*PushEvent).Ref)
+	assert.Equal(t, EventTypePush, (<-all.C).Type)
+	assert.Equal(t, EventTypeTagPush, (<-all.C).Type)
+
+	select {
+	case m := <-pushes.C:
+		t.Errorf("Unexpected message %+v", m)
+	default:
+	}
+
+	pushes.Unsubscribe()
+	_, ok := <-pushes.C
+	assert.False(t, ok, "channel of unsubscribed subscription is open")
+
+	bus.Close()
+	_, ok = <-all.C
+	assert.False(t, ok, "channel is open after Close")
+	assert.Equal(t, ErrWebhookEventBusClosed, bus.Publish(ctx, EventTypePush, &PushEvent{}))
+}
+
+func TestWebhookEventBusBackpressure(t *testing.T) {
+	bus := NewWebhookEventBus()
+	bus.Subscribe(1)
+
+	require.NoError(t, bus.Publish(context.Background(), EventTypePush, &PushEvent{}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, bus.Publish(ctx, EventTypePush, &PushEvent{}))
+}
+
+func TestWebhookEventBusSubscribeFunc(t *testing.T) {
+	bus := NewWebhookEventBus()
+
+	var (
+		mu   sync.Mutex
+		refs []string
+	)
+	bus.SubscribeFunc(10, func(m *WebhookMessage) {
+		time.Sleep(time.Millisecond)
+		mu.Lock()
+		refs = append(refs, m.Event.(*PushEvent).Ref)
+		mu.Unlock()
+	}, EventTypePush)
+
+	h := NewWebhookHandler("secret")
+	h.OnOther(bus.Handle)
+
+	payload := loadFixture("testdata/webhooks/push.json")
+	for i := 0; i < 3; i++ {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, newWebhookRequest(http.MethodPost, "Push Hook", "secret", payload))
+		require.Equal(t, http.StatusNoContent, rec.Code)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, bus.Shutdown(ctx))
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{"refs/heads/master", "refs/heads/master", "refs/heads/master"}, refs)
+}
+
+func TestWebhookEventBusUnsubscribeWhilePublishing(t *testing.T) {
+	bus := NewWebhookEventBus()
+	slow := bus.Subscribe(1)
+	fast := bus.Subscribe(10)
+
+	require.NoError(t, bus.Publish(context.Background(), EventTypePush, &PushEvent{}))
+
+	published := make(chan error, 1)
+	go func() {
+		published <- bus.Publish(context.Background(), EventTypePush, &PushEvent{})
+	}()
+
+	// The second message blocks on the full buffer of slow, which must not
+	// keep it from unsubscribing.
+	time.Sleep(10 * time.Millisecond)
+	slow.Unsubscribe()
+
+	select {
+	case err := <-published:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Publish still blocked after Unsubscribe")
+	}
+
+	_, ok := <-slow.C
+	assert.True(t, ok, "buffered message of unsubscribed subscription was dropped")
+	_, ok = <-slow.C
+	assert.False(t, ok, "channel of unsubscribed subscription is open")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, bus.Shutdown(ctx))
+	assert.Len(t, fast.C, 2)
+}
+
+func TestWebhookEventBusCloseWhilePublishing(t *testing.T) {
+	bus := NewWebhookEventBus()
+	bus.Subscribe(0)
+
+	published := make(chan error, 1)
+	go func() {
+		published <- bus.Publish(context.Background(), EventTypePush, &PushEvent{})
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, bus.Shutdown(ctx))
+
+	select {
+	case err := <-published:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Publish still blocked after Shutdown")
+	}
+}
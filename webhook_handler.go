@@ -0,0 +1,210 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"crypto/subtle"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"sync"
+)
+
+const webhookTokenHeader = "X-Gitlab-Token"
+
+// defaultMaxWebhookPayloadSize is the default maximum size of a webhook
+// payload accepted by a WebhookHandler.
+const defaultMaxWebhookPayloadSize = 25 << 20
+
+// WebhookEventFunc is called by a WebhookHandler for every received event of
+// the type it was registered for. The event is a pointer to one of the event
+// types returned by ParseHook, like *PushEvent or *MergeEvent.
+type WebhookEventFunc func(r *http.Request, event interface{}) error
+
+// WebhookHandler is an http.Handler that receives GitLab web and system hooks.
+// It validates the secret token and the source address of every request,
+// parses the payload and dispatches the event to the function registered for
+// its type. It responds with:
+//
+//   - 405 Method Not Allowed for requests not using POST;
+//   - 403 Forbidden for requests from an address that is not allowed;
+//   - 401 Unauthorized for requests with a missing or invalid token, or for
+//     all requests when no secret token is configured;
+//   - 413 Request Entity Too Large for payloads exceeding MaxPayloadSize;
+//   - 400 Bad Request for payloads that can't be parsed;
+//   - 500 Internal Server Error when the registered function fails;
+//   - 204 No Content otherwise, including for events without a registered
+//     function, so GitLab doesn't disable the hook.
+//
+// A WebhookHandler is safe for concurrent use.
+type WebhookHandler struct {
+	// Secret is the secret token configured for the hook. When empty, all
+	// requests are rejected, unless InsecureSkipTokenCheck is set.
+	Secret string
+
+	// InsecureSkipTokenCheck accepts hooks without checking the
+	// X-Gitlab-Token header, for hooks configured without a secret token.
+	// Anyone who can reach the handler can then send events, so combine it
+	// with AllowedNetworks.
+	InsecureSkipTokenCheck bool
+
+	// AllowedNetworks restricts the addresses hooks are accepted from. When
+	// empty, hooks from all addresses are accepted. The address is taken
+	// from the connection, so put the handler behind a proxy that rewrites
+	// the remote address when needed.
+	AllowedNetworks []*net.IPNet
+
+	// MaxPayloadSize is the maximum size of a payload in bytes. Defaults to
+	// 25 MiB.
+	MaxPayloadSize int64
+
+	mu       sync.RWMutex
+	handlers map[EventType]WebhookEventFunc
+	fallback WebhookEventFunc
+}
+
+// NewWebhookHandler returns a WebhookHandler validating the given secret
+// token.
+func NewWebhookHandler(secret string) *WebhookHandler {
+	return &WebhookHandler{Secret: secret}
+}
+
+// On registers fn for events of the given type, replacing any function
+// registered before. Note that confidential issues and notes have their own
+// event types.
+func (h *WebhookHandler) On(eventType EventType, fn WebhookEventFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.handlers == nil {
+		h.handlers = make(map[EventType]WebhookEventFunc)
+	}
+	h.handlers[eventType] = fn
+}
+
+// OnOther registers fn for all events without a function registered using On.
+// Events that can't be parsed are still rejected.
+func (h *WebhookHandler) OnOther(fn WebhookEventFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.fallback = fn
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	if !h.allowedAddress(r.RemoteAddr) {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+	if !h.InsecureSkipTokenCheck && !ValidWebhookToken(r, h.Secret) {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	eventType := HookEventType(r)
+
+	h.mu.RLock()
+	fn, ok := h.handlers[eventType]
+	if !ok {
+		fn = h.fallback
+	}
+	h.mu.RUnlock()
+
+	maxSize := h.MaxPayloadSize
+	if maxSize <= 0 {
+		maxSize = defaultMaxWebhookPayloadSize
+	}
+	payload, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxSize))
+	if err != nil {
+		if int64(len(payload)) >= maxSize {
+			http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	if fn == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	event, err := ParseHook(eventType, payload)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := fn(r, event); err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// allowedAddress reports whether hooks are accepted from the given remote
+// address.
+func (h *WebhookHandler) allowedAddress(remoteAddr string) bool {
+	if len(h.AllowedNetworks) == 0 {
+		return true
+	}
+
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+
+	for _, n := range h.AllowedNetworks {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidWebhookToken reports whether the X-Gitlab-Token header of a hook
+// request matches the given secret token. The tokens are compared in constant
+// time. An empty secret token never matches.
+func ValidWebhookToken(r *http.Request, secret string) bool {
+	if secret == "" {
+		return false
+	}
+	token := r.Header.Get(webhookTokenHeader)
+	return subtle.ConstantTimeCompare([]byte(token), []byte(secret)) == 1
+}
+
+// RequireWebhookToken returns middleware that rejects hook requests whose
+// X-Gitlab-Token header doesn't match the given secret token with 401
+// Unauthorized, and passes all other requests to next.
+func RequireWebhookToken(secret string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !ValidWebhookToken(r, secret) {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,152 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"bytes"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newWebhookRequest(method, eventType, token string, payload []byte) *http.Request {
+	req := httptest.NewRequest(method, "/hook", bytes.NewReader(payload))
+	req.RemoteAddr = "10.0.0.1:1234"
+	req.Header.Set(eventTypeHeader, eventType)
+	if token != "" {
+		req.Header.Set(webhookTokenHeader, token)
+	}
+	return req
+}
+
+func TestWebhookHandler(t *testing.T) {
+	payload := loadFixture("testdata/webhooks/push.json")
+
+	h := NewWebhookHandler("secret")
+
+	var received *PushEvent
+	h.On(EventTypePush, func(r *http.Request, event interface{}) error {
+		received = event.(*PushEvent)
+		return nil
+	})
+	h.On(EventTypeTagPush, func(r *http.Request, event interface{}) error {
+		return errors.New("failed")
+	})
+
+	tests := []struct {
+		name      string
+		method    string
+		eventType string
+		token     string
+		payload   []byte
+		want      int
+	}{
+		{"valid", http.MethodPost, "Push Hook", "secret", payload, http.StatusNoContent},
+		{"method", http.MethodGet, "Push Hook", "secret", payload, http.StatusMethodNotAllowed},
+		{"missing token", http.MethodPost, "Push Hook", "", payload, http.StatusUnauthorized},
+		{"invalid token", http.MethodPost, "Push Hook", "wrong", payload, http.StatusUnauthorized},
+		{"invalid payload", http.MethodPost, "Push Hook", "secret", []byte("{"), http.StatusBadRequest},
+		{"unknown event", http.MethodPost, "Unknown Hook", "secret", payload, http.StatusNoContent},
+		{"handler error", http.MethodPost, "Tag Push Hook", "secret", payload, http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			received = nil
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, newWebhookRequest(tt.method, tt.eventType, tt.token, tt.payload))
+			assert.Equal(t, tt.want, rec.Code)
+		})
+	}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, newWebhookRequest(http.MethodPost, "Push Hook", "secret", payload))
+	if assert.NotNil(t, received) {
+		assert.Equal(t, "95790bf891e76fee5e1747ab589903a6a1f80f22", received.Before)
+	}
+}
+
+func TestWebhookHandlerAllowedNetworks(t *testing.T) {
+	_, allowed, _ := net.ParseCIDR("10.0.0.0/8")
+
+	h := NewWebhookHandler("")
+	h.InsecureSkipTokenCheck = true
+	h.AllowedNetworks = []*net.IPNet{allowed}
+
+	var called bool
+	h.OnOther(func(r *http.Request, event interface{}) error {
+		called = true
+		return nil
+	})
+
+	payload := loadFixture("testdata/webhooks/push.json")
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, newWebhookRequest(http.MethodPost, "Push Hook", "", payload))
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.True(t, called)
+
+	req := newWebhookRequest(http.MethodPost, "Push Hook", "", payload)
+	req.RemoteAddr = "192.168.1.1:1234"
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestWebhookHandlerWithoutSecret(t *testing.T) {
+	h := NewWebhookHandler("")
+
+	var called bool
+	h.OnOther(func(r *http.Request, event interface{}) error {
+		called = true
+		return nil
+	})
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, newWebhookRequest(http.MethodPost, "Push Hook", "", loadFixture("testdata/webhooks/push.json")))
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.False(t, called)
+}
+
+func TestWebhookHandlerMaxPayloadSize(t *testing.T) {
+	h := NewWebhookHandler("secret")
+	h.MaxPayloadSize = 16
+	h.On(EventTypePush, func(r *http.Request, event interface{}) error { return nil })
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, newWebhookRequest(http.MethodPost, "Push Hook", "secret", loadFixture("testdata/webhooks/push.json")))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+}
+
+func TestRequireWebhookToken(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	h := RequireWebhookToken("secret", next)
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, newWebhookRequest(http.MethodPost, "Push Hook", "secret", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, newWebhookRequest(http.MethodPost, "Push Hook", "wrong", nil))
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}